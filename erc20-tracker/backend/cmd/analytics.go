@@ -0,0 +1,333 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"erc20-tracker/backend/internal/analytics"
+	"erc20-tracker/backend/internal/config"
+)
+
+// runAnalytics 持有人分析命令
+func runAnalytics(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: analytics top|concentration|churn|flows|rollup [参数]")
+	}
+
+	switch args[0] {
+	case "top":
+		return runAnalyticsTop(args[1:])
+	case "concentration":
+		return runAnalyticsConcentration(args[1:])
+	case "churn":
+		return runAnalyticsChurn(args[1:])
+	case "flows":
+		return runAnalyticsFlows(args[1:])
+	case "rollup":
+		return runAnalyticsRollup(args[1:])
+	default:
+		return fmt.Errorf("未知的analytics子命令: %s", args[0])
+	}
+}
+
+// analyticsFlags 分析查询命令的公共参数
+type analyticsFlags struct {
+	chain  *string
+	asJSON *bool
+	dryRun *bool
+}
+
+// newAnalyticsFlagSet 创建分析查询命令的参数解析器
+func newAnalyticsFlagSet(name string) (*flag.FlagSet, analyticsFlags) {
+	fs, dryRun := newFlagSet(name)
+	return fs, analyticsFlags{
+		chain:  fs.String("chain", "", "链名称或链ID"),
+		asJSON: fs.Bool("json", false, "以JSON格式输出"),
+		dryRun: dryRun,
+	}
+}
+
+// openAnalytics 打开应用程序并定位链，分析查询命令均为只读
+func openAnalytics(flags analyticsFlags) (*Application, *config.ChainConfig, *analytics.Service, error) {
+	if *flags.chain == "" {
+		return nil, nil, nil, errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("创建应用程序失败: %w", err)
+	}
+
+	chain, err := app.config.FindChain(*flags.chain)
+	if err != nil {
+		app.Close()
+		return nil, nil, nil, err
+	}
+
+	if *flags.dryRun {
+		fmt.Println("[dry-run] 分析查询为只读命令，不会写入数据库")
+	}
+	return app, chain, analytics.NewService(app.repos, app.loc), nil
+}
+
+// runAnalyticsTop 持有人排行
+func runAnalyticsTop(args []string) error {
+	fs, flags := newAnalyticsFlagSet("analytics top")
+	limit := fs.Int("limit", 100, "显示的持有人数量")
+	timeValue := fs.String("time", "", "时间点（默认: 当前余额）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *limit <= 0 {
+		return errors.New("--limit 必须大于0")
+	}
+
+	app, chain, service, err := openAnalytics(flags)
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	at, err := parseOptionalTime(*timeValue, app.loc)
+	if err != nil {
+		return err
+	}
+
+	result, err := service.TopHolders(chain.ChainID, at, *limit)
+	if err != nil {
+		return err
+	}
+	if *flags.asJSON {
+		return printJSON(result)
+	}
+
+	fmt.Printf("%s (chain_id=%d) 持有人 %d，余额合计 %s\n", chain.Name, chain.ChainID, result.HolderCount, result.TotalSupply)
+	for _, holder := range result.Holders {
+		fmt.Printf("  %4d  %s  %s  %.4f%%\n", holder.Rank, holder.Address, holder.Balance, holder.Share*100)
+	}
+	return nil
+}
+
+// runAnalyticsConcentration 持有集中度
+func runAnalyticsConcentration(args []string) error {
+	fs, flags := newAnalyticsFlagSet("analytics concentration")
+	timeValue := fs.String("time", "", "时间点（默认: 当前余额）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, chain, service, err := openAnalytics(flags)
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	at, err := parseOptionalTime(*timeValue, app.loc)
+	if err != nil {
+		return err
+	}
+
+	result, err := service.Concentration(chain.ChainID, at)
+	if err != nil {
+		return err
+	}
+	if *flags.asJSON {
+		return printJSON(result)
+	}
+
+	fmt.Printf("%s (chain_id=%d) 持有人 %d，余额合计 %s\n", chain.Name, chain.ChainID, result.HolderCount, result.TotalSupply)
+	fmt.Printf("  基尼系数:   %.4f\n", result.Gini)
+	fmt.Printf("  中本聪系数: %d\n", result.Nakamoto)
+	fmt.Printf("  HHI:        %.4f\n", result.HHI)
+	fmt.Printf("  前10名占比: %.2f%%\n", result.Top10Share*100)
+	fmt.Printf("  前100名占比: %.2f%%\n", result.Top100Share*100)
+	return nil
+}
+
+// runAnalyticsChurn 新增与流失持有人
+func runAnalyticsChurn(args []string) error {
+	fs, flags := newAnalyticsFlagSet("analytics churn")
+	fromValue := fs.String("from", "", "起始日期（默认: 7天前）")
+	toValue := fs.String("to", "", "结束日期（默认: 今天）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, chain, service, err := openAnalytics(flags)
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	from, to, err := parseWindow(*fromValue, *toValue, app.loc)
+	if err != nil {
+		return err
+	}
+
+	result, err := service.Churn(chain.ChainID, from, to)
+	if err != nil {
+		return err
+	}
+	if *flags.asJSON {
+		return printJSON(result)
+	}
+
+	fmt.Printf("%s (chain_id=%d) %s ~ %s（已汇总至 %s）\n", chain.Name, chain.ChainID, result.From, result.To, result.RolledUpUntil)
+	fmt.Printf("  持有人 %d -> %d，新增 %d，流失 %d\n", result.StartHolders, result.EndHolders, result.NewHolders, result.ChurnedHolders)
+	for _, day := range result.Days {
+		fmt.Printf("  %s  新增=%d 流失=%d 持有人=%d\n", day.Day, day.NewHolders, day.Churned, day.HolderCount)
+	}
+	return nil
+}
+
+// runAnalyticsFlows 每日净流量
+func runAnalyticsFlows(args []string) error {
+	fs, flags := newAnalyticsFlagSet("analytics flows")
+	fromValue := fs.String("from", "", "起始日期（默认: 7天前）")
+	toValue := fs.String("to", "", "结束日期（默认: 今天）")
+	user := fs.String("address", "", "地址（默认: 全链统计）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, chain, service, err := openAnalytics(flags)
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	from, to, err := parseWindow(*fromValue, *toValue, app.loc)
+	if err != nil {
+		return err
+	}
+
+	var result *analytics.Flows
+	if *user == "" {
+		result, err = service.ChainFlows(chain.ChainID, from, to)
+	} else {
+		address, addrErr := normalizeAddress(*user)
+		if addrErr != nil {
+			return addrErr
+		}
+		result, err = service.UserFlows(chain.ChainID, address, from, to)
+	}
+	if err != nil {
+		return err
+	}
+	if *flags.asJSON {
+		return printJSON(result)
+	}
+
+	fmt.Printf("%s (chain_id=%d) %s ~ %s（已汇总至 %s）净流量 %s\n",
+		chain.Name, chain.ChainID, result.From, result.To, result.RolledUpUntil, result.NetFlow)
+	for _, day := range result.Days {
+		fmt.Printf("  %s  铸造=%s 销毁=%s 净发行=%s 转账量=%s 活跃地址=%d\n",
+			day.Day, day.Minted, day.Burned, day.NetIssuance, day.TransferVolume, day.ActiveAddresses)
+	}
+	for _, day := range result.UserDays {
+		fmt.Printf("  %s  流入=%s 流出=%s 净流量=%s 余额 %s -> %s\n",
+			day.Day, day.Inflow, day.Outflow, day.NetFlow, day.OpeningBalance, day.ClosingBalance)
+	}
+	return nil
+}
+
+// runAnalyticsRollup 汇总每日统计
+func runAnalyticsRollup(args []string) error {
+	fs, dryRun := newFlagSet("analytics rollup")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	fromValue := fs.String("from", "", "从该日期开始重新汇总（默认: 从最后汇总的一天继续）")
+	untilValue := fs.String("until", "", "汇总到该日期（默认: 今天）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	from, err := parseOptionalTime(*fromValue, app.loc)
+	if err != nil {
+		return err
+	}
+	until := time.Now().In(app.loc)
+	if *untilValue != "" {
+		if until, err = parseTime(*untilValue, app.loc); err != nil {
+			return err
+		}
+	}
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	service := analytics.NewService(app.repos, app.loc)
+	for _, chain := range chains {
+		if *dryRun {
+			start, days, err := service.PendingRollupDays(chain.ChainID, from, until)
+			if err != nil {
+				return fmt.Errorf("计算汇总范围失败 (链: %s): %w", chain.Name, err)
+			}
+			if days == 0 {
+				fmt.Printf("[dry-run] %s: 没有需要汇总的数据\n", chain.Name)
+				continue
+			}
+			fmt.Printf("[dry-run] %s: 将从 %s 开始汇总 %d 天\n", chain.Name, start.Format("2006-01-02"), days)
+			continue
+		}
+
+		days, err := service.Rollup(chain.ChainID, from, until)
+		if err != nil {
+			return fmt.Errorf("汇总每日统计失败 (链: %s): %w", chain.Name, err)
+		}
+		fmt.Printf("%s: 已汇总 %d 天\n", chain.Name, days)
+	}
+	return nil
+}
+
+// parseOptionalTime 解析可选的时间参数，为空时返回零值
+func parseOptionalTime(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return parseTime(value, loc)
+}
+
+// parseWindow 解析日期窗口，默认以今天为结束的最近7天
+func parseWindow(fromValue, toValue string, loc *time.Location) (time.Time, time.Time, error) {
+	to := time.Now().In(loc)
+	if toValue != "" {
+		t, err := parseTime(toValue, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = t
+	}
+
+	from := to.AddDate(0, 0, -6)
+	if fromValue != "" {
+		t, err := parseTime(fromValue, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = t
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errors.New("起始日期不能晚于结束日期")
+	}
+	return from, to, nil
+}
+
+// printJSON 以缩进JSON格式输出
+func printJSON(value interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"erc20-tracker/backend/internal/auction"
+	"erc20-tracker/backend/internal/database"
+)
+
+// runAuction NFT拍卖查询命令
+func runAuction(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: auction contracts|list|nft|user [参数]")
+	}
+
+	switch args[0] {
+	case "contracts":
+		return runAuctionContracts(args[1:])
+	case "list":
+		return runAuctionList(args[1:])
+	case "nft":
+		return runAuctionNFT(args[1:])
+	case "user":
+		return runAuctionUser(args[1:])
+	default:
+		return fmt.Errorf("未知的auction子命令: %s", args[0])
+	}
+}
+
+// runAuctionContracts 查看已发现的拍卖合约
+func runAuctionContracts(args []string) error {
+	fs, _ := newFlagSet("auction contracts")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	contracts, err := auction.NewService(app.repos).Contracts(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("获取拍卖合约失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(contracts)
+	}
+
+	fmt.Printf("%d 个拍卖合约 (链: %s)\n", len(contracts), chain.Name)
+	for _, c := range contracts {
+		source := "配置"
+		if c.FactoryAddress != "" {
+			source = fmt.Sprintf("工厂#%d", c.FactoryID)
+		}
+		fmt.Printf("  %s 名称=%q 来源=%s 创建者=%s 启用=%t 部署区块=%d\n",
+			c.ContractAddress, c.Name, source, c.Creator, c.Active, c.DeployedBlock)
+	}
+	return nil
+}
+
+// runAuctionList 按条件查看拍卖
+func runAuctionList(args []string) error {
+	fs, _ := newFlagSet("auction list")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	contract := fs.String("contract", "", "拍卖合约地址")
+	seller := fs.String("seller", "", "卖家地址")
+	status := fs.String("status", "", "拍卖状态: active、ended、cancelled")
+	limit := fs.Int("limit", 20, "显示的拍卖数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	filter := database.AuctionFilter{Status: *status, Limit: *limit}
+	var err error
+	if *contract != "" {
+		if filter.ContractAddress, err = normalizeAddress(*contract); err != nil {
+			return err
+		}
+	}
+	if *seller != "" {
+		if filter.Seller, err = normalizeAddress(*seller); err != nil {
+			return err
+		}
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+	filter.ChainID = chain.ChainID
+
+	auctions, err := auction.NewService(app.repos).Auctions(filter)
+	if err != nil {
+		return fmt.Errorf("获取拍卖失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(auctions)
+	}
+
+	fmt.Printf("%d 个拍卖 (链: %s)\n", len(auctions), chain.Name)
+	for _, a := range auctions {
+		printAuction(a)
+	}
+	return nil
+}
+
+// runAuctionNFT 查看一个NFT的拍卖历史
+func runAuctionNFT(args []string) error {
+	fs, _ := newFlagSet("auction nft")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	nft := fs.String("nft", "", "NFT合约地址")
+	tokenID := fs.String("token-id", "", "NFT TokenID")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *nft == "" || *tokenID == "" {
+		return errors.New("必须指定 --chain、--nft 和 --token-id")
+	}
+	nftAddress, err := normalizeAddress(*nft)
+	if err != nil {
+		return err
+	}
+	id, ok := new(big.Int).SetString(*tokenID, 10)
+	if !ok || id.Sign() < 0 {
+		return fmt.Errorf("无效的TokenID: %s", *tokenID)
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	history, err := auction.NewService(app.repos).NFT(chain.ChainID, nftAddress, id.String())
+	if err != nil {
+		return fmt.Errorf("获取NFT拍卖历史失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(history)
+	}
+
+	fmt.Printf("NFT %s #%s (链: %s) 共 %d 次拍卖\n", history.NFTContract, history.TokenID, chain.Name, len(history.Auctions))
+	for _, d := range history.Auctions {
+		printAuction(d.Auction)
+		for _, b := range d.Bids {
+			printAuctionBid(b)
+		}
+	}
+	return nil
+}
+
+// runAuctionUser 查看用户的拍卖活动
+func runAuctionUser(args []string) error {
+	fs, _ := newFlagSet("auction user")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	user := fs.String("user", "", "用户地址")
+	limit := fs.Int("limit", 20, "显示的最近出价数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *user == "" {
+		return errors.New("必须指定 --chain 和 --user")
+	}
+	address, err := normalizeAddress(*user)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	view, err := auction.NewService(app.repos).User(chain.ChainID, address, *limit)
+	if err != nil {
+		return fmt.Errorf("获取用户拍卖信息失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(view)
+	}
+
+	fmt.Printf("用户 %s (链: %s) 出价=%d 赢得=%d 赢得总额(USD)=%s 售出总额(USD)=%s\n",
+		view.Address, chain.Name, view.BidCount, view.WonCount, view.WonUSD, view.SoldUSD)
+	fmt.Println("发起的拍卖:")
+	for _, a := range view.Selling {
+		printAuction(a)
+	}
+	fmt.Println("赢得的拍卖:")
+	for _, a := range view.Won {
+		printAuction(a)
+	}
+	fmt.Println("最近的出价:")
+	for _, b := range view.RecentBids {
+		printAuctionBid(b)
+	}
+	return nil
+}
+
+// printAuction 输出一个拍卖
+func printAuction(a database.Auction) {
+	fmt.Printf("  %s#%d NFT=%s #%s 卖家=%s 状态=%s 出价数=%d 最高出价(USD)=%s 保留价(USD)=%s",
+		a.ContractAddress, a.AuctionID, a.NFTContract, a.TokenID, a.Seller, a.Status,
+		a.BidCount, a.HighestBidUSD, a.ReservePriceUSD)
+	if a.Sold {
+		fmt.Printf(" 赢家=%s 成交=%s %s 平台费=%s 卖家所得=%s",
+			a.Winner, a.BidAmount, bidTokenLabel(a.BidToken), a.PlatformFee, a.SellerProceeds)
+	}
+	fmt.Println()
+}
+
+// printAuctionBid 输出一条出价
+func printAuctionBid(b database.AuctionBid) {
+	fmt.Printf("    %s#%d 出价者=%s 数量=%s %s USD=%s 状态=%s 区块=%d\n",
+		b.ContractAddress, b.AuctionID, b.Bidder, b.BidAmount, bidTokenLabel(b.BidToken),
+		b.BidUSD, b.Status, b.BlockNumber)
+}
+
+// bidTokenLabel 出价代币的显示名称，零地址表示ETH
+func bidTokenLabel(token string) string {
+	if token == "" || token == (common.Address{}).Hex() {
+		return "ETH"
+	}
+	return token
+}
@@ -0,0 +1,1110 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/internal/reconcile"
+	"erc20-tracker/backend/internal/replay"
+	"erc20-tracker/backend/internal/snapshot"
+	"erc20-tracker/backend/internal/stream"
+	"erc20-tracker/backend/pkg/utils"
+)
+
+// command 管理命令定义
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+// commands 返回所有可用的子命令
+func commands() []command {
+	return []command{
+		{name: "serve", summary: "启动事件监听与积分计算服务（默认命令）", run: runServe},
+		{name: "sync", summary: "手动同步指定区块范围: sync --chain <链> --from <区块> [--to <区块>]", run: runSync},
+		{name: "points", summary: "积分管理: points backfill --from <时间> --to <时间> | points recompute --user <地址>", run: runPoints},
+		{name: "balance", summary: "余额查询: balance show --user <地址> [--chain <链>]", run: runBalance},
+		{name: "status", summary: "查看各链同步状态: status [--chain <链>]", run: runStatus},
+		{name: "reconcile", summary: "链上余额对账: reconcile --chain <链> [--sample N] [--heal] [--json]", run: runReconcile},
+		{name: "quarantine", summary: "隔离事件管理: quarantine list | replay --chain <链> --user <地址> | resolve --id <ID> --note <说明>", run: runQuarantine},
+		{name: "replay", summary: "从原始日志归档重放到影子库并与生产数据比较: replay --chain <链> --shadow-db <库名>", run: runReplay},
+		{name: "snapshot", summary: "历史持有人快照: snapshot show --chain <链> --block N|--time T [--format csv|json] | materialize | list", run: runSnapshot},
+		{name: "analytics", summary: "持有人分析: analytics top|concentration|churn|flows --chain <链> | rollup [--from 日期]", run: runAnalytics},
+		{name: "events", summary: "通用合约事件: events list|summary --chain <链> | abi | redecode [--chain <链>]", run: runEvents},
+		{name: "stake", summary: "质押合约查询: stake pools --chain <链> | user --chain <链> --user <地址>", run: runStake},
+		{name: "auction", summary: "NFT拍卖查询: auction contracts|list --chain <链> | nft --chain <链> --nft <合约> --token-id <ID> | user --chain <链> --user <地址>", run: runAuction},
+		{name: "donation", summary: "捐赠合约查询: donation campaign|leaderboard|withdrawals --chain <链> | donor --chain <链> --donor <地址>", run: runDonation},
+		{name: "tax", summary: "带税代币查询: tax summary|distributions --chain <链> | revenue --chain <链> [--from --to] | payer --chain <链> --payer <地址>", run: runTax},
+		{name: "dex", summary: "交易对查询: dex pairs --chain <链> | prices|swaps --chain <链> --pair <交易对> | positions --chain <链> --address <地址>", run: runDex},
+		{name: "webhook", summary: "Webhook订阅管理: webhook add|list|enable|disable|deliveries|redeliver|test", run: runWebhook},
+		{name: "reset-cursor", summary: "重置同步游标: reset-cursor --chain <链> --block <区块>", run: runResetCursor},
+	}
+}
+
+// runCommand 解析并执行子命令，未指定子命令时默认执行serve
+func runCommand(args []string) error {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		printUsage()
+		return nil
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return runServe(args)
+	}
+
+	name := args[0]
+
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd.run(args[1:])
+		}
+	}
+
+	printUsage()
+	return fmt.Errorf("未知命令: %s", name)
+}
+
+// printUsage 打印命令帮助
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "用法: tracker <命令> [参数]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "命令:")
+	for _, cmd := range commands() {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "所有命令均支持 --dry-run，仅输出将要执行的操作而不写入数据库")
+}
+
+// newFlagSet 创建带--dry-run参数的参数解析器
+func newFlagSet(name string) (*flag.FlagSet, *bool) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "只输出将要执行的操作，不写入数据库")
+	return fs, dryRun
+}
+
+// flagSet 命令行中是否显式指定了该参数
+func flagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// runServe 启动完整服务
+func runServe(args []string) error {
+	fs, dryRun := newFlagSet("serve")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+
+	if *dryRun {
+		defer app.Close()
+		fmt.Println("[dry-run] 将启动以下链的事件监听:")
+		for _, chain := range app.config.GetEnabledChains() {
+			fmt.Printf("  %s (chain_id=%d) 合约=%s 起始区块=%d\n",
+				chain.Name, chain.ChainID, chain.ContractAddress, chain.StartBlock)
+		}
+		fmt.Printf("[dry-run] 确认区块数=%d 时区=%s\n", app.config.System.ConfirmationBlocks, app.config.Timezone)
+		return nil
+	}
+
+	return app.Run()
+}
+
+// runSync 手动同步区块范围
+func runSync(args []string) error {
+	fs, dryRun := newFlagSet("sync")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	from := fs.Uint64("from", 0, "起始区块")
+	to := fs.Uint64("to", 0, "结束区块（默认: 最新区块减去确认区块数）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	listener, err := app.newListener(chain)
+	if err != nil {
+		return err
+	}
+
+	toBlock := *to
+	if toBlock == 0 {
+		latest, err := listener.LatestBlock()
+		if err != nil {
+			return fmt.Errorf("获取最新区块号失败: %w", err)
+		}
+		confirmations := uint64(app.config.System.ConfirmationBlocks)
+		if latest < confirmations {
+			return fmt.Errorf("最新区块 %d 小于确认区块数 %d", latest, confirmations)
+		}
+		toBlock = latest - confirmations
+	}
+	if *from > toBlock {
+		return fmt.Errorf("起始区块 %d 大于结束区块 %d", *from, toBlock)
+	}
+
+	if *dryRun {
+		logs, err := listener.FetchLogs(*from, toBlock)
+		if err != nil {
+			return err
+		}
+		lastSynced, err := app.repos.BlockSyncStatus.GetLastSyncedBlock(chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取最后同步区块失败: %w", err)
+		}
+		fmt.Printf("[dry-run] 链 %s 区块 %d-%d 共有 %d 条事件待处理\n", chain.Name, *from, toBlock, len(logs))
+		if toBlock > lastSynced {
+			fmt.Printf("[dry-run] 同步游标将从 %d 推进到 %d\n", lastSynced, toBlock)
+		} else {
+			fmt.Printf("[dry-run] 同步游标保持 %d 不变\n", lastSynced)
+		}
+		return nil
+	}
+
+	publisher, err := app.streamPublisher()
+	if err != nil {
+		return err
+	}
+	if publisher != nil {
+		listener.SetPublisher(publisher)
+	}
+
+	if err := listener.SyncRange(*from, toBlock); err != nil {
+		return err
+	}
+	fmt.Printf("链 %s 区块 %d-%d 同步完成\n", chain.Name, *from, toBlock)
+	return nil
+}
+
+// runPoints 积分管理命令
+func runPoints(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: points backfill|recompute [参数]")
+	}
+
+	switch args[0] {
+	case "backfill":
+		return runPointsBackfill(args[1:])
+	case "recompute":
+		return runPointsRecompute(args[1:])
+	default:
+		return fmt.Errorf("未知的points子命令: %s", args[0])
+	}
+}
+
+// runPointsBackfill 回溯计算时间范围内的积分
+func runPointsBackfill(args []string) error {
+	fs, dryRun := newFlagSet("points backfill")
+	fromValue := fs.String("from", "", "开始时间（RFC3339 或 2006-01-02 15:04）")
+	toValue := fs.String("to", "", "结束时间（默认: 当前时间）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *fromValue == "" {
+		return errors.New("必须指定 --from")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	fromTime, err := parseTime(*fromValue, app.loc)
+	if err != nil {
+		return err
+	}
+	toTime := time.Now().In(app.loc)
+	if *toValue != "" {
+		if toTime, err = parseTime(*toValue, app.loc); err != nil {
+			return err
+		}
+	}
+	if !fromTime.Before(toTime) {
+		return fmt.Errorf("开始时间 %s 必须早于结束时间 %s", fromTime, toTime)
+	}
+
+	if *dryRun {
+		hours := int(toTime.Sub(fromTime).Hours() + 0.999999)
+		fmt.Printf("[dry-run] 将按小时回溯计算 %s 至 %s 的积分（共 %d 个时间段）\n",
+			fromTime.Format(time.RFC3339), toTime.Format(time.RFC3339), hours)
+		for _, chain := range app.config.GetEnabledChains() {
+			users, err := app.repos.UserPoints.GetUsersNeedingCalculation(chain.ChainID, toTime)
+			if err != nil {
+				return fmt.Errorf("获取需要计算积分的用户失败 (链: %s): %w", chain.Name, err)
+			}
+			fmt.Printf("  %s (chain_id=%d): %d 个用户待计算\n", chain.Name, chain.ChainID, len(users))
+		}
+		return nil
+	}
+
+	if err := app.calculator.BackfillPoints(fromTime, toTime); err != nil {
+		return err
+	}
+	fmt.Printf("积分回溯完成: %s 至 %s\n", fromTime.Format(time.RFC3339), toTime.Format(time.RFC3339))
+	return nil
+}
+
+// runPointsRecompute 重新计算指定用户的积分
+func runPointsRecompute(args []string) error {
+	fs, dryRun := newFlagSet("points recompute")
+	userValue := fs.String("user", "", "用户地址")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	user, err := normalizeAddress(*userValue)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(app.loc)
+	var failed int
+	for _, chain := range chains {
+		if *dryRun {
+			firstChange, err := app.repos.BalanceChange.GetFirstChangeTime(user, chain.ChainID)
+			if err != nil {
+				return fmt.Errorf("获取首次余额变动时间失败 (链: %s): %w", chain.Name, err)
+			}
+			if firstChange.IsZero() {
+				fmt.Printf("[dry-run] %s: 用户没有余额变动记录，跳过\n", chain.Name)
+				continue
+			}
+			pointsList, err := app.repos.UserPoints.ListByUser(user)
+			if err != nil {
+				return fmt.Errorf("获取用户积分失败 (链: %s): %w", chain.Name, err)
+			}
+			current := 0.0
+			for _, p := range pointsList {
+				if p.ChainID == chain.ChainID {
+					current = p.TotalPoints
+				}
+			}
+			fmt.Printf("[dry-run] %s: 当前积分 %.4f 将清零，并从 %s 重新计算到 %s\n",
+				chain.Name, current, firstChange.Format(time.RFC3339), now.Format(time.RFC3339))
+			continue
+		}
+
+		if err := app.calculator.RecomputeUserPoints(user, chain.ChainID, now); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: 重新计算失败: %v\n", chain.Name, err)
+			failed++
+			continue
+		}
+		points, err := app.repos.UserPoints.GetPoints(user, chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取用户积分失败 (链: %s): %w", chain.Name, err)
+		}
+		fmt.Printf("%s: 重新计算完成，当前积分 %.4f\n", chain.Name, points)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d 条链的积分重新计算失败", failed)
+	}
+	return nil
+}
+
+// runBalance 余额查询命令
+func runBalance(args []string) error {
+	if len(args) == 0 || args[0] != "show" {
+		return errors.New("用法: balance show --user <地址> [--chain <链>]")
+	}
+
+	fs, dryRun := newFlagSet("balance show")
+	userValue := fs.String("user", "", "用户地址")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	user, err := normalizeAddress(*userValue)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *dryRun {
+		fmt.Println("[dry-run] balance show 为只读命令，不会写入数据库")
+	}
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	balances, err := app.repos.UserBalance.ListByUser(user)
+	if err != nil {
+		return fmt.Errorf("查询用户余额失败: %w", err)
+	}
+	pointsList, err := app.repos.UserPoints.ListByUser(user)
+	if err != nil {
+		return fmt.Errorf("查询用户积分失败: %w", err)
+	}
+
+	fmt.Printf("用户: %s\n", user)
+	for _, chain := range chains {
+		balance := "0"
+		for _, b := range balances {
+			if b.ChainID == chain.ChainID {
+				balance = utils.FormatTokenAmount(b.GetBalanceBigInt(), 18)
+			}
+		}
+		points := 0.0
+		lastCalculated := "-"
+		for _, p := range pointsList {
+			if p.ChainID == chain.ChainID {
+				points = p.TotalPoints
+				lastCalculated = p.LastCalculatedAt.In(app.loc).Format(time.RFC3339)
+			}
+		}
+		fmt.Printf("  %-14s 余额=%s 积分=%.4f 最后计算=%s\n", chain.Name, balance, points, lastCalculated)
+	}
+	return nil
+}
+
+// runStatus 查看同步状态
+func runStatus(args []string) error {
+	fs, dryRun := newFlagSet("status")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *dryRun {
+		fmt.Println("[dry-run] status 为只读命令，不会写入数据库")
+	}
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	cursors, err := app.repos.StreamCursor.ListCursors()
+	if err != nil {
+		return fmt.Errorf("获取事件流发布位置失败: %w", err)
+	}
+
+	var failed int
+	for _, chain := range chains {
+		status, err := app.repos.BlockSyncStatus.GetOrCreate(chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取同步状态失败 (链: %s): %w", chain.Name, err)
+		}
+		holders, err := app.repos.UserBalance.CountHolders(chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("统计持有人失败 (链: %s): %w", chain.Name, err)
+		}
+		changes, err := app.repos.BalanceChange.CountByChain(chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("统计余额变动失败 (链: %s): %w", chain.Name, err)
+		}
+
+		latest := "不可用"
+		lag := "-"
+		listener, err := app.newListener(&chain)
+		if err == nil {
+			if block, err := listener.LatestBlock(); err == nil {
+				latest = fmt.Sprintf("%d", block)
+				if block >= status.LastSyncedBlock {
+					lag = fmt.Sprintf("%d", block-status.LastSyncedBlock)
+				}
+			} else {
+				failed++
+			}
+		} else {
+			failed++
+		}
+
+		fmt.Printf("%s (chain_id=%d)\n", chain.Name, chain.ChainID)
+		fmt.Printf("  最后同步区块: %d (%s)\n", status.LastSyncedBlock, status.LastSyncedAt.In(app.loc).Format(time.RFC3339))
+		fmt.Printf("  链上最新区块: %s  落后区块数: %s\n", latest, lag)
+		fmt.Printf("  持有人数: %d  余额变动记录: %d\n", holders, changes)
+		for _, cursor := range cursors {
+			if cursor.ChainID == chain.ChainID {
+				fmt.Printf("  事件流 %s: 已发布至区块 %d 日志 %d\n", cursor.Sink, cursor.BlockNumber, cursor.LogIndex)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d 条链的RPC不可用", failed)
+	}
+	return nil
+}
+
+// runResetCursor 重置同步游标
+func runResetCursor(args []string) error {
+	fs, dryRun := newFlagSet("reset-cursor")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	block := fs.Uint64("block", 0, "新的最后同步区块号（下次从该区块+1开始同步），必填")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+	// 默认值0会把游标退回创世区块，必须显式指定
+	if !flagSet(fs, "block") {
+		return errors.New("必须指定 --block")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	current, err := app.repos.BlockSyncStatus.GetLastSyncedBlock(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("获取最后同步区块失败: %w", err)
+	}
+
+	if *dryRun {
+		fmt.Printf("[dry-run] 链 %s 的同步游标将从 %d 重置为 %d\n", chain.Name, current, *block)
+		return nil
+	}
+
+	if err := app.repos.BlockSyncStatus.UpdateLastSyncedBlock(chain.ChainID, *block); err != nil {
+		return fmt.Errorf("重置同步游标失败: %w", err)
+	}
+	fmt.Printf("链 %s 的同步游标已从 %d 重置为 %d\n", chain.Name, current, *block)
+	return nil
+}
+
+// runReconcile 执行一轮链上余额对账
+func runReconcile(args []string) error {
+	fs, dryRun := newFlagSet("reconcile")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	sample := fs.Int("sample", 0, "随机抽样地址数（默认: 全量扫描）")
+	heal := fs.Bool("heal", false, "为偏差写入修正记录并更新余额")
+	asJSON := fs.Bool("json", false, "以JSON格式输出报告")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun && *heal {
+		fmt.Println("[dry-run] 仅报告偏差，不写入修正记录")
+	}
+
+	var reports []*reconcile.Report
+	var drifted int
+	for _, chain := range chains {
+		reconciler, err := reconcile.NewReconciler(chain, app.repos, app.config)
+		if err != nil {
+			return fmt.Errorf("创建对账器失败 (链: %s): %w", chain.Name, err)
+		}
+		report, err := reconciler.Run(app.ctx, reconcile.Options{
+			SampleSize: *sample,
+			Heal:       *heal && !*dryRun,
+		})
+		reconciler.Close()
+		if err != nil {
+			return fmt.Errorf("对账失败 (链: %s): %w", chain.Name, err)
+		}
+		reports = append(reports, report)
+		drifted += len(report.Drifts) - report.Healed
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(reports); err != nil {
+			return err
+		}
+	} else {
+		for _, report := range reports {
+			fmt.Printf("%s (chain_id=%d) 区块 %d: 检查 %d 个地址，偏差 %d 个，已修正 %d 个\n",
+				report.ChainName, report.ChainID, report.BlockNumber, report.Checked, len(report.Drifts), report.Healed)
+			for _, drift := range report.Drifts {
+				fmt.Printf("  %s 数据库=%s 链上=%s 差值=%s\n",
+					drift.UserAddress, drift.Stored, drift.OnChain, drift.Diff)
+				if drift.Conflict {
+					fmt.Println("    对账后该地址余额已变动，未执行修正，请重新对账")
+				}
+			}
+			if report.HealSkipped {
+				fmt.Println("  对账期间同步游标已移动，未执行修正，请重试")
+			}
+		}
+	}
+
+	// 存在未修正的偏差时以非零状态码退出，便于在脚本中告警
+	if drifted > 0 {
+		return fmt.Errorf("发现 %d 个未修正的余额偏差", drifted)
+	}
+	return nil
+}
+
+// runQuarantine 隔离事件管理命令
+func runQuarantine(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: quarantine list|replay|resolve [参数]")
+	}
+
+	switch args[0] {
+	case "list":
+		return runQuarantineList(args[1:])
+	case "replay":
+		return runQuarantineReplay(args[1:])
+	case "resolve":
+		return runQuarantineResolve(args[1:])
+	default:
+		return fmt.Errorf("未知的quarantine子命令: %s", args[0])
+	}
+}
+
+// runQuarantineList 列出待处理的隔离事件
+func runQuarantineList(args []string) error {
+	fs, dryRun := newFlagSet("quarantine list")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有链）")
+	userValue := fs.String("user", "", "用户地址（默认: 所有地址）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *dryRun {
+		fmt.Println("[dry-run] quarantine list 为只读命令，不会写入数据库")
+	}
+
+	var chainID int64
+	if *chainKey != "" {
+		chain, err := app.config.FindChain(*chainKey)
+		if err != nil {
+			return err
+		}
+		chainID = chain.ChainID
+	}
+	user := ""
+	if *userValue != "" {
+		if user, err = normalizeAddress(*userValue); err != nil {
+			return err
+		}
+	}
+
+	events, err := app.repos.QuarantinedEvent.ListPending(chainID, user)
+	if err != nil {
+		return fmt.Errorf("获取隔离事件失败: %w", err)
+	}
+
+	fmt.Printf("待处理的隔离事件: %d 条\n", len(events))
+	for _, event := range events {
+		printQuarantinedEvent(event)
+	}
+	return nil
+}
+
+// runQuarantineReplay 在问题修复后重放地址的隔离事件
+func runQuarantineReplay(args []string) error {
+	fs, dryRun := newFlagSet("quarantine replay")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	userValue := fs.String("user", "", "被冻结的用户地址")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+	user, err := normalizeAddress(*userValue)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		events, err := app.repos.QuarantinedEvent.ListPending(chain.ChainID, user)
+		if err != nil {
+			return fmt.Errorf("获取隔离事件失败: %w", err)
+		}
+		balance, err := app.repos.UserBalance.GetBalance(user, chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取用户余额失败: %w", err)
+		}
+		fmt.Printf("[dry-run] 将按顺序重放 %d 条隔离事件，当前余额 %s\n", len(events), balance)
+		for _, event := range events {
+			printQuarantinedEvent(event)
+		}
+		return nil
+	}
+
+	listener, err := app.newListener(chain)
+	if err != nil {
+		return err
+	}
+
+	replayed, err := listener.ReplayQuarantined(user)
+	fmt.Printf("已重放 %d 条隔离事件\n", replayed)
+	if err != nil {
+		return err
+	}
+
+	frozen, err := app.repos.QuarantinedEvent.IsFrozen(user, chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("检查地址冻结状态失败: %w", err)
+	}
+	if !frozen {
+		fmt.Printf("地址 %s 已在链 %s 上解除冻结\n", user, chain.Name)
+	}
+	return nil
+}
+
+// runQuarantineResolve 人工处理隔离事件，不写入余额
+func runQuarantineResolve(args []string) error {
+	fs, dryRun := newFlagSet("quarantine resolve")
+	id := fs.Uint64("id", 0, "隔离事件ID")
+	note := fs.String("note", "", "处理说明")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == 0 || *note == "" {
+		return errors.New("必须指定 --id 和 --note")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	event, err := app.repos.QuarantinedEvent.GetByID(*id)
+	if err != nil {
+		return fmt.Errorf("获取隔离事件失败: %w", err)
+	}
+	if event.Status != database.QuarantineStatusPending {
+		return fmt.Errorf("隔离事件 %d 的状态为 %s，无需处理", event.ID, event.Status)
+	}
+
+	if *dryRun {
+		fmt.Println("[dry-run] 将把以下隔离事件标记为已处理（不写入余额）:")
+		printQuarantinedEvent(*event)
+		return nil
+	}
+
+	if err := app.repos.QuarantinedEvent.MarkStatus(event.ID, database.QuarantineStatusResolved, *note); err != nil {
+		return fmt.Errorf("更新隔离事件状态失败: %w", err)
+	}
+	fmt.Printf("隔离事件 %d 已标记为已处理\n", event.ID)
+	return nil
+}
+
+// printQuarantinedEvent 打印隔离事件
+func printQuarantinedEvent(event database.QuarantinedEvent) {
+	fmt.Printf("  #%d chain_id=%d 用户=%s 区块=%d tx=%s log=%d\n    原因: %s\n",
+		event.ID, event.ChainID, event.UserAddress, event.BlockNumber, event.TxHash, event.LogIndex, event.Reason)
+}
+
+// runReplay 从原始日志归档重建派生数据并与生产库比较
+func runReplay(args []string) error {
+	fs, dryRun := newFlagSet("replay")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	shadowDB := fs.String("shadow-db", "", "影子库名称（不存在时自动创建，派生表会被清空）")
+	from := fs.Uint64("from", 0, "起始区块（不能晚于第一条归档日志）")
+	to := fs.Uint64("to", 0, "结束区块（默认: 归档中的全部日志）")
+	pointsUntilValue := fs.String("points-until", "", "生产库中没有积分记录的用户，积分计算到该时间（默认: 当前时间）")
+	asJSON := fs.Bool("json", false, "以JSON格式输出结果")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *shadowDB == "" {
+		return errors.New("必须指定 --chain 和 --shadow-db")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *shadowDB == app.config.Database.DBName {
+		return fmt.Errorf("影子库不能与生产库 %s 相同", app.config.Database.DBName)
+	}
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	pointsUntil := time.Now().In(app.loc)
+	if *pointsUntilValue != "" {
+		if pointsUntil, err = parseTime(*pointsUntilValue, app.loc); err != nil {
+			return err
+		}
+	}
+
+	if err := replay.CheckFromBlock(app.repos, chain.ChainID, *from); err != nil {
+		return err
+	}
+
+	if *dryRun {
+		count, err := app.repos.RawEventLog.CountByRange(chain.ChainID, *from, *to)
+		if err != nil {
+			return fmt.Errorf("统计归档日志失败: %w", err)
+		}
+		fmt.Printf("[dry-run] 将清空影子库 %s 的派生表，并重放链 %s 的 %d 条归档日志\n", *shadowDB, chain.Name, count)
+		return nil
+	}
+
+	if err := database.EnsureDatabase(app.config, *shadowDB); err != nil {
+		return err
+	}
+	shadowConfig := *app.config
+	shadowConfig.Database.DBName = *shadowDB
+	target, err := database.NewDB(&shadowConfig)
+	if err != nil {
+		return fmt.Errorf("连接影子库失败: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := target.DB.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	engine := replay.NewEngine(*chain, app.config, app.repos, target)
+	result, err := engine.Run(replay.Options{
+		FromBlock:   *from,
+		ToBlock:     *to,
+		PointsUntil: pointsUntil,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("%s (chain_id=%d): 重放 %d 条日志，最后区块 %d，比较 %d 个地址，差异 %d 处\n",
+			result.ChainName, result.ChainID, result.LogsReplayed, result.LastBlock, result.UsersCompared, len(result.Mismatches))
+		for _, m := range result.Mismatches {
+			fmt.Printf("  %s %-12s 生产=%s 重放=%s\n", m.UserAddress, m.Field, m.Production, m.Replayed)
+		}
+		for _, c := range result.Corrections {
+			fmt.Printf("  %s 生产库有 %d 条对账修正记录（不计入变动记录数）\n", c.UserAddress, c.Count)
+		}
+	}
+
+	if len(result.Mismatches) > 0 {
+		return fmt.Errorf("重放结果与生产数据存在 %d 处差异", len(result.Mismatches))
+	}
+	return nil
+}
+
+// runSnapshot 历史快照命令
+func runSnapshot(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: snapshot show|materialize|list [参数]")
+	}
+
+	switch args[0] {
+	case "show":
+		return runSnapshotShow(args[1:])
+	case "materialize":
+		return runSnapshotMaterialize(args[1:])
+	case "list":
+		return runSnapshotList(args[1:])
+	default:
+		return fmt.Errorf("未知的snapshot子命令: %s", args[0])
+	}
+}
+
+// runSnapshotShow 计算并导出区块或时间点上的持有人快照
+func runSnapshotShow(args []string) error {
+	fs, dryRun := newFlagSet("snapshot show")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	block := fs.Uint64("block", 0, "区块号")
+	timeValue := fs.String("time", "", "时间点（RFC3339 或 2006-01-02 15:04）")
+	format := fs.String("format", "json", "输出格式: json|csv")
+	output := fs.String("output", "", "输出文件（默认: 标准输出）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+	if (*block == 0) == (*timeValue == "") {
+		return errors.New("必须且只能指定 --block 或 --time 之一")
+	}
+	if *format != "json" && *format != "csv" {
+		return fmt.Errorf("不支持的输出格式: %s", *format)
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *dryRun {
+		fmt.Println("[dry-run] snapshot show 为只读命令，不会写入数据库")
+	}
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	service := snapshot.NewService(app.repos, app.loc)
+	var snap *snapshot.Snapshot
+	if *block > 0 {
+		snap, err = service.AtBlock(chain.ChainID, *block)
+	} else {
+		var at time.Time
+		if at, err = parseTime(*timeValue, app.loc); err != nil {
+			return err
+		}
+		snap, err = service.AtTime(chain.ChainID, at)
+	}
+	if err != nil {
+		return err
+	}
+
+	writer := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("创建输出文件失败: %w", err)
+		}
+		defer file.Close()
+		writer = file
+	}
+
+	if *format == "csv" {
+		err = snapshot.WriteCSV(writer, snap)
+	} else {
+		err = snapshot.WriteJSON(writer, snap)
+	}
+	if err != nil {
+		return fmt.Errorf("输出快照失败: %w", err)
+	}
+
+	if *output != "" {
+		fmt.Printf("快照已写入 %s: 区块 %d，持有人 %d\n", *output, snap.BlockNumber, snap.HolderCount)
+	}
+	return nil
+}
+
+// runSnapshotMaterialize 保存物化快照
+func runSnapshotMaterialize(args []string) error {
+	fs, dryRun := newFlagSet("snapshot materialize")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	block := fs.Uint64("block", 0, "区块号（默认: 最后同步区块）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	service := snapshot.NewService(app.repos, app.loc)
+	for _, chain := range chains {
+		target := *block
+		if target == 0 {
+			if target, err = app.repos.BlockSyncStatus.GetLastSyncedBlock(chain.ChainID); err != nil {
+				return fmt.Errorf("获取最后同步区块失败 (链: %s): %w", chain.Name, err)
+			}
+		}
+
+		if *dryRun {
+			snap, err := service.AtBlock(chain.ChainID, target)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("[dry-run] %s: 将在区块 %d 保存物化快照（持有人 %d）\n", chain.Name, target, snap.HolderCount)
+			continue
+		}
+
+		record, err := service.Materialize(chain.ChainID, target)
+		if err != nil {
+			return fmt.Errorf("保存物化快照失败 (链: %s): %w", chain.Name, err)
+		}
+		fmt.Printf("%s: 区块 %d 的物化快照 #%d（持有人 %d）\n", chain.Name, record.BlockNumber, record.ID, record.HolderCount)
+	}
+	return nil
+}
+
+// runSnapshotList 列出物化快照
+func runSnapshotList(args []string) error {
+	fs, dryRun := newFlagSet("snapshot list")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有启用的链）")
+	limit := fs.Int("limit", 20, "每条链最多显示的快照数")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *dryRun {
+		fmt.Println("[dry-run] snapshot list 为只读命令，不会写入数据库")
+	}
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	for _, chain := range chains {
+		snapshots, err := app.repos.BalanceSnapshot.List(chain.ChainID, *limit)
+		if err != nil {
+			return fmt.Errorf("获取物化快照失败 (链: %s): %w", chain.Name, err)
+		}
+		fmt.Printf("%s (chain_id=%d): %d 个物化快照\n", chain.Name, chain.ChainID, len(snapshots))
+		for _, snap := range snapshots {
+			fmt.Printf("  #%d 区块=%d 持有人=%d 总量=%s 时间=%s\n",
+				snap.ID, snap.BlockNumber, snap.HolderCount, snap.TotalSupply, snap.TakenAt.In(app.loc).Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// newListener 为管理命令创建事件监听器，监听器随应用程序关闭
+func (app *Application) newListener(chain *config.ChainConfig) (*event.EventListener, error) {
+	listener, err := event.NewEventListener(*chain, app.repos, app.config)
+	if err != nil {
+		return nil, fmt.Errorf("创建事件监听器失败 (链: %s): %w", chain.Name, err)
+	}
+	app.listeners = append(app.listeners, listener)
+	return listener, nil
+}
+
+// streamPublisher 按配置创建事件流发布器（只创建一次），未配置输出时返回nil
+func (app *Application) streamPublisher() (stream.Publisher, error) {
+	if app.publisher != nil || len(app.config.Stream.Sinks) == 0 {
+		return app.publisher, nil
+	}
+
+	publisher, err := stream.New(app.config, app.repos.StreamCursor)
+	if err != nil {
+		return nil, fmt.Errorf("创建事件流发布器失败: %w", err)
+	}
+	app.publisher = publisher
+	return publisher, nil
+}
+
+// selectChains 根据参数选择链，为空时返回所有启用的链
+func (app *Application) selectChains(key string) ([]config.ChainConfig, error) {
+	if key == "" {
+		return app.config.GetEnabledChains(), nil
+	}
+	chain, err := app.config.FindChain(key)
+	if err != nil {
+		return nil, err
+	}
+	return []config.ChainConfig{*chain}, nil
+}
+
+// normalizeAddress 校验并规范化用户地址，与监听器写入的校验和格式保持一致
+func normalizeAddress(address string) (string, error) {
+	if !utils.IsValidAddress(address) {
+		return "", fmt.Errorf("无效的地址: %q", address)
+	}
+	return common.HexToAddress(address).Hex(), nil
+}
+
+// parseTime 解析命令行时间参数
+func parseTime(value string, loc *time.Location) (time.Time, error) {
+	layouts := []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t.In(loc), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无法解析时间: %q", value)
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"erc20-tracker/backend/internal/dex"
+)
+
+// runDex 交易对查询命令
+func runDex(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: dex pairs|prices|swaps|positions [参数]")
+	}
+
+	switch args[0] {
+	case "pairs":
+		return runDexPairs(args[1:])
+	case "prices":
+		return runDexPrices(args[1:])
+	case "swaps":
+		return runDexSwaps(args[1:])
+	case "positions":
+		return runDexPositions(args[1:])
+	default:
+		return fmt.Errorf("未知的dex子命令: %s", args[0])
+	}
+}
+
+// runDexPairs 列出已索引的交易对
+func runDexPairs(args []string) error {
+	fs, _ := newFlagSet("dex pairs")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	pairs, err := dex.NewService(app.repos, app.loc).Pairs(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("获取交易对失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(pairs)
+	}
+
+	fmt.Printf("%d 个交易对 (链: %s)\n", len(pairs), chain.Name)
+	for _, p := range pairs {
+		fmt.Printf("  %s 计价=%s 来源=%s 价格=%g 储备=%s/%s LP=%s 成交=%d 笔 (%s/%s)\n",
+			p.PairAddress, p.QuoteAddress, p.Source, p.Price, p.ReserveToken, p.ReserveQuote,
+			p.LPSupply, p.SwapCount, p.VolumeToken, p.VolumeQuote)
+	}
+	return nil
+}
+
+// runDexPrices 查看交易对价格K线
+func runDexPrices(args []string) error {
+	fs, _ := newFlagSet("dex prices")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	pairValue := fs.String("pair", "", "交易对地址")
+	interval := fs.String("interval", dex.IntervalDay, "K线周期: 1h 或 1d")
+	fromValue := fs.String("from", "", "起始日期（默认: 7天前）")
+	toValue := fs.String("to", "", "结束日期（默认: 今天）")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *pairValue == "" {
+		return errors.New("必须指定 --chain 和 --pair")
+	}
+	pair, err := normalizeAddress(*pairValue)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+	from, to, err := parseWindow(*fromValue, *toValue, app.loc)
+	if err != nil {
+		return err
+	}
+
+	series, err := dex.NewService(app.repos, app.loc).Prices(chain.ChainID, pair, *interval, from, to)
+	if err != nil {
+		return fmt.Errorf("获取K线失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(series)
+	}
+
+	fmt.Printf("交易对 %s (链: %s) %s ~ %s %s K线\n", series.PairAddress, chain.Name, series.From, series.To, series.Interval)
+	for _, c := range series.Candles {
+		fmt.Printf("  %s  开=%g 高=%g 低=%g 收=%g 成交量=%s/%s (%d 笔)\n",
+			c.Start.Format(time.DateTime), c.Open, c.High, c.Low, c.Close,
+			c.VolumeToken, c.VolumeQuote, c.SwapCount)
+	}
+	return nil
+}
+
+// runDexSwaps 查看交易对最近的成交
+func runDexSwaps(args []string) error {
+	fs, _ := newFlagSet("dex swaps")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	pairValue := fs.String("pair", "", "交易对地址")
+	limit := fs.Int("limit", 20, "显示的成交数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *pairValue == "" {
+		return errors.New("必须指定 --chain 和 --pair")
+	}
+	pair, err := normalizeAddress(*pairValue)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	swaps, err := dex.NewService(app.repos, app.loc).Swaps(chain.ChainID, pair, *limit)
+	if err != nil {
+		return fmt.Errorf("获取成交记录失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(swaps)
+	}
+
+	fmt.Printf("交易对 %s (链: %s) 最近 %d 笔成交\n", pair, chain.Name, len(swaps))
+	for _, s := range swaps {
+		fmt.Printf("  %s %-4s 代币=%s 计价=%s 均价=%g 接收=%s 交易=%s\n",
+			s.Timestamp.In(app.loc).Format(time.DateTime), s.Side, s.AmountToken,
+			s.AmountQuote, s.Price, s.Recipient, s.TxHash)
+	}
+	return nil
+}
+
+// runDexPositions 查看地址的LP持仓
+func runDexPositions(args []string) error {
+	fs, _ := newFlagSet("dex positions")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	user := fs.String("address", "", "LP持有地址")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *user == "" {
+		return errors.New("必须指定 --chain 和 --address")
+	}
+	address, err := normalizeAddress(*user)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	positions, err := dex.NewService(app.repos, app.loc).Positions(chain.ChainID, address)
+	if err != nil {
+		return fmt.Errorf("获取LP持仓失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(positions)
+	}
+
+	fmt.Printf("地址 %s (链: %s) 持有 %d 个交易对的LP\n", address, chain.Name, len(positions))
+	for _, p := range positions {
+		fmt.Printf("  %s LP=%s 占比=%.4f%% 代币=%s 计价=%s\n",
+			p.PairAddress, p.LPBalance, p.Share*100, p.TokenAmount, p.QuoteAmount)
+	}
+	return nil
+}
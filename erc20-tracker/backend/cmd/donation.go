@@ -0,0 +1,223 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/donation"
+	"erc20-tracker/backend/pkg/utils"
+)
+
+// runDonation 捐赠合约查询命令
+func runDonation(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: donation campaign|leaderboard|donor|withdrawals [参数]")
+	}
+
+	switch args[0] {
+	case "campaign":
+		return runDonationCampaign(args[1:])
+	case "leaderboard":
+		return runDonationLeaderboard(args[1:])
+	case "donor":
+		return runDonationDonor(args[1:])
+	case "withdrawals":
+		return runDonationWithdrawals(args[1:])
+	default:
+		return fmt.Errorf("未知的donation子命令: %s", args[0])
+	}
+}
+
+// runDonationCampaign 查看捐赠汇总和时间窗口
+func runDonationCampaign(args []string) error {
+	fs, _ := newFlagSet("donation campaign")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	campaign, err := donation.NewService(app.repos).Campaign(chain.ChainID, time.Now())
+	if err != nil {
+		return fmt.Errorf("获取捐赠汇总失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(campaign)
+	}
+
+	fmt.Printf("捐赠合约 %s (链: %s)\n", campaign.ContractAddress, chain.Name)
+	fmt.Printf("  累计捐赠: %s ETH (%d 笔, %d 位捐赠者)\n",
+		formatWei(campaign.TotalDonated), campaign.DonationCount, campaign.DonorCount)
+	fmt.Printf("  累计提款: %s ETH (%d 次)  当前余额: %s ETH\n",
+		formatWei(campaign.TotalWithdrawn), campaign.WithdrawalCount, formatWei(campaign.Balance))
+	if campaign.WindowStart != nil && campaign.WindowEnd != nil {
+		fmt.Printf("  时间窗口: %s ~ %s (%s)\n",
+			campaign.WindowStart.In(app.loc).Format(time.DateTime),
+			campaign.WindowEnd.In(app.loc).Format(time.DateTime),
+			campaign.WindowStatus)
+	} else {
+		fmt.Printf("  时间窗口: %s\n", campaign.WindowStatus)
+	}
+	return nil
+}
+
+// runDonationLeaderboard 查看捐赠排行榜
+func runDonationLeaderboard(args []string) error {
+	fs, _ := newFlagSet("donation leaderboard")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	offset := fs.Int("offset", 0, "从第几名之后开始（0表示第一名）")
+	limit := fs.Int("limit", 20, "显示的捐赠者数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+	if *offset < 0 || *limit <= 0 {
+		return errors.New("--offset 不能为负数，--limit 必须大于0")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	entries, err := donation.NewService(app.repos).Leaderboard(chain.ChainID, *offset, *limit)
+	if err != nil {
+		return fmt.Errorf("获取捐赠排行榜失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(entries)
+	}
+
+	fmt.Printf("捐赠排行榜 (链: %s)\n", chain.Name)
+	for _, e := range entries {
+		fmt.Printf("  #%d %s %s ETH (%d 笔) 最近捐赠=%s\n",
+			e.Rank, e.Donor, formatWei(e.TotalAmount), e.DonationCount,
+			e.LastDonatedAt.In(app.loc).Format(time.DateTime))
+	}
+	return nil
+}
+
+// runDonationDonor 查看捐赠者的累计捐赠和捐赠记录
+func runDonationDonor(args []string) error {
+	fs, _ := newFlagSet("donation donor")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	user := fs.String("donor", "", "捐赠者地址")
+	limit := fs.Int("limit", 20, "显示的捐赠记录数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *user == "" {
+		return errors.New("必须指定 --chain 和 --donor")
+	}
+	address, err := normalizeAddress(*user)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	donor, err := donation.NewService(app.repos).Donor(chain.ChainID, address, *limit)
+	if err != nil {
+		return fmt.Errorf("获取捐赠者失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(donor)
+	}
+
+	fmt.Printf("捐赠者 %s (链: %s) 排名第%d 累计 %s ETH (%d 笔)\n",
+		donor.Donor, chain.Name, donor.Rank, formatWei(donor.TotalAmount), donor.DonationCount)
+	for _, d := range donor.Donations {
+		printDonation(d, app.loc)
+	}
+	return nil
+}
+
+// runDonationWithdrawals 查看提款记录
+func runDonationWithdrawals(args []string) error {
+	fs, _ := newFlagSet("donation withdrawals")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	limit := fs.Int("limit", 20, "显示的提款记录数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	withdrawals, err := donation.NewService(app.repos).Withdrawals(chain.ChainID, *limit)
+	if err != nil {
+		return fmt.Errorf("获取提款记录失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(withdrawals)
+	}
+
+	fmt.Printf("%d 条提款记录 (链: %s)\n", len(withdrawals), chain.Name)
+	for _, w := range withdrawals {
+		fmt.Printf("  %s 所有者=%s %s ETH 区块=%d 交易=%s\n",
+			w.Timestamp.In(app.loc).Format(time.DateTime), w.Owner, formatWei(w.Amount), w.BlockNumber, w.TxHash)
+	}
+	return nil
+}
+
+// printDonation 输出一条捐赠记录
+func printDonation(d database.Donation, loc *time.Location) {
+	fmt.Printf("  %s %s ETH 区块=%d 交易=%s\n",
+		d.Timestamp.In(loc).Format(time.DateTime), formatWei(d.Amount), d.BlockNumber, d.TxHash)
+}
+
+// formatWei 把wei金额格式化为ETH
+func formatWei(value string) string {
+	return utils.FormatTokenAmount(database.ParseDecimal(value), 18)
+}
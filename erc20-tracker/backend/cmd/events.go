@@ -0,0 +1,209 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/decoder"
+	"erc20-tracker/backend/internal/event"
+)
+
+// runEvents 通用合约事件命令
+func runEvents(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: events list|summary|abi|redecode [参数]")
+	}
+
+	switch args[0] {
+	case "list":
+		return runEventsList(args[1:])
+	case "summary":
+		return runEventsSummary(args[1:])
+	case "abi":
+		return runEventsABI(args[1:])
+	case "redecode":
+		return runEventsRedecode(args[1:])
+	default:
+		return fmt.Errorf("未知的events子命令: %s", args[0])
+	}
+}
+
+// runEventsList 查看保存的合约事件
+func runEventsList(args []string) error {
+	fs, _ := newFlagSet("events list")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	contract := fs.String("contract", "", "合约地址（默认: 全部）")
+	name := fs.String("event", "", "事件名（默认: 全部）")
+	unknown := fs.Bool("unknown", false, "只显示未能解码的事件")
+	limit := fs.Int("limit", 50, "最多显示的记录数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+	filter := database.ContractEventFilter{
+		ChainID:       chain.ChainID,
+		EventName:     *name,
+		UndecodedOnly: *unknown,
+		Limit:         *limit,
+	}
+	if *contract != "" {
+		if filter.ContractAddress, err = normalizeAddress(*contract); err != nil {
+			return err
+		}
+	}
+
+	events, err := app.repos.ContractEvent.List(filter)
+	if err != nil {
+		return fmt.Errorf("获取合约事件失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(events)
+	}
+
+	fmt.Printf("%d 条合约事件 (链: %s)\n", len(events), chain.Name)
+	for _, e := range events {
+		name := e.EventName
+		if !e.Decoded {
+			name = "未知 " + e.Topic0
+		}
+		fmt.Printf("  #%d 区块=%d %s 合约=%s 交易=%s:%d 时间=%s\n",
+			e.ID, e.BlockNumber, name, e.ContractAddress, e.TxHash, e.LogIndex,
+			e.Timestamp.In(app.loc).Format(time.RFC3339))
+		if e.Decoded {
+			fmt.Printf("      %s\n", e.Args)
+		}
+	}
+	return nil
+}
+
+// runEventsSummary 按合约和事件统计保存的事件数量
+func runEventsSummary(args []string) error {
+	fs, _ := newFlagSet("events summary")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	counts, err := app.repos.ContractEvent.CountByEvent(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("统计合约事件失败: %w", err)
+	}
+
+	fmt.Printf("链 %s 的合约事件:\n", chain.Name)
+	for _, c := range counts {
+		name := c.EventName
+		if name == "" {
+			name = "未知 " + c.Topic0
+		}
+		fmt.Printf("  %s %-40s %d\n", c.ContractAddress, name, c.Count)
+	}
+	return nil
+}
+
+// runEventsABI 列出注册表中的事件定义，只读取配置，不连接数据库
+func runEventsABI(args []string) error {
+	fs, _ := newFlagSet("events abi")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+	registry, err := decoder.Load(cfg.Decoder.ABIPaths)
+	if err != nil {
+		return fmt.Errorf("加载ABI失败: %w", err)
+	}
+
+	type definition struct {
+		Source    string `json:"source"`
+		Signature string `json:"signature"`
+		Topic0    string `json:"topic0"`
+	}
+	var defs []definition
+	for _, def := range registry.Definitions() {
+		defs = append(defs, definition{Source: def.Source, Signature: def.Event.Sig, Topic0: def.Event.ID.Hex()})
+	}
+
+	if *asJSON {
+		return printJSON(defs)
+	}
+
+	fmt.Printf("共 %d 个事件定义\n", len(defs))
+	for _, def := range defs {
+		fmt.Printf("  %-20s %-60s %s\n", def.Source, def.Signature, def.Topic0)
+	}
+	return nil
+}
+
+// runEventsRedecode 补充ABI后重新解码之前未能解码的事件
+func runEventsRedecode(args []string) error {
+	fs, dryRun := newFlagSet("events redecode")
+	chainKey := fs.String("chain", "", "链名称或链ID（默认: 所有链）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chains, err := app.selectChains(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	for _, chain := range chains {
+		processor, err := event.NewReplayProcessor(chain, app.repos, app.config)
+		if err != nil {
+			return fmt.Errorf("创建事件处理器失败 (链: %s): %w", chain.Name, err)
+		}
+
+		decoded, err := processor.RedecodeCaptured(*dryRun)
+		if err != nil {
+			return fmt.Errorf("重新解码失败 (链: %s): %w", chain.Name, err)
+		}
+		if *dryRun {
+			fmt.Printf("[dry-run] 链 %s: 可重新解码 %d 条事件\n", chain.Name, decoded)
+		} else {
+			fmt.Printf("链 %s: 已重新解码 %d 条事件\n", chain.Name, decoded)
+		}
+	}
+	return nil
+}
@@ -11,11 +11,17 @@ import (
 
 	"github.com/robfig/cron/v3"
 
+	"erc20-tracker/backend/internal/analytics"
+	"erc20-tracker/backend/internal/api"
 	"erc20-tracker/backend/internal/config"
 	"erc20-tracker/backend/internal/database"
 	"erc20-tracker/backend/internal/event"
 	"erc20-tracker/backend/internal/points"
+	"erc20-tracker/backend/internal/reconcile"
 	"erc20-tracker/backend/internal/retry"
+	"erc20-tracker/backend/internal/snapshot"
+	"erc20-tracker/backend/internal/stream"
+	"erc20-tracker/backend/internal/webhook"
 	"erc20-tracker/backend/pkg/logger"
 )
 
@@ -28,6 +34,8 @@ type Application struct {
 	calculator *points.PointsCalculator
 	retryMgr   *retry.RetryManager
 	cronJob    *cron.Cron
+	apiServer  *api.Server
+	publisher  stream.Publisher
 	ctx        context.Context
 	cancel     context.CancelFunc
 	wg         sync.WaitGroup
@@ -103,6 +111,36 @@ func (app *Application) Start() error {
 		return fmt.Errorf("启动事件监听器失败: %w", err)
 	}
 
+	// 启动余额对账
+	if app.config.Reconcile.Enabled {
+		if err := app.startReconcilers(); err != nil {
+			return fmt.Errorf("启动余额对账失败: %w", err)
+		}
+	}
+
+	// 启动物化快照任务
+	if app.config.Snapshot.Enabled {
+		app.startSnapshotJob()
+	}
+
+	// 启动每日统计汇总任务
+	if app.config.Analytics.RollupEnabled {
+		app.startRollupJob()
+	}
+
+	// 启动Webhook投递
+	if app.config.Webhook.Enabled {
+		app.startWebhooks()
+	}
+
+	// 启动查询API
+	if app.config.API.Enabled {
+		app.apiServer = api.NewServer(app.config, app.repos, app.loc)
+		if err := app.apiServer.Start(); err != nil {
+			return fmt.Errorf("启动查询API失败: %w", err)
+		}
+	}
+
 	// 启动定时任务
 	//if err := app.startCronJobs(); err != nil {
 	//	return fmt.Errorf("启动定时任务失败: %w", err)
@@ -132,11 +170,19 @@ func (app *Application) startEventListeners() error {
 
 	logger.WithField("chains_count", len(enabledChains)).Info("启动事件监听器")
 
+	publisher, err := app.streamPublisher()
+	if err != nil {
+		return err
+	}
+
 	for _, chainConfig := range enabledChains {
 		listener, err := event.NewEventListener(chainConfig, app.repos, app.config)
 		if err != nil {
 			return fmt.Errorf("创建事件监听器失败 (链: %s): %w", chainConfig.Name, err)
 		}
+		if publisher != nil {
+			listener.SetPublisher(publisher)
+		}
 
 		// 使用重试机制启动监听器
 		err = app.retryMgr.ExecuteWithContext(app.ctx, func(ctx context.Context) error {
@@ -157,6 +203,153 @@ func (app *Application) startEventListeners() error {
 	return nil
 }
 
+// startReconcilers 为每条启用的链启动周期性余额对账
+func (app *Application) startReconcilers() error {
+	opts := reconcile.Options{
+		SampleSize: app.config.Reconcile.SampleSize,
+		Heal:       app.config.Reconcile.AutoHeal,
+	}
+
+	for _, chainConfig := range app.config.GetEnabledChains() {
+		reconciler, err := reconcile.NewReconciler(chainConfig, app.repos, app.config)
+		if err != nil {
+			return fmt.Errorf("创建对账器失败 (链: %s): %w", chainConfig.Name, err)
+		}
+
+		app.wg.Add(1)
+		go func(name string) {
+			defer app.wg.Done()
+			defer reconciler.Close()
+
+			ticker := time.NewTicker(app.config.Reconcile.Interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-app.ctx.Done():
+					return
+				case <-ticker.C:
+					if _, err := reconciler.Run(app.ctx, opts); err != nil {
+						logger.WithFields(map[string]interface{}{
+							"error": err,
+							"chain": name,
+						}).Error("余额对账失败")
+					}
+				}
+			}
+		}(chainConfig.Name)
+
+		logger.WithFields(map[string]interface{}{
+			"chain":       chainConfig.Name,
+			"interval":    app.config.Reconcile.Interval,
+			"sample_size": opts.SampleSize,
+			"auto_heal":   opts.Heal,
+		}).Info("余额对账已启动")
+	}
+
+	return nil
+}
+
+// startSnapshotJob 周期性地在各链的最后同步区块上保存物化快照
+func (app *Application) startSnapshotJob() {
+	service := snapshot.NewService(app.repos, app.loc)
+
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+
+		ticker := time.NewTicker(app.config.Snapshot.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-app.ctx.Done():
+				return
+			case <-ticker.C:
+				for _, chain := range app.config.GetEnabledChains() {
+					block, err := app.repos.BlockSyncStatus.GetLastSyncedBlock(chain.ChainID)
+					if err != nil || block == 0 {
+						logger.WithFields(map[string]interface{}{
+							"error": err,
+							"chain": chain.Name,
+						}).Warn("跳过物化快照：同步状态不可用")
+						continue
+					}
+					if _, err := service.Materialize(chain.ChainID, block); err != nil {
+						logger.WithFields(map[string]interface{}{
+							"error": err,
+							"chain": chain.Name,
+							"block": block,
+						}).Error("保存物化快照失败")
+					}
+				}
+			}
+		}
+	}()
+
+	logger.WithField("interval", app.config.Snapshot.Interval).Info("物化快照任务已启动")
+}
+
+// startRollupJob 周期性地汇总各链的每日统计，当天的汇总会在下次运行时覆盖
+func (app *Application) startRollupJob() {
+	service := analytics.NewService(app.repos, app.loc)
+
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+
+		ticker := time.NewTicker(app.config.Analytics.RollupInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-app.ctx.Done():
+				return
+			case <-ticker.C:
+				for _, chain := range app.config.GetEnabledChains() {
+					if _, err := service.Rollup(chain.ChainID, time.Time{}, time.Now().In(app.loc)); err != nil {
+						logger.WithFields(map[string]interface{}{
+							"error": err,
+							"chain": chain.Name,
+						}).Error("汇总每日统计失败")
+					}
+				}
+			}
+		}
+	}()
+
+	logger.WithField("interval", app.config.Analytics.RollupInterval).Info("每日统计汇总任务已启动")
+}
+
+// startWebhooks 启动发件箱投递和同步停滞监控
+func (app *Application) startWebhooks() {
+	dispatcher := webhook.NewDispatcher(app.repos, app.config, nil)
+	monitor := webhook.NewStallMonitor(app.repos, webhook.NewNotifier(app.repos, app.config), app.config)
+
+	app.wg.Add(2)
+	go func() {
+		defer app.wg.Done()
+		dispatcher.Run(app.ctx)
+	}()
+	go func() {
+		defer app.wg.Done()
+
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-app.ctx.Done():
+				return
+			case now := <-ticker.C:
+				monitor.Check(now)
+			}
+		}
+	}()
+
+	logger.WithField("poll_interval", app.config.Webhook.PollInterval).Info("Webhook投递已启动")
+}
+
 // startCronJobs 启动定时任务
 func (app *Application) startCronJobs() error {
 	logger.Info("启动定时任务")
@@ -312,6 +505,16 @@ func (app *Application) Stop() {
 	// 取消上下文
 	app.cancel()
 
+	// 停止查询API
+	if app.apiServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := app.apiServer.Shutdown(shutdownCtx); err != nil {
+			logger.WithField("error", err).Warn("关闭查询API失败")
+		}
+		cancel()
+		logger.Info("查询API已停止")
+	}
+
 	// 停止定时任务
 	if app.cronJob != nil {
 		app.cronJob.Stop()
@@ -327,6 +530,9 @@ func (app *Application) Stop() {
 	// 等待所有goroutine完成
 	app.wg.Wait()
 
+	// 关闭事件流输出
+	app.closePublisher()
+
 	// 关闭数据库连接
 	if app.db != nil {
 		sqlDB, err := app.db.DB.DB()
@@ -363,17 +569,38 @@ func (app *Application) Run() error {
 	return nil
 }
 
-func main() {
-	// 创建应用程序实例
-	app, err := NewApplication()
-	if err != nil {
-		fmt.Printf("创建应用程序失败: %v\n", err)
-		os.Exit(1)
+// Close 释放管理命令使用的资源（不启动服务时使用）
+func (app *Application) Close() {
+	app.cancel()
+
+	for _, listener := range app.listeners {
+		listener.Stop()
+	}
+	app.closePublisher()
+
+	if app.db != nil {
+		sqlDB, err := app.db.DB.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// closePublisher 关闭事件流输出
+func (app *Application) closePublisher() {
+	if app.publisher == nil {
+		return
+	}
+	if err := app.publisher.Close(); err != nil {
+		logger.WithField("error", err).Warn("关闭事件流输出失败")
 	}
+	app.publisher = nil
+}
 
-	// 运行应用程序
-	if err := app.Run(); err != nil {
-		logger.WithField("error", err).Fatal("应用程序运行失败")
+func main() {
+	// 解析并执行子命令，失败时以非零状态码退出
+	if err := runCommand(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "执行失败: %v\n", err)
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"erc20-tracker/backend/internal/stake"
+)
+
+// runStake 质押合约查询命令
+func runStake(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: stake pools|user [参数]")
+	}
+
+	switch args[0] {
+	case "pools":
+		return runStakePools(args[1:])
+	case "user":
+		return runStakeUser(args[1:])
+	default:
+		return fmt.Errorf("未知的stake子命令: %s", args[0])
+	}
+}
+
+// runStakePools 查看质押池及TVL
+func runStakePools(args []string) error {
+	fs, _ := newFlagSet("stake pools")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	pools, err := stake.NewService(app.repos).Pools(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("获取质押池失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(pools)
+	}
+
+	fmt.Printf("%d 个质押池 (链: %s)\n", len(pools), chain.Name)
+	for _, p := range pools {
+		token := p.StTokenAddress
+		if p.ETHPool {
+			token = "ETH"
+		}
+		fmt.Printf("  #%d 代币=%s 权重=%s TVL=%s 质押中=%s 待提取=%s 用户数=%d 锁定区块=%d 已领奖励=%s\n",
+			p.PoolID, token, p.PoolWeight, p.TVL, p.TotalStaked, p.PendingUnstake,
+			p.StakerCount, p.UnstakeLockedBlocks, p.TotalRewardsClaimed)
+	}
+	return nil
+}
+
+// runStakeUser 查看用户的质押持仓、待提取请求和奖励领取记录
+func runStakeUser(args []string) error {
+	fs, _ := newFlagSet("stake user")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	user := fs.String("user", "", "用户地址")
+	limit := fs.Int("limit", 20, "显示的奖励领取记录数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *user == "" {
+		return errors.New("必须指定 --chain 和 --user")
+	}
+	address, err := normalizeAddress(*user)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	view, err := stake.NewService(app.repos).User(chain.ChainID, address, *limit)
+	if err != nil {
+		return fmt.Errorf("获取用户质押信息失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(view)
+	}
+
+	fmt.Printf("用户 %s (链: %s) 累计领取奖励: %s\n", view.Address, chain.Name, view.TotalClaimed)
+	fmt.Println("持仓:")
+	for _, p := range view.Positions {
+		fmt.Printf("  池#%d 质押中=%s 待提取=%s 已领奖励=%s\n", p.PoolID, p.StakedAmount, p.PendingUnstake, p.TotalClaimed)
+	}
+	fmt.Println("待提取的解质押请求:")
+	for _, r := range view.PendingRequests {
+		fmt.Printf("  池#%d 数量=%s 解锁区块=%d 申请交易=%s\n", r.PoolID, r.Amount, r.UnlockBlock, r.RequestTxHash)
+	}
+	fmt.Println("最近的奖励领取:")
+	for _, c := range view.RecentClaims {
+		fmt.Printf("  池#%d 数量=%s 区块=%d 交易=%s\n", c.PoolID, c.Amount, c.BlockNumber, c.TxHash)
+	}
+	return nil
+}
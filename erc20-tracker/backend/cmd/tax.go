@@ -0,0 +1,211 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"erc20-tracker/backend/internal/tax"
+)
+
+// runTax 带税代币查询命令
+func runTax(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: tax summary|revenue|payer|distributions [参数]")
+	}
+
+	switch args[0] {
+	case "summary":
+		return runTaxSummary(args[1:])
+	case "revenue":
+		return runTaxRevenue(args[1:])
+	case "payer":
+		return runTaxPayer(args[1:])
+	case "distributions":
+		return runTaxDistributions(args[1:])
+	default:
+		return fmt.Errorf("未知的tax子命令: %s", args[0])
+	}
+}
+
+// runTaxSummary 查看税率配置、累计税费及去向
+func runTaxSummary(args []string) error {
+	fs, _ := newFlagSet("tax summary")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	summary, err := tax.NewService(app.repos, app.loc).Summary(chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("获取税费汇总失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(summary)
+	}
+
+	fmt.Printf("带税代币 %s (链: %s)\n", summary.ContractAddress, chain.Name)
+	fmt.Printf("  税率(基点): 买入=%d 卖出=%d 转账=%d\n",
+		summary.BuyTaxRate, summary.SellTaxRate, summary.TransferTaxRate)
+	fmt.Printf("  分配比例(基点): 流动性=%d 营销=%d 销毁=%d\n",
+		summary.LiquidityShare, summary.MarketingShare, summary.BurnShare)
+	fmt.Printf("  累计收税: %s (%d 笔)  未分配: %s\n", summary.TotalCollected, summary.TaxCount, summary.Undistributed)
+	fmt.Printf("  去向: 销毁=%s 流动性=%s 营销=%s (%d 次分配)\n",
+		summary.TotalBurned, summary.TotalLiquidity, summary.TotalMarketing, summary.DistributedCount)
+	for i, p := range summary.TopPayers {
+		fmt.Printf("  #%d %s 付税=%s (%d 笔)\n", i+1, p.Payer, p.TotalTax, p.TaxCount)
+	}
+	return nil
+}
+
+// runTaxRevenue 查看每日税费收入
+func runTaxRevenue(args []string) error {
+	fs, _ := newFlagSet("tax revenue")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	fromValue := fs.String("from", "", "起始日期（默认: 7天前）")
+	toValue := fs.String("to", "", "结束日期（默认: 今天）")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+	from, to, err := parseWindow(*fromValue, *toValue, app.loc)
+	if err != nil {
+		return err
+	}
+
+	revenue, err := tax.NewService(app.repos, app.loc).Revenue(chain.ChainID, from, to)
+	if err != nil {
+		return fmt.Errorf("统计税费收入失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(revenue)
+	}
+
+	fmt.Printf("%s (chain_id=%d) %s ~ %s 税费收入 %s\n", chain.Name, chain.ChainID, revenue.From, revenue.To, revenue.Collected)
+	for _, day := range revenue.Days {
+		fmt.Printf("  %s  收税=%s (买入=%s 卖出=%s 转账=%s 未知=%s, %d 笔) 销毁=%s 流动性=%s 营销=%s\n",
+			day.Day, day.Collected, day.Buy, day.Sell, day.Transfer, day.Unknown, day.TaxCount,
+			day.Burned, day.ToLiquidity, day.ToMarketing)
+	}
+	return nil
+}
+
+// runTaxPayer 查看地址的付税记录
+func runTaxPayer(args []string) error {
+	fs, _ := newFlagSet("tax payer")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	user := fs.String("payer", "", "付税方地址")
+	limit := fs.Int("limit", 20, "显示的带税转账数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" || *user == "" {
+		return errors.New("必须指定 --chain 和 --payer")
+	}
+	address, err := normalizeAddress(*user)
+	if err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	payer, err := tax.NewService(app.repos, app.loc).Payer(chain.ChainID, address, *limit)
+	if err != nil {
+		return fmt.Errorf("获取付税记录失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(payer)
+	}
+
+	fmt.Printf("付税方 %s (链: %s) 累计付税 %s (%d 笔)\n", payer.Payer, chain.Name, payer.TotalTax, payer.TaxCount)
+	for _, t := range payer.Taxes {
+		fmt.Printf("  %s %-8s 转给=%s 金额=%s 税=%s (%d基点) 到账=%s 交易=%s\n",
+			t.Timestamp.In(app.loc).Format(time.DateTime), t.Kind, t.Recipient,
+			t.Amount, t.Tax, t.RateBps, t.NetAmount, t.TxHash)
+	}
+	return nil
+}
+
+// runTaxDistributions 查看税费分配记录
+func runTaxDistributions(args []string) error {
+	fs, _ := newFlagSet("tax distributions")
+	chainKey := fs.String("chain", "", "链名称或链ID")
+	limit := fs.Int("limit", 20, "显示的分配记录数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chainKey == "" {
+		return errors.New("必须指定 --chain")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	chain, err := app.config.FindChain(*chainKey)
+	if err != nil {
+		return err
+	}
+
+	distributions, err := tax.NewService(app.repos, app.loc).Distributions(chain.ChainID, *limit)
+	if err != nil {
+		return fmt.Errorf("获取税费分配失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(distributions)
+	}
+
+	fmt.Printf("%d 条税费分配 (链: %s)\n", len(distributions), chain.Name)
+	for _, d := range distributions {
+		fmt.Printf("  %s 分配=%s 销毁=%s 流动性=%s 营销=%s 余数=%s 区块=%d 交易=%s\n",
+			d.Timestamp.In(app.loc).Format(time.DateTime), d.TokensSwapped, d.Burned,
+			d.ToLiquidity, d.ToMarketing, d.Retained, d.BlockNumber, d.TxHash)
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/webhook"
+)
+
+// runWebhook Webhook订阅管理命令
+func runWebhook(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: webhook add|list|enable|disable|deliveries|redeliver|test [参数]")
+	}
+
+	switch args[0] {
+	case "add":
+		return runWebhookAdd(args[1:])
+	case "list":
+		return runWebhookList(args[1:])
+	case "enable":
+		return runWebhookSetActive(args[1:], true)
+	case "disable":
+		return runWebhookSetActive(args[1:], false)
+	case "deliveries":
+		return runWebhookDeliveries(args[1:])
+	case "redeliver":
+		return runWebhookRedeliver(args[1:])
+	case "test":
+		return runWebhookTest(args[1:])
+	default:
+		return fmt.Errorf("未知的webhook子命令: %s", args[0])
+	}
+}
+
+// runWebhookAdd 创建订阅
+func runWebhookAdd(args []string) error {
+	fs, dryRun := newFlagSet("webhook add")
+	name := fs.String("name", "", "订阅名称（唯一）")
+	target := fs.String("url", "", "接收地址")
+	secret := fs.String("secret", "", "HMAC签名密钥（默认: 随机生成）")
+	events := fs.String("events", "", "事件类型，逗号分隔（默认: 全部）")
+	chainKey := fs.String("chain", "", "只通知该链的事件（默认: 全部）")
+	addresses := fs.String("addresses", "", "只通知这些地址的事件，逗号分隔（默认: 全部）")
+	minAmount := fs.String("min-amount", "0", "余额变动的最小金额（最小单位）")
+	threshold := fs.String("threshold", "0", "只通知跨越该余额的变动（最小单位，0表示不限制）")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *name == "" || *target == "" {
+		return errors.New("必须指定 --name 和 --url")
+	}
+	if parsed, err := url.Parse(*target); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return fmt.Errorf("无效的接收地址: %s", *target)
+	}
+	for _, value := range []string{*minAmount, *threshold} {
+		if amount, ok := new(big.Int).SetString(value, 10); !ok || amount.Sign() < 0 {
+			return fmt.Errorf("无效的金额: %s", value)
+		}
+	}
+
+	var normalized []string
+	for _, address := range strings.Split(*addresses, ",") {
+		if address = strings.TrimSpace(address); address == "" {
+			continue
+		}
+		checksum, err := normalizeAddress(address)
+		if err != nil {
+			return err
+		}
+		normalized = append(normalized, checksum)
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	var chainID int64
+	if *chainKey != "" {
+		chain, err := app.config.FindChain(*chainKey)
+		if err != nil {
+			return err
+		}
+		chainID = chain.ChainID
+	}
+
+	generated := *secret == ""
+	if generated {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return fmt.Errorf("生成密钥失败: %w", err)
+		}
+		*secret = hex.EncodeToString(buf)
+	}
+
+	sub := &database.WebhookSubscription{
+		Name:             *name,
+		URL:              *target,
+		Secret:           *secret,
+		EventTypes:       strings.ReplaceAll(*events, " ", ""),
+		ChainID:          chainID,
+		Addresses:        strings.Join(normalized, ","),
+		MinAmount:        *minAmount,
+		BalanceThreshold: *threshold,
+		Active:           true,
+	}
+
+	if *dryRun {
+		fmt.Printf("[dry-run] 将创建订阅 %s -> %s 事件=%q 链=%d 地址=%d个 最小金额=%s 阈值=%s\n",
+			sub.Name, sub.URL, sub.EventTypes, sub.ChainID, len(normalized), sub.MinAmount, sub.BalanceThreshold)
+		return nil
+	}
+
+	if err := app.repos.Webhook.CreateSubscription(sub); err != nil {
+		return fmt.Errorf("创建订阅失败: %w", err)
+	}
+	fmt.Printf("已创建订阅 #%d %s\n", sub.ID, sub.Name)
+	if generated {
+		fmt.Printf("签名密钥（只显示一次）: %s\n", sub.Secret)
+	}
+	return nil
+}
+
+// runWebhookList 列出订阅
+func runWebhookList(args []string) error {
+	fs, _ := newFlagSet("webhook list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	subs, err := app.repos.Webhook.ListSubscriptions(false)
+	if err != nil {
+		return fmt.Errorf("获取订阅失败: %w", err)
+	}
+
+	fmt.Printf("共 %d 个订阅\n", len(subs))
+	for _, sub := range subs {
+		state := "启用"
+		if !sub.Active {
+			state = "停用"
+		}
+		fmt.Printf("  #%d %s [%s] %s 事件=%q 链=%d 最小金额=%s 阈值=%s 地址=%q\n",
+			sub.ID, sub.Name, state, sub.URL, sub.EventTypes, sub.ChainID, sub.MinAmount, sub.BalanceThreshold, sub.Addresses)
+	}
+	return nil
+}
+
+// runWebhookSetActive 启用或停用订阅，停用后待投递的记录会转入死信
+func runWebhookSetActive(args []string, active bool) error {
+	name := "webhook disable"
+	if active {
+		name = "webhook enable"
+	}
+	fs, dryRun := newFlagSet(name)
+	id := fs.Uint64("id", 0, "订阅ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == 0 {
+		return errors.New("必须指定 --id")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	sub, err := app.repos.Webhook.GetSubscription(*id)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Printf("[dry-run] 将把订阅 #%d %s 设置为 active=%t\n", sub.ID, sub.Name, active)
+		return nil
+	}
+
+	if err := app.repos.Webhook.SetSubscriptionActive(sub.ID, active); err != nil {
+		return fmt.Errorf("更新订阅失败: %w", err)
+	}
+	fmt.Printf("订阅 #%d %s active=%t\n", sub.ID, sub.Name, active)
+	return nil
+}
+
+// runWebhookDeliveries 查看发件箱
+func runWebhookDeliveries(args []string) error {
+	fs, _ := newFlagSet("webhook deliveries")
+	status := fs.String("status", database.DeliveryStatusDead, "状态: pending|delivered|dead，为空表示全部")
+	limit := fs.Int("limit", 50, "最多显示的记录数")
+	asJSON := fs.Bool("json", false, "以JSON格式输出")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	deliveries, err := app.repos.Webhook.ListDeliveries(*status, *limit)
+	if err != nil {
+		return fmt.Errorf("获取投递记录失败: %w", err)
+	}
+
+	if *asJSON {
+		return printJSON(deliveries)
+	}
+
+	fmt.Printf("%d 条投递记录 (status=%q)\n", len(deliveries), *status)
+	for _, d := range deliveries {
+		fmt.Printf("  #%d 订阅=%d %s %s 状态=%s 次数=%d 状态码=%d 下次=%s\n",
+			d.ID, d.SubscriptionID, d.EventType, d.EventID, d.Status, d.Attempts, d.LastStatusCode,
+			d.NextAttemptAt.In(app.loc).Format(time.RFC3339))
+		if d.LastError != "" {
+			fmt.Printf("      错误: %s\n", d.LastError)
+		}
+	}
+	return nil
+}
+
+// runWebhookRedeliver 把死信重新放回发件箱
+func runWebhookRedeliver(args []string) error {
+	fs, dryRun := newFlagSet("webhook redeliver")
+	id := fs.Uint64("id", 0, "投递记录ID")
+	subscriptionID := fs.Uint64("subscription", 0, "重新投递该订阅的所有死信")
+	all := fs.Bool("all", false, "重新投递所有死信")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == 0 && *subscriptionID == 0 && !*all {
+		return errors.New("必须指定 --id、--subscription 或 --all")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	if *dryRun {
+		fmt.Printf("[dry-run] 将重新投递死信 (id=%d subscription=%d all=%t)\n", *id, *subscriptionID, *all)
+		return nil
+	}
+
+	count, err := app.repos.Webhook.Redeliver(*id, *subscriptionID, time.Now())
+	if err != nil {
+		return fmt.Errorf("重新投递失败: %w", err)
+	}
+	fmt.Printf("已将 %d 条死信放回发件箱\n", count)
+	return nil
+}
+
+// runWebhookTest 直接向订阅发送一条测试事件，不经过发件箱
+func runWebhookTest(args []string) error {
+	fs, dryRun := newFlagSet("webhook test")
+	id := fs.Uint64("id", 0, "订阅ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == 0 {
+		return errors.New("必须指定 --id")
+	}
+
+	app, err := NewApplication()
+	if err != nil {
+		return fmt.Errorf("创建应用程序失败: %w", err)
+	}
+	defer app.Close()
+
+	sub, err := app.repos.Webhook.GetSubscription(*id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	envelope := webhook.Envelope{
+		ID:        fmt.Sprintf("%s:%d:%d", webhook.EventTest, sub.ID, now.UnixNano()),
+		Type:      webhook.EventTest,
+		CreatedAt: now.In(app.loc),
+		Data:      map[string]interface{}{"subscription": sub.Name},
+	}
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Printf("[dry-run] 将向 %s 发送: %s\n", sub.URL, body)
+		return nil
+	}
+
+	client := &http.Client{Timeout: app.config.Webhook.Timeout}
+	statusCode, err := webhook.Send(app.ctx, client, sub.URL, sub.Secret, envelope.ID, envelope.Type, body, now)
+	if err != nil {
+		return fmt.Errorf("发送测试事件失败: %w", err)
+	}
+	fmt.Printf("测试事件已送达 %s，状态码 %d\n", sub.URL, statusCode)
+	return nil
+}
@@ -0,0 +1,86 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// 告警级别
+const (
+	LevelWarning  = "warning"
+	LevelCritical = "critical"
+)
+
+// Alert 告警内容
+type Alert struct {
+	Level   string                 `json:"level"`
+	Title   string                 `json:"title"`
+	Message string                 `json:"message"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+	Time    time.Time              `json:"time"`
+}
+
+// Alerter 告警发送接口
+type Alerter interface {
+	Send(alert Alert) error
+}
+
+// NewAlerter 根据配置创建告警器，未配置Webhook时只写日志
+func NewAlerter(cfg config.AlertConfig) Alerter {
+	if cfg.WebhookURL == "" {
+		return LogAlerter{}
+	}
+	return &WebhookAlerter{
+		url:    cfg.WebhookURL,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// LogAlerter 只写错误日志的告警器
+type LogAlerter struct{}
+
+// Send 以错误级别记录告警
+func (LogAlerter) Send(alert Alert) error {
+	fields := map[string]interface{}{
+		"alert_level": alert.Level,
+		"alert_title": alert.Title,
+	}
+	for k, v := range alert.Fields {
+		fields[k] = v
+	}
+	logger.WithFields(fields).Error(alert.Message)
+	return nil
+}
+
+// WebhookAlerter 以JSON POST方式发送告警的告警器，同时写日志
+type WebhookAlerter struct {
+	url    string
+	client *http.Client
+}
+
+// Send 记录日志并发送Webhook
+func (w *WebhookAlerter) Send(alert Alert) error {
+	LogAlerter{}.Send(alert)
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("序列化告警失败: %w", err)
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("发送告警失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("告警接收方返回状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
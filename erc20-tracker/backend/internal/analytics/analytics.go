@@ -0,0 +1,404 @@
+package analytics
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/snapshot"
+)
+
+// dayLayout 日期格式
+const dayLayout = "2006-01-02"
+
+// RankedHolder 排名持有人
+type RankedHolder struct {
+	Rank    int     `json:"rank"`
+	Address string  `json:"address"`
+	Balance string  `json:"balance"`
+	Share   float64 `json:"share"` // 占持有人余额之和的比例
+}
+
+// TopHolders 持有人排行
+type TopHolders struct {
+	ChainID     int64          `json:"chain_id"`
+	At          *time.Time     `json:"at,omitempty"` // 为空表示当前余额
+	BlockNumber uint64         `json:"block_number,omitempty"`
+	HolderCount int            `json:"holder_count"`
+	TotalSupply string         `json:"total_supply"`
+	Holders     []RankedHolder `json:"holders"`
+}
+
+// Concentration 持有集中度指标
+type Concentration struct {
+	ChainID     int64      `json:"chain_id"`
+	At          *time.Time `json:"at,omitempty"`
+	BlockNumber uint64     `json:"block_number,omitempty"`
+	HolderCount int        `json:"holder_count"`
+	TotalSupply string     `json:"total_supply"`
+	Gini        float64    `json:"gini"`        // 基尼系数，0为完全平均，趋近1为高度集中
+	Nakamoto    int        `json:"nakamoto"`    // 合计持有超过50%的最少地址数
+	HHI         float64    `json:"hhi"`         // 赫芬达尔指数（份额平方和）
+	Top10Share  float64    `json:"top10_share"` // 前10名持有比例
+	Top100Share float64    `json:"top100_share"`
+}
+
+// DayChurn 单日持有人变化
+type DayChurn struct {
+	Day         string `json:"day"`
+	NewHolders  int64  `json:"new_holders"`
+	Churned     int64  `json:"churned_holders"`
+	HolderCount int64  `json:"holder_count"`
+}
+
+// Churn 时间窗口内的持有人变化
+type Churn struct {
+	ChainID        int64      `json:"chain_id"`
+	From           string     `json:"from"`
+	To             string     `json:"to"`
+	RolledUpUntil  string     `json:"rolled_up_until"` // 最后汇总的日期，之后的数据尚未汇总
+	StartHolders   int64      `json:"start_holders"`
+	EndHolders     int64      `json:"end_holders"`
+	NewHolders     int64      `json:"new_holders"`
+	ChurnedHolders int64      `json:"churned_holders"`
+	Days           []DayChurn `json:"days"`
+}
+
+// DayFlow 单日代币流量
+type DayFlow struct {
+	Day             string `json:"day"`
+	Minted          string `json:"minted"`
+	Burned          string `json:"burned"`
+	NetIssuance     string `json:"net_issuance"` // 铸造减销毁，转账在持有人之间净额为0
+	TransferVolume  string `json:"transfer_volume"`
+	ChangeCount     int64  `json:"change_count"`
+	ActiveAddresses int64  `json:"active_addresses"`
+}
+
+// UserDayFlow 地址单日资金流
+type UserDayFlow struct {
+	Day            string `json:"day"`
+	Inflow         string `json:"inflow"`
+	Outflow        string `json:"outflow"`
+	NetFlow        string `json:"net_flow"`
+	ChangeCount    int64  `json:"change_count"`
+	OpeningBalance string `json:"opening_balance"`
+	ClosingBalance string `json:"closing_balance"`
+}
+
+// Flows 时间窗口内的每日净流量
+type Flows struct {
+	ChainID       int64         `json:"chain_id"`
+	UserAddress   string        `json:"user_address,omitempty"` // 为空表示全链统计
+	From          string        `json:"from"`
+	To            string        `json:"to"`
+	RolledUpUntil string        `json:"rolled_up_until"`
+	NetFlow       string        `json:"net_flow"`
+	Days          []DayFlow     `json:"days,omitempty"`
+	UserDays      []UserDayFlow `json:"user_days,omitempty"`
+}
+
+// Service 持有人分析服务
+// 排行和集中度基于当前余额或历史快照计算，持有人变化和流量基于每日汇总表
+type Service struct {
+	repos     *database.Repositories
+	snapshots *snapshot.Service
+	loc       *time.Location
+}
+
+// NewService 创建持有人分析服务
+func NewService(repos *database.Repositories, loc *time.Location) *Service {
+	return &Service{
+		repos:     repos,
+		snapshots: snapshot.NewService(repos, loc),
+		loc:       loc,
+	}
+}
+
+// TopHolders 获取余额最高的持有人，at为零值时使用当前余额
+func (s *Service) TopHolders(chainID int64, at time.Time, limit int) (*TopHolders, error) {
+	result := &TopHolders{ChainID: chainID, Holders: []RankedHolder{}}
+
+	var addresses []string
+	var balances []*big.Int
+	var total *big.Int
+
+	if at.IsZero() {
+		top, err := s.repos.UserBalance.TopByBalance(chainID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("获取持有人排行失败: %w", err)
+		}
+		if total, err = s.repos.UserBalance.SumBalances(chainID); err != nil {
+			return nil, fmt.Errorf("统计余额合计失败: %w", err)
+		}
+		count, err := s.repos.UserBalance.CountHolders(chainID)
+		if err != nil {
+			return nil, fmt.Errorf("统计持有人数量失败: %w", err)
+		}
+		result.HolderCount = int(count)
+		for _, b := range top {
+			addresses = append(addresses, b.UserAddress)
+			balances = append(balances, b.GetBalanceBigInt())
+		}
+	} else {
+		snap, err := s.snapshots.AtTime(chainID, at)
+		if err != nil {
+			return nil, err
+		}
+		result.At, result.BlockNumber, result.HolderCount = &snap.At, snap.BlockNumber, snap.HolderCount
+		total, _ = new(big.Int).SetString(snap.TotalSupply, 10)
+		for i, holder := range snap.Holders {
+			if i >= limit {
+				break
+			}
+			balance, _ := new(big.Int).SetString(holder.Balance, 10)
+			addresses = append(addresses, holder.Address)
+			balances = append(balances, balance)
+		}
+	}
+
+	result.TotalSupply = total.String()
+	for i := range addresses {
+		result.Holders = append(result.Holders, RankedHolder{
+			Rank:    i + 1,
+			Address: addresses[i],
+			Balance: balances[i].String(),
+			Share:   ratio(balances[i], total),
+		})
+	}
+	return result, nil
+}
+
+// Concentration 计算持有集中度，at为零值时使用当前余额
+func (s *Service) Concentration(chainID int64, at time.Time) (*Concentration, error) {
+	result := &Concentration{ChainID: chainID}
+
+	var balances []*big.Int
+	if at.IsZero() {
+		list, err := s.repos.UserBalance.ListByChain(chainID, 0)
+		if err != nil {
+			return nil, fmt.Errorf("获取余额记录失败: %w", err)
+		}
+		for _, b := range list {
+			if balance := b.GetBalanceBigInt(); balance.Sign() > 0 {
+				balances = append(balances, balance)
+			}
+		}
+	} else {
+		snap, err := s.snapshots.AtTime(chainID, at)
+		if err != nil {
+			return nil, err
+		}
+		result.At, result.BlockNumber = &snap.At, snap.BlockNumber
+		for _, holder := range snap.Holders {
+			balance, _ := new(big.Int).SetString(holder.Balance, 10)
+			balances = append(balances, balance)
+		}
+	}
+
+	// 按余额降序排列
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Cmp(balances[j]) > 0 })
+
+	total := big.NewInt(0)
+	for _, balance := range balances {
+		total.Add(total, balance)
+	}
+
+	result.HolderCount = len(balances)
+	result.TotalSupply = total.String()
+	if total.Sign() == 0 {
+		return result, nil
+	}
+
+	result.Gini = gini(balances, total)
+	result.Top10Share = ratio(sumTop(balances, 10), total)
+	result.Top100Share = ratio(sumTop(balances, 100), total)
+
+	half := new(big.Int).Rsh(total, 1)
+	cumulative := big.NewInt(0)
+	for i, balance := range balances {
+		share := ratio(balance, total)
+		result.HHI += share * share
+
+		cumulative.Add(cumulative, balance)
+		if result.Nakamoto == 0 && cumulative.Cmp(half) > 0 {
+			result.Nakamoto = i + 1
+		}
+	}
+	return result, nil
+}
+
+// Churn 统计 [from, to] 日期范围内的新增与流失持有人
+func (s *Service) Churn(chainID int64, from, to time.Time) (*Churn, error) {
+	from, to = s.dayOf(from), s.dayOf(to)
+	result := &Churn{ChainID: chainID, From: from.Format(dayLayout), To: to.Format(dayLayout), Days: []DayChurn{}}
+
+	rolledUpUntil, err := s.rolledUpUntil(chainID)
+	if err != nil {
+		return nil, err
+	}
+	result.RolledUpUntil = rolledUpUntil
+
+	stats, err := s.repos.DailyRollup.ListStats(chainID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("获取每日统计失败: %w", err)
+	}
+
+	previous, err := s.repos.DailyRollup.GetStat(chainID, from.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, fmt.Errorf("获取前一天统计失败: %w", err)
+	}
+	if previous != nil {
+		result.StartHolders = previous.HolderCount
+	} else if len(stats) > 0 {
+		result.StartHolders = stats[0].HolderCount - stats[0].NewHolders + stats[0].ChurnedHolders
+	}
+	result.EndHolders = result.StartHolders
+
+	for _, stat := range stats {
+		result.NewHolders += stat.NewHolders
+		result.ChurnedHolders += stat.ChurnedHolders
+		result.EndHolders = stat.HolderCount
+		result.Days = append(result.Days, DayChurn{
+			Day:         s.dayOf(stat.Day).Format(dayLayout),
+			NewHolders:  stat.NewHolders,
+			Churned:     stat.ChurnedHolders,
+			HolderCount: stat.HolderCount,
+		})
+	}
+	return result, nil
+}
+
+// ChainFlows 统计 [from, to] 日期范围内全链的每日铸造、销毁和转账量
+func (s *Service) ChainFlows(chainID int64, from, to time.Time) (*Flows, error) {
+	from, to = s.dayOf(from), s.dayOf(to)
+	result := &Flows{ChainID: chainID, From: from.Format(dayLayout), To: to.Format(dayLayout), Days: []DayFlow{}}
+
+	rolledUpUntil, err := s.rolledUpUntil(chainID)
+	if err != nil {
+		return nil, err
+	}
+	result.RolledUpUntil = rolledUpUntil
+
+	stats, err := s.repos.DailyRollup.ListStats(chainID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("获取每日统计失败: %w", err)
+	}
+
+	net := big.NewInt(0)
+	for _, stat := range stats {
+		minted, _ := new(big.Int).SetString(stat.Minted, 10)
+		burned, _ := new(big.Int).SetString(stat.Burned, 10)
+		issuance := new(big.Int).Sub(minted, burned)
+		net.Add(net, issuance)
+
+		result.Days = append(result.Days, DayFlow{
+			Day:             s.dayOf(stat.Day).Format(dayLayout),
+			Minted:          stat.Minted,
+			Burned:          stat.Burned,
+			NetIssuance:     issuance.String(),
+			TransferVolume:  stat.TransferVolume,
+			ChangeCount:     stat.ChangeCount,
+			ActiveAddresses: stat.ActiveAddresses,
+		})
+	}
+	result.NetFlow = net.String()
+	return result, nil
+}
+
+// UserFlows 统计地址在 [from, to] 日期范围内的每日流入流出
+func (s *Service) UserFlows(chainID int64, userAddress string, from, to time.Time) (*Flows, error) {
+	from, to = s.dayOf(from), s.dayOf(to)
+	result := &Flows{
+		ChainID:     chainID,
+		UserAddress: userAddress,
+		From:        from.Format(dayLayout),
+		To:          to.Format(dayLayout),
+		UserDays:    []UserDayFlow{},
+	}
+
+	rolledUpUntil, err := s.rolledUpUntil(chainID)
+	if err != nil {
+		return nil, err
+	}
+	result.RolledUpUntil = rolledUpUntil
+
+	flows, err := s.repos.DailyRollup.ListUserFlows(chainID, userAddress, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("获取地址资金流失败: %w", err)
+	}
+
+	net := big.NewInt(0)
+	for _, flow := range flows {
+		inflow, _ := new(big.Int).SetString(flow.Inflow, 10)
+		outflow, _ := new(big.Int).SetString(flow.Outflow, 10)
+		dayNet := new(big.Int).Sub(inflow, outflow)
+		net.Add(net, dayNet)
+
+		result.UserDays = append(result.UserDays, UserDayFlow{
+			Day:            s.dayOf(flow.Day).Format(dayLayout),
+			Inflow:         flow.Inflow,
+			Outflow:        flow.Outflow,
+			NetFlow:        dayNet.String(),
+			ChangeCount:    flow.ChangeCount,
+			OpeningBalance: flow.OpeningBalance,
+			ClosingBalance: flow.ClosingBalance,
+		})
+	}
+	result.NetFlow = net.String()
+	return result, nil
+}
+
+// rolledUpUntil 返回最后汇总的日期，尚无汇总时返回空字符串
+func (s *Service) rolledUpUntil(chainID int64) (string, error) {
+	latest, err := s.repos.DailyRollup.GetLatestStat(chainID)
+	if err != nil {
+		return "", fmt.Errorf("获取最后汇总日期失败: %w", err)
+	}
+	if latest == nil {
+		return "", nil
+	}
+	return s.dayOf(latest.Day).Format(dayLayout), nil
+}
+
+// dayOf 返回时间所在日期的零点（按配置时区）
+func (s *Service) dayOf(t time.Time) time.Time {
+	t = t.In(s.loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+}
+
+// gini 计算基尼系数，balances须按降序排列
+// G = 2·Σ(i·x_i) / (n·Σx) − (n+1)/n，其中x按升序排列、i从1开始
+func gini(balances []*big.Int, total *big.Int) float64 {
+	n := len(balances)
+	weighted := big.NewInt(0)
+	for i, balance := range balances {
+		rank := big.NewInt(int64(n - i)) // 降序下标转换为升序排名
+		weighted.Add(weighted, new(big.Int).Mul(rank, balance))
+	}
+
+	numerator := new(big.Float).SetInt(new(big.Int).Mul(weighted, big.NewInt(2)))
+	denominator := new(big.Float).SetInt(new(big.Int).Mul(total, big.NewInt(int64(n))))
+	g, _ := new(big.Float).Quo(numerator, denominator).Float64()
+	return g - float64(n+1)/float64(n)
+}
+
+// sumTop 计算前n个余额之和，balances须按降序排列
+func sumTop(balances []*big.Int, n int) *big.Int {
+	sum := big.NewInt(0)
+	for i := 0; i < n && i < len(balances); i++ {
+		sum.Add(sum, balances[i])
+	}
+	return sum
+}
+
+// ratio 计算a/b
+func ratio(a, b *big.Int) float64 {
+	if b.Sign() == 0 {
+		return 0
+	}
+	r, _ := new(big.Float).Quo(new(big.Float).SetInt(a), new(big.Float).SetInt(b)).Float64()
+	return r
+}
@@ -0,0 +1,199 @@
+package analytics
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// userDay 单个地址在一天内的变动汇总
+type userDay struct {
+	opening *big.Int // 当天第一条变动前的余额
+	closing *big.Int // 当天最后一条变动后的余额
+	inflow  *big.Int
+	outflow *big.Int
+	count   int64
+}
+
+// Rollup 按天汇总 [from, until] 的代币统计和地址资金流，已存在的汇总会被覆盖
+// from为零值时从最后汇总的一天（可能只汇总了部分数据）开始，尚无汇总时从链上第一条余额变动开始
+// 返回汇总的天数
+func (s *Service) Rollup(chainID int64, from, until time.Time) (int, error) {
+	start, err := s.rollupStart(chainID, from)
+	if err != nil {
+		return 0, err
+	}
+	if start.IsZero() {
+		return 0, nil
+	}
+
+	last := s.dayOf(until)
+	days := 0
+	for day := start; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if err := s.rollupDay(chainID, day); err != nil {
+			return days, fmt.Errorf("汇总 %s 失败: %w", day.Format(dayLayout), err)
+		}
+		days++
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain_id": chainID,
+		"from":     start.Format(dayLayout),
+		"until":    last.Format(dayLayout),
+		"days":     days,
+	}).Info("每日统计汇总完成")
+
+	return days, nil
+}
+
+// PendingRollupDays 返回Rollup将要汇总的起始日期和天数，不写入数据库
+func (s *Service) PendingRollupDays(chainID int64, from, until time.Time) (time.Time, int, error) {
+	start, err := s.rollupStart(chainID, from)
+	if err != nil || start.IsZero() {
+		return start, 0, err
+	}
+	last := s.dayOf(until)
+	if last.Before(start) {
+		return start, 0, nil
+	}
+	days := 0
+	for day := start; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days++
+	}
+	return start, days, nil
+}
+
+// rollupStart 计算汇总的起始日期，没有需要汇总的数据时返回零值
+func (s *Service) rollupStart(chainID int64, from time.Time) (time.Time, error) {
+	if !from.IsZero() {
+		return s.dayOf(from), nil
+	}
+
+	latest, err := s.repos.DailyRollup.GetLatestStat(chainID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("获取最后汇总日期失败: %w", err)
+	}
+	if latest != nil {
+		return s.dayOf(latest.Day), nil
+	}
+
+	first, found, err := s.repos.BalanceChange.GetFirstChainChangeTime(chainID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("获取第一条余额变动失败: %w", err)
+	}
+	if !found {
+		return time.Time{}, nil
+	}
+	return s.dayOf(first), nil
+}
+
+// rollupDay 汇总一天内的余额变动
+func (s *Service) rollupDay(chainID int64, day time.Time) error {
+	users := make(map[string]*userDay)
+	minted, burned, transferVolume := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	var changeCount int64
+
+	err := s.repos.BalanceChange.ForEachChangeInTimeRange(chainID, day, day.AddDate(0, 0, 1), func(change database.BalanceChange) error {
+		before, after := change.GetBalanceBeforeBigInt(), change.GetBalanceAfterBigInt()
+
+		u, ok := users[change.UserAddress]
+		if !ok {
+			u = &userDay{opening: before, inflow: big.NewInt(0), outflow: big.NewInt(0)}
+			users[change.UserAddress] = u
+		}
+		u.closing = after
+		u.count++
+
+		// 用余额差值统计流入流出，修正记录的change_amount带符号，不能直接使用
+		delta := new(big.Int).Sub(after, before)
+		if delta.Sign() > 0 {
+			u.inflow.Add(u.inflow, delta)
+		} else {
+			u.outflow.Sub(u.outflow, delta)
+		}
+
+		switch change.ChangeType {
+		case database.ChangeTypeMint:
+			minted.Add(minted, change.GetChangeAmountBigInt())
+		case database.ChangeTypeBurn:
+			burned.Add(burned, change.GetChangeAmountBigInt())
+		case database.ChangeTypeTransferOut:
+			transferVolume.Add(transferVolume, change.GetChangeAmountBigInt())
+		}
+		changeCount++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("遍历余额变动失败: %w", err)
+	}
+
+	previousHolders, err := s.holdersBefore(chainID, day)
+	if err != nil {
+		return err
+	}
+
+	stat := &database.DailyTokenStat{
+		ChainID:         chainID,
+		Day:             day,
+		Minted:          minted.String(),
+		Burned:          burned.String(),
+		TransferVolume:  transferVolume.String(),
+		ChangeCount:     changeCount,
+		ActiveAddresses: int64(len(users)),
+	}
+
+	addresses := make([]string, 0, len(users))
+	for address := range users {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	flows := make([]database.DailyUserFlow, 0, len(users))
+	for _, address := range addresses {
+		u := users[address]
+		switch {
+		case u.opening.Sign() <= 0 && u.closing.Sign() > 0:
+			stat.NewHolders++
+		case u.opening.Sign() > 0 && u.closing.Sign() <= 0:
+			stat.ChurnedHolders++
+		}
+		flows = append(flows, database.DailyUserFlow{
+			ChainID:        chainID,
+			Day:            day,
+			UserAddress:    address,
+			Inflow:         u.inflow.String(),
+			Outflow:        u.outflow.String(),
+			ChangeCount:    u.count,
+			OpeningBalance: u.opening.String(),
+			ClosingBalance: u.closing.String(),
+		})
+	}
+	stat.HolderCount = previousHolders + stat.NewHolders - stat.ChurnedHolders
+
+	if err := s.repos.DailyRollup.SaveDay(stat, flows); err != nil {
+		return fmt.Errorf("保存每日汇总失败: %w", err)
+	}
+	return nil
+}
+
+// holdersBefore 获取某一天开始时的持有人数
+// 优先使用前一天的汇总，没有时根据余额变动计算快照
+func (s *Service) holdersBefore(chainID int64, day time.Time) (int64, error) {
+	previous, err := s.repos.DailyRollup.GetStat(chainID, day.AddDate(0, 0, -1))
+	if err != nil {
+		return 0, fmt.Errorf("获取前一天汇总失败: %w", err)
+	}
+	if previous != nil {
+		return previous.HolderCount, nil
+	}
+
+	snap, err := s.snapshots.AtTime(chainID, day.Add(-time.Nanosecond))
+	if err != nil {
+		return 0, fmt.Errorf("计算前一天持有人快照失败: %w", err)
+	}
+	return int64(snap.HolderCount), nil
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// 默认查询参数
+const (
+	defaultTopHolders   = 100
+	maxTopHolders       = 1000
+	defaultAnalyticDays = 7
+)
+
+// handleTopHolders 持有人排行
+// 参数: chain、limit（默认100）、time（RFC3339，为空表示当前余额）
+func (s *Server) handleTopHolders(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	limit := defaultTopHolders
+	if value := query.Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 || limit > maxTopHolders {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("limit必须在1到%d之间", maxTopHolders))
+			return
+		}
+	}
+
+	at, err := s.parseOptionalTime(query.Get("time"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.analytics.TopHolders(chain.ChainID, at, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleConcentration 持有集中度（基尼系数、中本聪系数等）
+// 参数: chain、time（RFC3339，为空表示当前余额）
+func (s *Server) handleConcentration(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	at, err := s.parseOptionalTime(query.Get("time"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.analytics.Concentration(chain.ChainID, at)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleChurn 新增与流失持有人
+// 参数: chain、from、to（YYYY-MM-DD，默认最近7天）
+func (s *Server) handleChurn(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	from, to, err := s.parseWindow(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.analytics.Churn(chain.ChainID, from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleFlows 每日净流量，指定address时返回该地址的流入流出
+// 参数: chain、from、to（YYYY-MM-DD，默认最近7天）、address
+func (s *Server) handleFlows(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	from, to, err := s.parseWindow(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if query.Get("address") == "" {
+		result, err := s.analytics.ChainFlows(chain.ChainID, from, to)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+		return
+	}
+
+	address, err := parseAddress(query.Get("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	result, err := s.analytics.UserFlows(chain.ChainID, address, from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// parseOptionalTime 解析可选的RFC3339时间参数
+func (s *Server) parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("无效的时间: %s", value)
+	}
+	return t, nil
+}
+
+// parseWindow 解析日期窗口，默认以今天为结束的最近7天
+func (s *Server) parseWindow(query url.Values) (time.Time, time.Time, error) {
+	to := time.Now().In(s.loc)
+	if value := query.Get("to"); value != "" {
+		t, err := time.ParseInLocation("2006-01-02", value, s.loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("无效的结束日期: %s", value)
+		}
+		to = t
+	}
+
+	from := to.AddDate(0, 0, -(defaultAnalyticDays - 1))
+	if value := query.Get("from"); value != "" {
+		t, err := time.ParseInLocation("2006-01-02", value, s.loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("无效的起始日期: %s", value)
+		}
+		from = t
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errors.New("起始日期不能晚于结束日期")
+	}
+	return from, to, nil
+}
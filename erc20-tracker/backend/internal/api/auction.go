@@ -0,0 +1,173 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"erc20-tracker/backend/internal/auction"
+	"erc20-tracker/backend/internal/database"
+)
+
+// 拍卖查询的默认参数
+const (
+	defaultAuctionLimit = 50
+	defaultAuctionBids  = 50
+)
+
+// handleAuctionContracts 链上已发现的拍卖合约
+// 参数: chain
+func (s *Server) handleAuctionContracts(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	contracts, err := s.auction.Contracts(chain.ChainID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":  chain.ChainID,
+		"contracts": contracts,
+	})
+}
+
+// handleAuctions 按条件查询拍卖
+// 参数: chain、contract、seller、status（active/ended/cancelled）、limit（默认50）
+func (s *Server) handleAuctions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultAuctionLimit)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	filter := database.AuctionFilter{ChainID: chain.ChainID, Limit: limit}
+	if value := query.Get("contract"); value != "" {
+		if filter.ContractAddress, err = parseAddress(value); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	if value := query.Get("seller"); value != "" {
+		if filter.Seller, err = parseAddress(value); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	switch status := query.Get("status"); status {
+	case "", database.AuctionStatusActive, database.AuctionStatusEnded, database.AuctionStatusCancelled:
+		filter.Status = status
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("无效的拍卖状态: %s", status))
+		return
+	}
+
+	auctions, err := s.auction.Auctions(filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id": chain.ChainID,
+		"auctions": auctions,
+	})
+}
+
+// handleAuction 拍卖详情及出价历史
+// 参数: chain
+func (s *Server) handleAuction(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	contract, err := parseAddress(r.PathValue("contract"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	auctionID, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("无效的拍卖ID: %s", r.PathValue("id")))
+		return
+	}
+
+	detail, err := s.auction.Auction(chain.ChainID, contract, auctionID)
+	if errors.Is(err, auction.ErrAuctionNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, detail)
+}
+
+// handleAuctionNFT 一个NFT的拍卖历史
+// 参数: chain
+func (s *Server) handleAuctionNFT(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	nft, err := parseAddress(r.PathValue("contract"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	tokenID, ok := new(big.Int).SetString(r.PathValue("tokenId"), 10)
+	if !ok || tokenID.Sign() < 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("无效的TokenID: %s", r.PathValue("tokenId")))
+		return
+	}
+
+	history, err := s.auction.NFT(chain.ChainID, nft, tokenID.String())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, history)
+}
+
+// handleAuctionUser 用户作为卖家、出价者和赢家的拍卖活动
+// 参数: chain、limit（最近出价记录数，默认50）
+func (s *Server) handleAuctionUser(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	address, err := parseAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultAuctionBids)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	user, err := s.auction.User(chain.ChainID, address, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"erc20-tracker/backend/internal/dex"
+)
+
+// defaultDexRecords 成交和流动性记录查询的默认条数
+const defaultDexRecords = 50
+
+// handleDexPairs 已索引的交易对及当前储备、价格
+// 参数: chain
+func (s *Server) handleDexPairs(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	pairs, err := s.dex.Pairs(chain.ChainID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id": chain.ChainID,
+		"pairs":    pairs,
+	})
+}
+
+// handleDexPair 交易对详情和LP持仓最多的地址
+// 参数: chain
+func (s *Server) handleDexPair(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	pair, err := parseAddress(r.PathValue("pair"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	detail, err := s.dex.Pair(chain.ChainID, pair)
+	if errors.Is(err, dex.ErrPairNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, detail)
+}
+
+// handleDexPrices 交易对价格K线
+// 参数: chain、interval（1h/1d，默认1h）、from、to（YYYY-MM-DD，默认最近7天）
+func (s *Server) handleDexPrices(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	pair, err := parseAddress(r.PathValue("pair"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = dex.IntervalHour
+	}
+	if interval != dex.IntervalHour && interval != dex.IntervalDay {
+		writeError(w, http.StatusBadRequest, errors.New("interval 只支持 1h 或 1d"))
+		return
+	}
+	from, to, err := s.parseWindow(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	series, err := s.dex.Prices(chain.ChainID, pair, interval, from, to)
+	if errors.Is(err, dex.ErrPairNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, series)
+}
+
+// handleDexSwaps 交易对最近的成交
+// 参数: chain、limit（默认50）
+func (s *Server) handleDexSwaps(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	pair, err := parseAddress(r.PathValue("pair"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultDexRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	swaps, err := s.dex.Swaps(chain.ChainID, pair, limit)
+	if errors.Is(err, dex.ErrPairNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":     chain.ChainID,
+		"pair_address": pair,
+		"swaps":        swaps,
+	})
+}
+
+// handleDexLiquidity 交易对最近的添加/移除流动性
+// 参数: chain、limit（默认50）
+func (s *Server) handleDexLiquidity(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	pair, err := parseAddress(r.PathValue("pair"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultDexRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	events, err := s.dex.Liquidity(chain.ChainID, pair, limit)
+	if errors.Is(err, dex.ErrPairNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":     chain.ChainID,
+		"pair_address": pair,
+		"events":       events,
+	})
+}
+
+// handleDexUser 地址在各交易对的LP持仓
+// 参数: chain
+func (s *Server) handleDexUser(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	address, err := parseAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	positions, err := s.dex.Positions(chain.ChainID, address)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":  chain.ChainID,
+		"address":   address,
+		"positions": positions,
+	})
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"erc20-tracker/backend/internal/donation"
+)
+
+// 捐赠查询的默认参数
+const (
+	defaultLeaderboardSize = 50
+	defaultDonationRecords = 50
+)
+
+// handleDonationCampaign 捐赠合约汇总和时间窗口状态
+// 参数: chain
+func (s *Server) handleDonationCampaign(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	campaign, err := s.donation.Campaign(chain.ChainID, time.Now())
+	if errors.Is(err, donation.ErrCampaignNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, campaign)
+}
+
+// handleDonationLeaderboard 捐赠排行榜
+// 参数: chain、offset（默认0）、limit（默认50）
+func (s *Server) handleDonationLeaderboard(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultLeaderboardSize)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	offset := 0
+	if value := query.Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, errors.New("offset必须是非负整数"))
+			return
+		}
+	}
+
+	entries, err := s.donation.Leaderboard(chain.ChainID, offset, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id": chain.ChainID,
+		"offset":   offset,
+		"donors":   entries,
+	})
+}
+
+// handleDonationDonor 捐赠者的累计捐赠、名次和捐赠记录
+// 参数: chain、limit（捐赠记录数，默认50）
+func (s *Server) handleDonationDonor(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	address, err := parseAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultDonationRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	donor, err := s.donation.Donor(chain.ChainID, address, limit)
+	if errors.Is(err, donation.ErrDonorNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, donor)
+}
+
+// handleDonations 最近的捐赠记录
+// 参数: chain、limit（默认50）
+func (s *Server) handleDonations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultDonationRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	donations, err := s.donation.Donations(chain.ChainID, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":  chain.ChainID,
+		"donations": donations,
+	})
+}
+
+// handleDonationWithdrawals 所有者的提款记录
+// 参数: chain、limit（默认50）
+func (s *Server) handleDonationWithdrawals(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultDonationRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	withdrawals, err := s.donation.Withdrawals(chain.ChainID, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":    chain.ChainID,
+		"withdrawals": withdrawals,
+	})
+}
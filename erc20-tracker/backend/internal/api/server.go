@@ -0,0 +1,223 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"erc20-tracker/backend/internal/analytics"
+	"erc20-tracker/backend/internal/auction"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/dex"
+	"erc20-tracker/backend/internal/donation"
+	"erc20-tracker/backend/internal/snapshot"
+	"erc20-tracker/backend/internal/stake"
+	"erc20-tracker/backend/internal/tax"
+	"erc20-tracker/backend/pkg/logger"
+	"erc20-tracker/backend/pkg/utils"
+)
+
+// Server 只读查询API
+type Server struct {
+	config     *config.Config
+	repos      *database.Repositories
+	snapshots  *snapshot.Service
+	analytics  *analytics.Service
+	stake      *stake.Service
+	auction    *auction.Service
+	donation   *donation.Service
+	tax        *tax.Service
+	dex        *dex.Service
+	loc        *time.Location
+	httpServer *http.Server
+}
+
+// NewServer 创建查询API服务
+func NewServer(cfg *config.Config, repos *database.Repositories, loc *time.Location) *Server {
+	s := &Server{
+		config:    cfg,
+		repos:     repos,
+		snapshots: snapshot.NewService(repos, loc),
+		analytics: analytics.NewService(repos, loc),
+		stake:     stake.NewService(repos),
+		auction:   auction.NewService(repos),
+		donation:  donation.NewService(repos),
+		tax:       tax.NewService(repos, loc),
+		dex:       dex.NewService(repos, loc),
+		loc:       loc,
+	}
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+
+	s.httpServer = &http.Server{
+		Addr:              cfg.API.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// registerRoutes 注册路由
+func (s *Server) registerRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /healthz", s.handleHealth)
+	mux.HandleFunc("GET /api/v1/users/{address}", s.handleUser)
+	mux.HandleFunc("GET /api/v1/snapshots", s.handleSnapshot)
+	mux.HandleFunc("GET /api/v1/analytics/top-holders", s.handleTopHolders)
+	mux.HandleFunc("GET /api/v1/analytics/concentration", s.handleConcentration)
+	mux.HandleFunc("GET /api/v1/analytics/churn", s.handleChurn)
+	mux.HandleFunc("GET /api/v1/analytics/flows", s.handleFlows)
+	mux.HandleFunc("GET /api/v1/stake/pools", s.handleStakePools)
+	mux.HandleFunc("GET /api/v1/stake/pools/{pid}", s.handleStakePool)
+	mux.HandleFunc("GET /api/v1/stake/users/{address}", s.handleStakeUser)
+	mux.HandleFunc("GET /api/v1/auctions", s.handleAuctions)
+	mux.HandleFunc("GET /api/v1/auctions/contracts", s.handleAuctionContracts)
+	mux.HandleFunc("GET /api/v1/auctions/{contract}/{id}", s.handleAuction)
+	mux.HandleFunc("GET /api/v1/auctions/nfts/{contract}/{tokenId}", s.handleAuctionNFT)
+	mux.HandleFunc("GET /api/v1/auctions/users/{address}", s.handleAuctionUser)
+	mux.HandleFunc("GET /api/v1/donations", s.handleDonations)
+	mux.HandleFunc("GET /api/v1/donations/campaign", s.handleDonationCampaign)
+	mux.HandleFunc("GET /api/v1/donations/leaderboard", s.handleDonationLeaderboard)
+	mux.HandleFunc("GET /api/v1/donations/donors/{address}", s.handleDonationDonor)
+	mux.HandleFunc("GET /api/v1/donations/withdrawals", s.handleDonationWithdrawals)
+	mux.HandleFunc("GET /api/v1/tax", s.handleTaxes)
+	mux.HandleFunc("GET /api/v1/tax/summary", s.handleTaxSummary)
+	mux.HandleFunc("GET /api/v1/tax/revenue", s.handleTaxRevenue)
+	mux.HandleFunc("GET /api/v1/tax/payers/{address}", s.handleTaxPayer)
+	mux.HandleFunc("GET /api/v1/tax/distributions", s.handleTaxDistributions)
+	mux.HandleFunc("GET /api/v1/dex/pairs", s.handleDexPairs)
+	mux.HandleFunc("GET /api/v1/dex/pairs/{pair}", s.handleDexPair)
+	mux.HandleFunc("GET /api/v1/dex/pairs/{pair}/prices", s.handleDexPrices)
+	mux.HandleFunc("GET /api/v1/dex/pairs/{pair}/swaps", s.handleDexSwaps)
+	mux.HandleFunc("GET /api/v1/dex/pairs/{pair}/liquidity", s.handleDexLiquidity)
+	mux.HandleFunc("GET /api/v1/dex/users/{address}", s.handleDexUser)
+}
+
+// Start 在后台启动HTTP服务
+func (s *Server) Start() error {
+	logger.WithField("addr", s.config.API.Addr).Info("查询API启动")
+
+	go func() {
+		if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.WithField("error", err).Error("查询API异常退出")
+		}
+	}()
+	return nil
+}
+
+// Shutdown 优雅关闭HTTP服务
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
+
+// handleHealth 健康检查
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleUser 查询用户在各链上的余额和积分
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	address, err := parseAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	balances, err := s.repos.UserBalance.ListByUser(address)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	points, err := s.repos.UserPoints.ListByUser(address)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"address":  address,
+		"balances": balances,
+		"points":   points,
+	})
+}
+
+// handleSnapshot 查询区块或时间点上的持有人快照
+// 参数: chain（名称或链ID）、block 或 time（RFC3339）、format（json|csv）
+func (s *Server) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var snap *snapshot.Snapshot
+	switch {
+	case query.Get("block") != "":
+		block, err := strconv.ParseUint(query.Get("block"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("无效的区块号: %s", query.Get("block")))
+			return
+		}
+		snap, err = s.snapshots.AtBlock(chain.ChainID, block)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	case query.Get("time") != "":
+		t, err := time.Parse(time.RFC3339, query.Get("time"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("无效的时间: %s", query.Get("time")))
+			return
+		}
+		snap, err = s.snapshots.AtTime(chain.ChainID, t)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, errors.New("必须指定 block 或 time"))
+		return
+	}
+
+	if strings.EqualFold(query.Get("format"), "csv") {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=snapshot-%d-%d.csv", snap.ChainID, snap.BlockNumber))
+		if err := snapshot.WriteCSV(w, snap); err != nil {
+			logger.WithField("error", err).Error("输出CSV快照失败")
+		}
+		return
+	}
+	writeJSON(w, http.StatusOK, snap)
+}
+
+// parseAddress 校验并规范化地址
+func parseAddress(value string) (string, error) {
+	if !utils.IsValidAddress(value) {
+		return "", fmt.Errorf("无效的地址: %s", value)
+	}
+	return common.HexToAddress(value).Hex(), nil
+}
+
+// writeJSON 输出JSON响应
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.WithField("error", err).Error("输出JSON响应失败")
+	}
+}
+
+// writeError 输出错误响应
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
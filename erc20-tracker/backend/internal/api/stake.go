@@ -0,0 +1,113 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"erc20-tracker/backend/internal/stake"
+)
+
+// 质押查询的默认参数
+const (
+	defaultTopStakers  = 20
+	defaultStakeClaims = 50
+	maxQueryLimit      = 500
+)
+
+// handleStakePools 链上所有质押池及其TVL
+// 参数: chain
+func (s *Server) handleStakePools(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	pools, err := s.stake.Pools(chain.ChainID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id": chain.ChainID,
+		"pools":    pools,
+	})
+}
+
+// handleStakePool 质押池详情
+// 参数: chain、limit（质押最多的用户数，默认20）
+func (s *Server) handleStakePool(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	pid, err := strconv.ParseUint(r.PathValue("pid"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("无效的池ID: %s", r.PathValue("pid")))
+		return
+	}
+	limit, err := parseLimit(query, defaultTopStakers)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	pool, err := s.stake.Pool(chain.ChainID, pid, limit)
+	if errors.Is(err, stake.ErrPoolNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, pool)
+}
+
+// handleStakeUser 用户的质押持仓、待提取请求和奖励领取记录
+// 参数: chain、limit（奖励领取记录数，默认50）
+func (s *Server) handleStakeUser(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	address, err := parseAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultStakeClaims)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	user, err := s.stake.User(chain.ChainID, address, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+// parseLimit 解析limit参数
+func parseLimit(query url.Values, fallback int) (int, error) {
+	value := query.Get("limit")
+	if value == "" {
+		return fallback, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 || limit > maxQueryLimit {
+		return 0, fmt.Errorf("limit必须在1到%d之间", maxQueryLimit)
+	}
+	return limit, nil
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/tax"
+)
+
+// defaultTaxRecords 税费记录查询的默认条数
+const defaultTaxRecords = 50
+
+// handleTaxSummary 税率配置、累计税费及去向、付税最多的地址
+// 参数: chain
+func (s *Server) handleTaxSummary(w http.ResponseWriter, r *http.Request) {
+	chain, err := s.config.FindChain(r.URL.Query().Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	summary, err := s.tax.Summary(chain.ChainID)
+	if errors.Is(err, tax.ErrStateNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, summary)
+}
+
+// handleTaxes 最近的带税转账
+// 参数: chain、kind（buy/sell/transfer/unknown）、limit（默认50）
+func (s *Server) handleTaxes(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	kind := query.Get("kind")
+	switch kind {
+	case "", database.TaxKindBuy, database.TaxKindSell, database.TaxKindTransfer, database.TaxKindUnknown:
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("无效的税费类型: %s", kind))
+		return
+	}
+	limit, err := parseLimit(query, defaultTaxRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	taxes, err := s.tax.Taxes(chain.ChainID, kind, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id": chain.ChainID,
+		"taxes":    taxes,
+	})
+}
+
+// handleTaxRevenue 每日税费收入（按类型）和分配去向
+// 参数: chain、from、to（YYYY-MM-DD，默认最近7天）
+func (s *Server) handleTaxRevenue(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	from, to, err := s.parseWindow(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	revenue, err := s.tax.Revenue(chain.ChainID, from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, revenue)
+}
+
+// handleTaxPayer 付税方的累计税费和带税转账
+// 参数: chain、limit（默认50）
+func (s *Server) handleTaxPayer(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	address, err := parseAddress(r.PathValue("address"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultTaxRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	payer, err := s.tax.Payer(chain.ChainID, address, limit)
+	if errors.Is(err, tax.ErrPayerNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, payer)
+}
+
+// handleTaxDistributions 税费分配记录
+// 参数: chain、limit（默认50）
+func (s *Server) handleTaxDistributions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	chain, err := s.config.FindChain(query.Get("chain"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := parseLimit(query, defaultTaxRecords)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	distributions, err := s.tax.Distributions(chain.ChainID, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"chain_id":      chain.ChainID,
+		"distributions": distributions,
+	})
+}
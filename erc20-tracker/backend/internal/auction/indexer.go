@@ -0,0 +1,401 @@
+package auction
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	auctionbind "erc20-tracker/backend/internal/bindings/auction"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+func init() {
+	event.RegisterIndexer("auction", func(chain config.ChainConfig, cfg *config.Config, repos *database.Repositories) (event.Indexer, error) {
+		if chain.AuctionFactory == "" && len(chain.AuctionContracts) == 0 {
+			return nil, nil
+		}
+		return NewIndexer(chain, cfg.Auction, repos.Auction)
+	})
+}
+
+// auctionHandler 拍卖事件的处理函数，在登记日志的同一事务中执行
+type auctionHandler func(tx *database.AuctionTx, vLog types.Log, timestamp time.Time) error
+
+// Indexer NFT拍卖索引器
+// 跟随AuctionFactory发现新部署的拍卖合约，记录拍卖生命周期、出价历史和成交结算
+type Indexer struct {
+	chain    config.ChainConfig
+	feeRate  *big.Int
+	factory  common.Address // 未配置工厂时为零地址
+	auctions *auctionbind.NFTAuctionFilterer
+	factoryF *auctionbind.AuctionFactoryFilterer
+	repo     *database.AuctionRepository
+
+	factoryEvents *event.EventHandlers[auctionHandler]
+	auctionEvents *event.EventHandlers[auctionHandler]
+
+	mu        sync.RWMutex
+	contracts []common.Address // 已知的拍卖合约
+	discover  func(address common.Address)
+}
+
+// NewIndexer 创建拍卖索引器，并加载之前发现的拍卖合约
+func NewIndexer(chain config.ChainConfig, cfg config.AuctionConfig, repo *database.AuctionRepository) (*Indexer, error) {
+	idx := &Indexer{
+		chain:   chain,
+		feeRate: big.NewInt(cfg.PlatformFeeRate),
+		repo:    repo,
+	}
+
+	if chain.AuctionFactory != "" {
+		if !common.IsHexAddress(chain.AuctionFactory) {
+			return nil, fmt.Errorf("无效的拍卖工厂地址: %q", chain.AuctionFactory)
+		}
+		idx.factory = common.HexToAddress(chain.AuctionFactory)
+	}
+	for _, value := range chain.AuctionContracts {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("无效的拍卖合约地址: %q", value)
+		}
+		idx.addContract(common.HexToAddress(value))
+	}
+
+	// 工厂之前部署的合约：服务重启后不会再收到它们的创建事件
+	known, err := repo.ListContracts(chain.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("获取已发现的拍卖合约失败: %w", err)
+	}
+	for _, contract := range known {
+		idx.addContract(common.HexToAddress(contract.ContractAddress))
+	}
+
+	if idx.auctions, err = auctionbind.NewNFTAuctionFilterer(common.Address{}, nil); err != nil {
+		return nil, fmt.Errorf("创建拍卖合约绑定失败: %w", err)
+	}
+	if idx.factoryF, err = auctionbind.NewAuctionFactoryFilterer(idx.factory, nil); err != nil {
+		return nil, fmt.Errorf("创建拍卖工厂绑定失败: %w", err)
+	}
+
+	if idx.factoryEvents, err = event.BindEvents(auctionbind.AuctionFactoryMetaData.GetAbi, "拍卖工厂", map[string]auctionHandler{
+		"AuctionContractCreated":     idx.handleContractCreated,
+		"AuctionContractDeactivated": idx.handleContractDeactivated,
+	}); err != nil {
+		return nil, err
+	}
+	if idx.auctionEvents, err = event.BindEvents(auctionbind.NFTAuctionMetaData.GetAbi, "拍卖合约", map[string]auctionHandler{
+		"AuctionCreated":   idx.handleAuctionCreated,
+		"BidPlaced":        idx.handleBidPlaced,
+		"AuctionEnded":     idx.handleAuctionEnded,
+		"AuctionCancelled": idx.handleAuctionCancelled,
+	}); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Name 索引器名称
+func (idx *Indexer) Name() string {
+	return "NFTAuction"
+}
+
+// ABIs 拍卖合约和工厂合约的ABI
+func (idx *Indexer) ABIs() map[string]string {
+	return map[string]string{
+		"NFTAuction":     auctionbind.NFTAuctionMetaData.ABI,
+		"AuctionFactory": auctionbind.AuctionFactoryMetaData.ABI,
+	}
+}
+
+// Addresses 工厂合约和已知的拍卖合约
+func (idx *Indexer) Addresses() []common.Address {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	addresses := slices.Clone(idx.contracts)
+	if idx.factory != (common.Address{}) {
+		addresses = append([]common.Address{idx.factory}, addresses...)
+	}
+	return addresses
+}
+
+// SetDiscoverFunc 注册发现新拍卖合约时的回调
+func (idx *Indexer) SetDiscoverFunc(fn func(address common.Address)) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.discover = fn
+}
+
+// HandleLog 处理工厂和拍卖合约的日志，所有权、模板更新等其他事件返回false，按通用合约事件保存
+func (idx *Indexer) HandleLog(vLog types.Log, timestamp time.Time) (bool, error) {
+	events := idx.auctionEvents
+	if idx.factory != (common.Address{}) && vLog.Address == idx.factory {
+		events = idx.factoryEvents
+	}
+	handler, name, ok := events.Lookup(vLog)
+	if !ok {
+		return false, nil
+	}
+	err := event.ApplyLog(idx, idx.chain, name, vLog, idx.repo.Apply, func(tx *database.AuctionTx) error {
+		return handler(tx, vLog, timestamp)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// handleContractCreated 工厂部署了新的拍卖合约，加入监听
+func (idx *Indexer) handleContractCreated(tx *database.AuctionTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.factoryF.ParseAuctionContractCreated(vLog)
+	if err != nil {
+		return err
+	}
+
+	contract := &database.AuctionContract{
+		ContractAddress: ev.AuctionContract.Hex(),
+		FactoryAddress:  idx.factory.Hex(),
+		FactoryID:       ev.ContractId.Uint64(),
+		Creator:         ev.Creator.Hex(),
+		Name:            ev.Name,
+		Description:     ev.Description,
+		Active:          true,
+		DeployedBlock:   vLog.BlockNumber,
+		DeployedTxHash:  vLog.TxHash.Hex(),
+	}
+	if err := tx.SaveContract(contract); err != nil {
+		return err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain":    idx.chain.Name,
+		"contract": contract.ContractAddress,
+		"creator":  contract.Creator,
+		"name":     contract.Name,
+	}).Info("发现新的拍卖合约")
+
+	// 事务提交失败时合约也已加入监听，重新处理该日志时不会遗漏
+	if idx.addContract(ev.AuctionContract) {
+		idx.mu.RLock()
+		discover := idx.discover
+		idx.mu.RUnlock()
+		if discover != nil {
+			discover(ev.AuctionContract)
+		}
+	}
+	return nil
+}
+
+// handleContractDeactivated 工厂停用拍卖合约，停用后合约仍可能结束已有拍卖，继续监听
+func (idx *Indexer) handleContractDeactivated(tx *database.AuctionTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.factoryF.ParseAuctionContractDeactivated(vLog)
+	if err != nil {
+		return err
+	}
+
+	updated, err := tx.DeactivateContract(idx.factory.Hex(), ev.ContractId.Uint64())
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		logger.WithFields(map[string]interface{}{
+			"chain":       idx.chain.Name,
+			"contract_id": ev.ContractId.String(),
+			"tx_hash":     vLog.TxHash.Hex(),
+		}).Warn("停用的拍卖合约不存在，可能起始区块晚于其创建")
+	}
+	return nil
+}
+
+// handleAuctionCreated 创建拍卖
+func (idx *Indexer) handleAuctionCreated(tx *database.AuctionTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.auctions.ParseAuctionCreated(vLog)
+	if err != nil {
+		return err
+	}
+
+	contract := vLog.Address.Hex()
+	auction, err := tx.GetAuction(contract, ev.AuctionId.Uint64())
+	if err != nil {
+		return err
+	}
+	if auction == nil {
+		auction = newAuction(contract, ev.AuctionId.Uint64())
+	}
+	auction.Seller = ev.Seller.Hex()
+	auction.NFTContract = ev.NftContract.Hex()
+	auction.TokenID = ev.TokenId.String()
+	auction.StartPriceUSD = ev.StartPrice.String()
+	auction.ReservePriceUSD = ev.ReservePrice.String()
+	auction.StartTime = time.Unix(ev.StartTime.Int64(), 0)
+	auction.EndTime = time.Unix(ev.EndTime.Int64(), 0)
+	auction.CreatedBlock = vLog.BlockNumber
+	auction.CreatedTxHash = vLog.TxHash.Hex()
+	return tx.SaveAuction(auction)
+}
+
+// handleBidPlaced 出价：之前的最高出价被退还
+func (idx *Indexer) handleBidPlaced(tx *database.AuctionTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.auctions.ParseBidPlaced(vLog)
+	if err != nil {
+		return err
+	}
+
+	contract := vLog.Address.Hex()
+	auctionID := ev.AuctionId.Uint64()
+	auction, err := idx.loadAuction(tx, contract, auctionID, vLog)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.SetBidStatus(contract, auctionID, database.BidStatusActive, database.BidStatusOutbid); err != nil {
+		return err
+	}
+	bid := &database.AuctionBid{
+		ContractAddress: contract,
+		AuctionID:       auctionID,
+		Bidder:          ev.Bidder.Hex(),
+		BidUSD:          ev.BidAmountUSD.String(),
+		BidToken:        ev.BidToken.Hex(),
+		BidAmount:       ev.BidAmount.String(),
+		Status:          database.BidStatusActive,
+		TxHash:          vLog.TxHash.Hex(),
+		LogIndex:        vLog.Index,
+		BlockNumber:     vLog.BlockNumber,
+		Timestamp:       timestamp,
+	}
+	if err := tx.CreateBid(bid); err != nil {
+		return err
+	}
+
+	auction.BidCount++
+	auction.HighestBidder = bid.Bidder
+	auction.HighestBidUSD = bid.BidUSD
+	auction.BidToken = bid.BidToken
+	auction.BidAmount = bid.BidAmount
+	return tx.SaveAuction(auction)
+}
+
+// handleAuctionEnded 结束拍卖
+// 有出价且达到保留价时成交：NFT转给赢家，出价扣除平台费后支付给卖家；否则退还最高出价
+// 未成交时事件中的winner仍是最高出价者，因此按保留价判断是否成交
+func (idx *Indexer) handleAuctionEnded(tx *database.AuctionTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.auctions.ParseAuctionEnded(vLog)
+	if err != nil {
+		return err
+	}
+
+	contract := vLog.Address.Hex()
+	auctionID := ev.AuctionId.Uint64()
+	auction, err := idx.loadAuction(tx, contract, auctionID, vLog)
+	if err != nil {
+		return err
+	}
+
+	endedAt := timestamp
+	auction.Status = database.AuctionStatusEnded
+	auction.EndedBlock = vLog.BlockNumber
+	auction.EndedTxHash = vLog.TxHash.Hex()
+	auction.EndedAt = &endedAt
+	auction.HighestBidUSD = ev.WinningBidUSD.String()
+	auction.BidToken = ev.BidToken.Hex()
+	auction.BidAmount = ev.BidAmount.String()
+
+	reserve := database.ParseDecimal(auction.ReservePriceUSD)
+	auction.Sold = ev.Winner != (common.Address{}) && ev.WinningBidUSD.Cmp(reserve) >= 0
+
+	bidStatus := database.BidStatusRefunded
+	if auction.Sold {
+		fee := new(big.Int).Mul(ev.BidAmount, idx.feeRate)
+		fee.Quo(fee, big.NewInt(10000))
+		auction.Winner = ev.Winner.Hex()
+		auction.PlatformFee = fee.String()
+		auction.SellerProceeds = new(big.Int).Sub(ev.BidAmount, fee).String()
+		bidStatus = database.BidStatusWon
+	}
+	if err := tx.SetBidStatus(contract, auctionID, database.BidStatusActive, bidStatus); err != nil {
+		return err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain":      idx.chain.Name,
+		"contract":   contract,
+		"auction_id": auctionID,
+		"sold":       auction.Sold,
+		"winner":     auction.Winner,
+		"bid_usd":    auction.HighestBidUSD,
+	}).Info("拍卖结束")
+	return tx.SaveAuction(auction)
+}
+
+// handleAuctionCancelled 取消拍卖（只有无人出价时可以取消）
+func (idx *Indexer) handleAuctionCancelled(tx *database.AuctionTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.auctions.ParseAuctionCancelled(vLog)
+	if err != nil {
+		return err
+	}
+
+	auction, err := idx.loadAuction(tx, vLog.Address.Hex(), ev.AuctionId.Uint64(), vLog)
+	if err != nil {
+		return err
+	}
+
+	endedAt := timestamp
+	auction.Status = database.AuctionStatusCancelled
+	auction.EndedBlock = vLog.BlockNumber
+	auction.EndedTxHash = vLog.TxHash.Hex()
+	auction.EndedAt = &endedAt
+	return tx.SaveAuction(auction)
+}
+
+// loadAuction 获取拍卖；起始区块晚于AuctionCreated时拍卖不存在，创建缺少NFT信息的占位记录
+func (idx *Indexer) loadAuction(tx *database.AuctionTx, contract string, auctionID uint64, vLog types.Log) (*database.Auction, error) {
+	auction, err := tx.GetAuction(contract, auctionID)
+	if err != nil || auction != nil {
+		return auction, err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain":      idx.chain.Name,
+		"contract":   contract,
+		"auction_id": auctionID,
+		"tx_hash":    vLog.TxHash.Hex(),
+	}).Warn("拍卖不存在，可能起始区块晚于AuctionCreated，创建占位记录")
+	auction = newAuction(contract, auctionID)
+	auction.CreatedBlock = vLog.BlockNumber
+	return auction, nil
+}
+
+// addContract 记录已知的拍卖合约，返回是否新增
+func (idx *Indexer) addContract(address common.Address) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if slices.Contains(idx.contracts, address) {
+		return false
+	}
+	idx.contracts = append(idx.contracts, address)
+	return true
+}
+
+// newAuction 创建进行中的零值拍卖
+func newAuction(contract string, auctionID uint64) *database.Auction {
+	return &database.Auction{
+		ContractAddress: contract,
+		AuctionID:       auctionID,
+		Status:          database.AuctionStatusActive,
+		StartPriceUSD:   "0",
+		ReservePriceUSD: "0",
+		HighestBidUSD:   "0",
+		BidAmount:       "0",
+		PlatformFee:     "0",
+		SellerProceeds:  "0",
+	}
+}
@@ -0,0 +1,159 @@
+package auction
+
+import (
+	"errors"
+	"math/big"
+
+	"gorm.io/gorm"
+
+	"erc20-tracker/backend/internal/database"
+)
+
+// ErrAuctionNotFound 拍卖不存在
+var ErrAuctionNotFound = errors.New("拍卖不存在")
+
+// maxBidsPerAuction 拍卖详情中返回的最多出价数
+const maxBidsPerAuction = 200
+
+// Detail 拍卖详情及出价历史（按时间倒序）
+type Detail struct {
+	database.Auction
+	Bids []database.AuctionBid `json:"bids"`
+}
+
+// NFTHistory 一个NFT的拍卖历史
+type NFTHistory struct {
+	ChainID     int64    `json:"chain_id"`
+	NFTContract string   `json:"nft_contract"`
+	TokenID     string   `json:"token_id"`
+	Auctions    []Detail `json:"auctions"`
+}
+
+// User 用户的拍卖活动
+type User struct {
+	ChainID    int64                 `json:"chain_id"`
+	Address    string                `json:"address"`
+	BidCount   int                   `json:"bid_count"`
+	WonCount   int                   `json:"won_count"`
+	WonUSD     string                `json:"won_usd"`  // 成交出价的美元合计（18位精度）
+	SoldUSD    string                `json:"sold_usd"` // 作为卖家成交的美元合计（18位精度）
+	Selling    []database.Auction    `json:"selling"`
+	Won        []database.Auction    `json:"won"`
+	RecentBids []database.AuctionBid `json:"recent_bids"`
+}
+
+// Service 拍卖数据查询服务
+type Service struct {
+	repo *database.AuctionRepository
+}
+
+// NewService 创建拍卖查询服务
+func NewService(repos *database.Repositories) *Service {
+	return &Service{repo: repos.Auction}
+}
+
+// Contracts 链上已发现的拍卖合约
+func (s *Service) Contracts(chainID int64) ([]database.AuctionContract, error) {
+	return s.repo.ListContracts(chainID)
+}
+
+// Auctions 按条件查询拍卖
+func (s *Service) Auctions(filter database.AuctionFilter) ([]database.Auction, error) {
+	return s.repo.ListAuctions(filter)
+}
+
+// Auction 拍卖详情及出价历史
+func (s *Service) Auction(chainID int64, contract string, auctionID uint64) (*Detail, error) {
+	auction, err := s.repo.GetAuction(chainID, contract, auctionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAuctionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := s.withBids(chainID, []database.Auction{*auction})
+	if err != nil {
+		return nil, err
+	}
+	return &details[0], nil
+}
+
+// NFT 一个NFT在所有拍卖合约中的拍卖历史
+func (s *Service) NFT(chainID int64, nftContract, tokenID string) (*NFTHistory, error) {
+	auctions, err := s.repo.ListAuctions(database.AuctionFilter{
+		ChainID:     chainID,
+		NFTContract: nftContract,
+		TokenID:     tokenID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := s.withBids(chainID, auctions)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTHistory{ChainID: chainID, NFTContract: nftContract, TokenID: tokenID, Auctions: details}, nil
+}
+
+// User 用户作为卖家、出价者和赢家的拍卖活动
+func (s *Service) User(chainID int64, address string, limit int) (*User, error) {
+	selling, err := s.repo.ListAuctions(database.AuctionFilter{ChainID: chainID, Seller: address})
+	if err != nil {
+		return nil, err
+	}
+	won, err := s.repo.ListAuctions(database.AuctionFilter{ChainID: chainID, Winner: address})
+	if err != nil {
+		return nil, err
+	}
+	bids, err := s.repo.ListBids(database.AuctionBidFilter{ChainID: chainID, Bidder: address})
+	if err != nil {
+		return nil, err
+	}
+
+	wonUSD := big.NewInt(0)
+	for _, auction := range won {
+		wonUSD.Add(wonUSD, database.ParseDecimal(auction.HighestBidUSD))
+	}
+	soldUSD := big.NewInt(0)
+	for _, auction := range selling {
+		if auction.Sold {
+			soldUSD.Add(soldUSD, database.ParseDecimal(auction.HighestBidUSD))
+		}
+	}
+
+	recent := bids
+	if limit > 0 && len(recent) > limit {
+		recent = recent[:limit]
+	}
+	return &User{
+		ChainID:    chainID,
+		Address:    address,
+		BidCount:   len(bids),
+		WonCount:   len(won),
+		WonUSD:     wonUSD.String(),
+		SoldUSD:    soldUSD.String(),
+		Selling:    selling,
+		Won:        won,
+		RecentBids: recent,
+	}, nil
+}
+
+// withBids 为拍卖附加出价历史
+func (s *Service) withBids(chainID int64, auctions []database.Auction) ([]Detail, error) {
+	details := make([]Detail, 0, len(auctions))
+	for _, auction := range auctions {
+		bids, err := s.repo.ListBids(database.AuctionBidFilter{
+			ChainID:         chainID,
+			ContractAddress: auction.ContractAddress,
+			AuctionIDs:      []uint64{auction.AuctionID},
+			Limit:           maxBidsPerAuction,
+		})
+		if err != nil {
+			return nil, err
+		}
+		details = append(details, Detail{Auction: auction, Bids: bids})
+	}
+	return details, nil
+}
@@ -0,0 +1,1599 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package auction
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AuctionFactoryAuctionInfo is an auto generated low-level Go binding around an user-defined struct.
+type AuctionFactoryAuctionInfo struct {
+	AuctionContract common.Address
+	Creator         common.Address
+	CreatedAt       *big.Int
+	IsActive        bool
+	Name            string
+	Description     string
+}
+
+// AuctionFactoryMetaData contains all meta data concerning the AuctionFactory contract.
+var AuctionFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_feeRecipient\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ContractAlreadyDeactivated\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ContractNotFound\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidFeeRate\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidTemplate\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotContractCreator\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"contractId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\",\"indexed\":false},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\",\"indexed\":false}],\"name\":\"AuctionContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"contractId\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"AuctionContractDeactivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"ethUsdPriceFeed\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"platformFeeRate\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"DefaultConfigUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newTemplate\",\"type\":\"address\",\"indexed\":true}],\"name\":\"TemplateUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"auctionContractCounter\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"auctionContracts\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isActive\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"auctionTemplate\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"names\",\"type\":\"string[]\"},{\"internalType\":\"string[]\",\"name\":\"descriptions\",\"type\":\"string[]\"},{\"internalType\":\"address[]\",\"name\":\"ethUsdPriceFeeds\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"feeRecipients\",\"type\":\"address[]\"}],\"name\":\"batchCreateAuctionContracts\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"contractIds\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"auctionContracts\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"ethUsdPriceFeed\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"feeRecipient\",\"type\":\"address\"}],\"name\":\"createAuctionContract\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"contractId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"ethUsdPriceFeed\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"feeRecipient\",\"type\":\"address\"}],\"name\":\"createAuctionContractClone\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"contractId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"contractId\",\"type\":\"uint256\"}],\"name\":\"deactivateAuctionContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultEthUsdPriceFeed\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultFeeRecipient\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultPlatformFeeRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getActiveAuctionContractsCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAllActiveAuctionContracts\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"contractId\",\"type\":\"uint256\"}],\"name\":\"getAuctionContract\",\"outputs\":[{\"internalType\":\"structAuctionFactory.AuctionInfo\",\"name\":\"\",\"type\":\"tuple\",\"components\":[{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"createdAt\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isActive\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}]}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"getAuctionContractsByCreator\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalAuctionContracts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserAuctionContracts\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isAuctionContract\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_template\",\"type\":\"address\"}],\"name\":\"setAuctionTemplate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_feeRecipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_platformFeeRate\",\"type\":\"uint256\"}],\"name\":\"setDefaultConfig\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"userAuctionContracts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// AuctionFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use AuctionFactoryMetaData.ABI instead.
+var AuctionFactoryABI = AuctionFactoryMetaData.ABI
+
+// AuctionFactory is an auto generated Go binding around an Ethereum contract.
+type AuctionFactory struct {
+	AuctionFactoryCaller     // Read-only binding to the contract
+	AuctionFactoryTransactor // Write-only binding to the contract
+	AuctionFactoryFilterer   // Log filterer for contract events
+}
+
+// AuctionFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AuctionFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AuctionFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AuctionFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AuctionFactorySession struct {
+	Contract     *AuctionFactory   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AuctionFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AuctionFactoryCallerSession struct {
+	Contract *AuctionFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// AuctionFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AuctionFactoryTransactorSession struct {
+	Contract     *AuctionFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// AuctionFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AuctionFactoryRaw struct {
+	Contract *AuctionFactory // Generic contract binding to access the raw methods on
+}
+
+// AuctionFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AuctionFactoryCallerRaw struct {
+	Contract *AuctionFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AuctionFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AuctionFactoryTransactorRaw struct {
+	Contract *AuctionFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAuctionFactory creates a new instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactory(address common.Address, backend bind.ContractBackend) (*AuctionFactory, error) {
+	contract, err := bindAuctionFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactory{AuctionFactoryCaller: AuctionFactoryCaller{contract: contract}, AuctionFactoryTransactor: AuctionFactoryTransactor{contract: contract}, AuctionFactoryFilterer: AuctionFactoryFilterer{contract: contract}}, nil
+}
+
+// NewAuctionFactoryCaller creates a new read-only instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryCaller(address common.Address, caller bind.ContractCaller) (*AuctionFactoryCaller, error) {
+	contract, err := bindAuctionFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryCaller{contract: contract}, nil
+}
+
+// NewAuctionFactoryTransactor creates a new write-only instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*AuctionFactoryTransactor, error) {
+	contract, err := bindAuctionFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryTransactor{contract: contract}, nil
+}
+
+// NewAuctionFactoryFilterer creates a new log filterer instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*AuctionFactoryFilterer, error) {
+	contract, err := bindAuctionFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryFilterer{contract: contract}, nil
+}
+
+// bindAuctionFactory binds a generic wrapper to an already deployed contract.
+func bindAuctionFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AuctionFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuctionFactory *AuctionFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuctionFactory.Contract.AuctionFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuctionFactory *AuctionFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.AuctionFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuctionFactory *AuctionFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.AuctionFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuctionFactory *AuctionFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuctionFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuctionFactory *AuctionFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuctionFactory *AuctionFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// AuctionContractCounter is a free data retrieval call binding the contract method 0x63585270.
+//
+// Solidity: function auctionContractCounter() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCaller) AuctionContractCounter(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "auctionContractCounter")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AuctionContractCounter is a free data retrieval call binding the contract method 0x63585270.
+//
+// Solidity: function auctionContractCounter() view returns(uint256)
+func (_AuctionFactory *AuctionFactorySession) AuctionContractCounter() (*big.Int, error) {
+	return _AuctionFactory.Contract.AuctionContractCounter(&_AuctionFactory.CallOpts)
+}
+
+// AuctionContractCounter is a free data retrieval call binding the contract method 0x63585270.
+//
+// Solidity: function auctionContractCounter() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCallerSession) AuctionContractCounter() (*big.Int, error) {
+	return _AuctionFactory.Contract.AuctionContractCounter(&_AuctionFactory.CallOpts)
+}
+
+// AuctionContracts is a free data retrieval call binding the contract method 0xa4ed8789.
+//
+// Solidity: function auctionContracts(uint256 ) view returns(address auctionContract, address creator, uint256 createdAt, bool isActive, string name, string description)
+func (_AuctionFactory *AuctionFactoryCaller) AuctionContracts(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	AuctionContract common.Address
+	Creator         common.Address
+	CreatedAt       *big.Int
+	IsActive        bool
+	Name            string
+	Description     string
+}, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "auctionContracts", arg0)
+
+	outstruct := new(struct {
+		AuctionContract common.Address
+		Creator         common.Address
+		CreatedAt       *big.Int
+		IsActive        bool
+		Name            string
+		Description     string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.AuctionContract = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Creator = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.CreatedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.IsActive = *abi.ConvertType(out[3], new(bool)).(*bool)
+	outstruct.Name = *abi.ConvertType(out[4], new(string)).(*string)
+	outstruct.Description = *abi.ConvertType(out[5], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// AuctionContracts is a free data retrieval call binding the contract method 0xa4ed8789.
+//
+// Solidity: function auctionContracts(uint256 ) view returns(address auctionContract, address creator, uint256 createdAt, bool isActive, string name, string description)
+func (_AuctionFactory *AuctionFactorySession) AuctionContracts(arg0 *big.Int) (struct {
+	AuctionContract common.Address
+	Creator         common.Address
+	CreatedAt       *big.Int
+	IsActive        bool
+	Name            string
+	Description     string
+}, error) {
+	return _AuctionFactory.Contract.AuctionContracts(&_AuctionFactory.CallOpts, arg0)
+}
+
+// AuctionContracts is a free data retrieval call binding the contract method 0xa4ed8789.
+//
+// Solidity: function auctionContracts(uint256 ) view returns(address auctionContract, address creator, uint256 createdAt, bool isActive, string name, string description)
+func (_AuctionFactory *AuctionFactoryCallerSession) AuctionContracts(arg0 *big.Int) (struct {
+	AuctionContract common.Address
+	Creator         common.Address
+	CreatedAt       *big.Int
+	IsActive        bool
+	Name            string
+	Description     string
+}, error) {
+	return _AuctionFactory.Contract.AuctionContracts(&_AuctionFactory.CallOpts, arg0)
+}
+
+// AuctionTemplate is a free data retrieval call binding the contract method 0xf2be7f53.
+//
+// Solidity: function auctionTemplate() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) AuctionTemplate(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "auctionTemplate")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AuctionTemplate is a free data retrieval call binding the contract method 0xf2be7f53.
+//
+// Solidity: function auctionTemplate() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) AuctionTemplate() (common.Address, error) {
+	return _AuctionFactory.Contract.AuctionTemplate(&_AuctionFactory.CallOpts)
+}
+
+// AuctionTemplate is a free data retrieval call binding the contract method 0xf2be7f53.
+//
+// Solidity: function auctionTemplate() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) AuctionTemplate() (common.Address, error) {
+	return _AuctionFactory.Contract.AuctionTemplate(&_AuctionFactory.CallOpts)
+}
+
+// DefaultEthUsdPriceFeed is a free data retrieval call binding the contract method 0xb7c7f272.
+//
+// Solidity: function defaultEthUsdPriceFeed() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) DefaultEthUsdPriceFeed(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "defaultEthUsdPriceFeed")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DefaultEthUsdPriceFeed is a free data retrieval call binding the contract method 0xb7c7f272.
+//
+// Solidity: function defaultEthUsdPriceFeed() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) DefaultEthUsdPriceFeed() (common.Address, error) {
+	return _AuctionFactory.Contract.DefaultEthUsdPriceFeed(&_AuctionFactory.CallOpts)
+}
+
+// DefaultEthUsdPriceFeed is a free data retrieval call binding the contract method 0xb7c7f272.
+//
+// Solidity: function defaultEthUsdPriceFeed() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) DefaultEthUsdPriceFeed() (common.Address, error) {
+	return _AuctionFactory.Contract.DefaultEthUsdPriceFeed(&_AuctionFactory.CallOpts)
+}
+
+// DefaultFeeRecipient is a free data retrieval call binding the contract method 0x4e3fe278.
+//
+// Solidity: function defaultFeeRecipient() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) DefaultFeeRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "defaultFeeRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DefaultFeeRecipient is a free data retrieval call binding the contract method 0x4e3fe278.
+//
+// Solidity: function defaultFeeRecipient() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) DefaultFeeRecipient() (common.Address, error) {
+	return _AuctionFactory.Contract.DefaultFeeRecipient(&_AuctionFactory.CallOpts)
+}
+
+// DefaultFeeRecipient is a free data retrieval call binding the contract method 0x4e3fe278.
+//
+// Solidity: function defaultFeeRecipient() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) DefaultFeeRecipient() (common.Address, error) {
+	return _AuctionFactory.Contract.DefaultFeeRecipient(&_AuctionFactory.CallOpts)
+}
+
+// DefaultPlatformFeeRate is a free data retrieval call binding the contract method 0x00a76770.
+//
+// Solidity: function defaultPlatformFeeRate() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCaller) DefaultPlatformFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "defaultPlatformFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultPlatformFeeRate is a free data retrieval call binding the contract method 0x00a76770.
+//
+// Solidity: function defaultPlatformFeeRate() view returns(uint256)
+func (_AuctionFactory *AuctionFactorySession) DefaultPlatformFeeRate() (*big.Int, error) {
+	return _AuctionFactory.Contract.DefaultPlatformFeeRate(&_AuctionFactory.CallOpts)
+}
+
+// DefaultPlatformFeeRate is a free data retrieval call binding the contract method 0x00a76770.
+//
+// Solidity: function defaultPlatformFeeRate() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCallerSession) DefaultPlatformFeeRate() (*big.Int, error) {
+	return _AuctionFactory.Contract.DefaultPlatformFeeRate(&_AuctionFactory.CallOpts)
+}
+
+// GetActiveAuctionContractsCount is a free data retrieval call binding the contract method 0x47f4d073.
+//
+// Solidity: function getActiveAuctionContractsCount() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCaller) GetActiveAuctionContractsCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getActiveAuctionContractsCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetActiveAuctionContractsCount is a free data retrieval call binding the contract method 0x47f4d073.
+//
+// Solidity: function getActiveAuctionContractsCount() view returns(uint256)
+func (_AuctionFactory *AuctionFactorySession) GetActiveAuctionContractsCount() (*big.Int, error) {
+	return _AuctionFactory.Contract.GetActiveAuctionContractsCount(&_AuctionFactory.CallOpts)
+}
+
+// GetActiveAuctionContractsCount is a free data retrieval call binding the contract method 0x47f4d073.
+//
+// Solidity: function getActiveAuctionContractsCount() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCallerSession) GetActiveAuctionContractsCount() (*big.Int, error) {
+	return _AuctionFactory.Contract.GetActiveAuctionContractsCount(&_AuctionFactory.CallOpts)
+}
+
+// GetAllActiveAuctionContracts is a free data retrieval call binding the contract method 0x48f8a48f.
+//
+// Solidity: function getAllActiveAuctionContracts() view returns(uint256[])
+func (_AuctionFactory *AuctionFactoryCaller) GetAllActiveAuctionContracts(opts *bind.CallOpts) ([]*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getAllActiveAuctionContracts")
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetAllActiveAuctionContracts is a free data retrieval call binding the contract method 0x48f8a48f.
+//
+// Solidity: function getAllActiveAuctionContracts() view returns(uint256[])
+func (_AuctionFactory *AuctionFactorySession) GetAllActiveAuctionContracts() ([]*big.Int, error) {
+	return _AuctionFactory.Contract.GetAllActiveAuctionContracts(&_AuctionFactory.CallOpts)
+}
+
+// GetAllActiveAuctionContracts is a free data retrieval call binding the contract method 0x48f8a48f.
+//
+// Solidity: function getAllActiveAuctionContracts() view returns(uint256[])
+func (_AuctionFactory *AuctionFactoryCallerSession) GetAllActiveAuctionContracts() ([]*big.Int, error) {
+	return _AuctionFactory.Contract.GetAllActiveAuctionContracts(&_AuctionFactory.CallOpts)
+}
+
+// GetAuctionContract is a free data retrieval call binding the contract method 0xe506dfbe.
+//
+// Solidity: function getAuctionContract(uint256 contractId) view returns((address,address,uint256,bool,string,string))
+func (_AuctionFactory *AuctionFactoryCaller) GetAuctionContract(opts *bind.CallOpts, contractId *big.Int) (AuctionFactoryAuctionInfo, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getAuctionContract", contractId)
+
+	if err != nil {
+		return *new(AuctionFactoryAuctionInfo), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(AuctionFactoryAuctionInfo)).(*AuctionFactoryAuctionInfo)
+
+	return out0, err
+
+}
+
+// GetAuctionContract is a free data retrieval call binding the contract method 0xe506dfbe.
+//
+// Solidity: function getAuctionContract(uint256 contractId) view returns((address,address,uint256,bool,string,string))
+func (_AuctionFactory *AuctionFactorySession) GetAuctionContract(contractId *big.Int) (AuctionFactoryAuctionInfo, error) {
+	return _AuctionFactory.Contract.GetAuctionContract(&_AuctionFactory.CallOpts, contractId)
+}
+
+// GetAuctionContract is a free data retrieval call binding the contract method 0xe506dfbe.
+//
+// Solidity: function getAuctionContract(uint256 contractId) view returns((address,address,uint256,bool,string,string))
+func (_AuctionFactory *AuctionFactoryCallerSession) GetAuctionContract(contractId *big.Int) (AuctionFactoryAuctionInfo, error) {
+	return _AuctionFactory.Contract.GetAuctionContract(&_AuctionFactory.CallOpts, contractId)
+}
+
+// GetAuctionContractsByCreator is a free data retrieval call binding the contract method 0x722929c1.
+//
+// Solidity: function getAuctionContractsByCreator(address creator) view returns(uint256[])
+func (_AuctionFactory *AuctionFactoryCaller) GetAuctionContractsByCreator(opts *bind.CallOpts, creator common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getAuctionContractsByCreator", creator)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetAuctionContractsByCreator is a free data retrieval call binding the contract method 0x722929c1.
+//
+// Solidity: function getAuctionContractsByCreator(address creator) view returns(uint256[])
+func (_AuctionFactory *AuctionFactorySession) GetAuctionContractsByCreator(creator common.Address) ([]*big.Int, error) {
+	return _AuctionFactory.Contract.GetAuctionContractsByCreator(&_AuctionFactory.CallOpts, creator)
+}
+
+// GetAuctionContractsByCreator is a free data retrieval call binding the contract method 0x722929c1.
+//
+// Solidity: function getAuctionContractsByCreator(address creator) view returns(uint256[])
+func (_AuctionFactory *AuctionFactoryCallerSession) GetAuctionContractsByCreator(creator common.Address) ([]*big.Int, error) {
+	return _AuctionFactory.Contract.GetAuctionContractsByCreator(&_AuctionFactory.CallOpts, creator)
+}
+
+// GetTotalAuctionContracts is a free data retrieval call binding the contract method 0xa7788e56.
+//
+// Solidity: function getTotalAuctionContracts() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCaller) GetTotalAuctionContracts(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getTotalAuctionContracts")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalAuctionContracts is a free data retrieval call binding the contract method 0xa7788e56.
+//
+// Solidity: function getTotalAuctionContracts() view returns(uint256)
+func (_AuctionFactory *AuctionFactorySession) GetTotalAuctionContracts() (*big.Int, error) {
+	return _AuctionFactory.Contract.GetTotalAuctionContracts(&_AuctionFactory.CallOpts)
+}
+
+// GetTotalAuctionContracts is a free data retrieval call binding the contract method 0xa7788e56.
+//
+// Solidity: function getTotalAuctionContracts() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCallerSession) GetTotalAuctionContracts() (*big.Int, error) {
+	return _AuctionFactory.Contract.GetTotalAuctionContracts(&_AuctionFactory.CallOpts)
+}
+
+// GetUserAuctionContracts is a free data retrieval call binding the contract method 0x041380e6.
+//
+// Solidity: function getUserAuctionContracts(address user) view returns(uint256[])
+func (_AuctionFactory *AuctionFactoryCaller) GetUserAuctionContracts(opts *bind.CallOpts, user common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getUserAuctionContracts", user)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetUserAuctionContracts is a free data retrieval call binding the contract method 0x041380e6.
+//
+// Solidity: function getUserAuctionContracts(address user) view returns(uint256[])
+func (_AuctionFactory *AuctionFactorySession) GetUserAuctionContracts(user common.Address) ([]*big.Int, error) {
+	return _AuctionFactory.Contract.GetUserAuctionContracts(&_AuctionFactory.CallOpts, user)
+}
+
+// GetUserAuctionContracts is a free data retrieval call binding the contract method 0x041380e6.
+//
+// Solidity: function getUserAuctionContracts(address user) view returns(uint256[])
+func (_AuctionFactory *AuctionFactoryCallerSession) GetUserAuctionContracts(user common.Address) ([]*big.Int, error) {
+	return _AuctionFactory.Contract.GetUserAuctionContracts(&_AuctionFactory.CallOpts, user)
+}
+
+// IsAuctionContract is a free data retrieval call binding the contract method 0x435a3454.
+//
+// Solidity: function isAuctionContract(address ) view returns(bool)
+func (_AuctionFactory *AuctionFactoryCaller) IsAuctionContract(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "isAuctionContract", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsAuctionContract is a free data retrieval call binding the contract method 0x435a3454.
+//
+// Solidity: function isAuctionContract(address ) view returns(bool)
+func (_AuctionFactory *AuctionFactorySession) IsAuctionContract(arg0 common.Address) (bool, error) {
+	return _AuctionFactory.Contract.IsAuctionContract(&_AuctionFactory.CallOpts, arg0)
+}
+
+// IsAuctionContract is a free data retrieval call binding the contract method 0x435a3454.
+//
+// Solidity: function isAuctionContract(address ) view returns(bool)
+func (_AuctionFactory *AuctionFactoryCallerSession) IsAuctionContract(arg0 common.Address) (bool, error) {
+	return _AuctionFactory.Contract.IsAuctionContract(&_AuctionFactory.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) Owner() (common.Address, error) {
+	return _AuctionFactory.Contract.Owner(&_AuctionFactory.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) Owner() (common.Address, error) {
+	return _AuctionFactory.Contract.Owner(&_AuctionFactory.CallOpts)
+}
+
+// UserAuctionContracts is a free data retrieval call binding the contract method 0x41f9faf8.
+//
+// Solidity: function userAuctionContracts(address , uint256 ) view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCaller) UserAuctionContracts(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "userAuctionContracts", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UserAuctionContracts is a free data retrieval call binding the contract method 0x41f9faf8.
+//
+// Solidity: function userAuctionContracts(address , uint256 ) view returns(uint256)
+func (_AuctionFactory *AuctionFactorySession) UserAuctionContracts(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _AuctionFactory.Contract.UserAuctionContracts(&_AuctionFactory.CallOpts, arg0, arg1)
+}
+
+// UserAuctionContracts is a free data retrieval call binding the contract method 0x41f9faf8.
+//
+// Solidity: function userAuctionContracts(address , uint256 ) view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCallerSession) UserAuctionContracts(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _AuctionFactory.Contract.UserAuctionContracts(&_AuctionFactory.CallOpts, arg0, arg1)
+}
+
+// BatchCreateAuctionContracts is a paid mutator transaction binding the contract method 0x1a3fd8e1.
+//
+// Solidity: function batchCreateAuctionContracts(string[] names, string[] descriptions, address[] ethUsdPriceFeeds, address[] feeRecipients) returns(uint256[] contractIds, address[] auctionContracts)
+func (_AuctionFactory *AuctionFactoryTransactor) BatchCreateAuctionContracts(opts *bind.TransactOpts, names []string, descriptions []string, ethUsdPriceFeeds []common.Address, feeRecipients []common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "batchCreateAuctionContracts", names, descriptions, ethUsdPriceFeeds, feeRecipients)
+}
+
+// BatchCreateAuctionContracts is a paid mutator transaction binding the contract method 0x1a3fd8e1.
+//
+// Solidity: function batchCreateAuctionContracts(string[] names, string[] descriptions, address[] ethUsdPriceFeeds, address[] feeRecipients) returns(uint256[] contractIds, address[] auctionContracts)
+func (_AuctionFactory *AuctionFactorySession) BatchCreateAuctionContracts(names []string, descriptions []string, ethUsdPriceFeeds []common.Address, feeRecipients []common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.BatchCreateAuctionContracts(&_AuctionFactory.TransactOpts, names, descriptions, ethUsdPriceFeeds, feeRecipients)
+}
+
+// BatchCreateAuctionContracts is a paid mutator transaction binding the contract method 0x1a3fd8e1.
+//
+// Solidity: function batchCreateAuctionContracts(string[] names, string[] descriptions, address[] ethUsdPriceFeeds, address[] feeRecipients) returns(uint256[] contractIds, address[] auctionContracts)
+func (_AuctionFactory *AuctionFactoryTransactorSession) BatchCreateAuctionContracts(names []string, descriptions []string, ethUsdPriceFeeds []common.Address, feeRecipients []common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.BatchCreateAuctionContracts(&_AuctionFactory.TransactOpts, names, descriptions, ethUsdPriceFeeds, feeRecipients)
+}
+
+// CreateAuctionContract is a paid mutator transaction binding the contract method 0x223faf1e.
+//
+// Solidity: function createAuctionContract(string name, string description, address ethUsdPriceFeed, address feeRecipient) returns(uint256 contractId, address auctionContract)
+func (_AuctionFactory *AuctionFactoryTransactor) CreateAuctionContract(opts *bind.TransactOpts, name string, description string, ethUsdPriceFeed common.Address, feeRecipient common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "createAuctionContract", name, description, ethUsdPriceFeed, feeRecipient)
+}
+
+// CreateAuctionContract is a paid mutator transaction binding the contract method 0x223faf1e.
+//
+// Solidity: function createAuctionContract(string name, string description, address ethUsdPriceFeed, address feeRecipient) returns(uint256 contractId, address auctionContract)
+func (_AuctionFactory *AuctionFactorySession) CreateAuctionContract(name string, description string, ethUsdPriceFeed common.Address, feeRecipient common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuctionContract(&_AuctionFactory.TransactOpts, name, description, ethUsdPriceFeed, feeRecipient)
+}
+
+// CreateAuctionContract is a paid mutator transaction binding the contract method 0x223faf1e.
+//
+// Solidity: function createAuctionContract(string name, string description, address ethUsdPriceFeed, address feeRecipient) returns(uint256 contractId, address auctionContract)
+func (_AuctionFactory *AuctionFactoryTransactorSession) CreateAuctionContract(name string, description string, ethUsdPriceFeed common.Address, feeRecipient common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuctionContract(&_AuctionFactory.TransactOpts, name, description, ethUsdPriceFeed, feeRecipient)
+}
+
+// CreateAuctionContractClone is a paid mutator transaction binding the contract method 0x2bc58291.
+//
+// Solidity: function createAuctionContractClone(string name, string description, address ethUsdPriceFeed, address feeRecipient) returns(uint256 contractId, address auctionContract)
+func (_AuctionFactory *AuctionFactoryTransactor) CreateAuctionContractClone(opts *bind.TransactOpts, name string, description string, ethUsdPriceFeed common.Address, feeRecipient common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "createAuctionContractClone", name, description, ethUsdPriceFeed, feeRecipient)
+}
+
+// CreateAuctionContractClone is a paid mutator transaction binding the contract method 0x2bc58291.
+//
+// Solidity: function createAuctionContractClone(string name, string description, address ethUsdPriceFeed, address feeRecipient) returns(uint256 contractId, address auctionContract)
+func (_AuctionFactory *AuctionFactorySession) CreateAuctionContractClone(name string, description string, ethUsdPriceFeed common.Address, feeRecipient common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuctionContractClone(&_AuctionFactory.TransactOpts, name, description, ethUsdPriceFeed, feeRecipient)
+}
+
+// CreateAuctionContractClone is a paid mutator transaction binding the contract method 0x2bc58291.
+//
+// Solidity: function createAuctionContractClone(string name, string description, address ethUsdPriceFeed, address feeRecipient) returns(uint256 contractId, address auctionContract)
+func (_AuctionFactory *AuctionFactoryTransactorSession) CreateAuctionContractClone(name string, description string, ethUsdPriceFeed common.Address, feeRecipient common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuctionContractClone(&_AuctionFactory.TransactOpts, name, description, ethUsdPriceFeed, feeRecipient)
+}
+
+// DeactivateAuctionContract is a paid mutator transaction binding the contract method 0x22ffade2.
+//
+// Solidity: function deactivateAuctionContract(uint256 contractId) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) DeactivateAuctionContract(opts *bind.TransactOpts, contractId *big.Int) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "deactivateAuctionContract", contractId)
+}
+
+// DeactivateAuctionContract is a paid mutator transaction binding the contract method 0x22ffade2.
+//
+// Solidity: function deactivateAuctionContract(uint256 contractId) returns()
+func (_AuctionFactory *AuctionFactorySession) DeactivateAuctionContract(contractId *big.Int) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.DeactivateAuctionContract(&_AuctionFactory.TransactOpts, contractId)
+}
+
+// DeactivateAuctionContract is a paid mutator transaction binding the contract method 0x22ffade2.
+//
+// Solidity: function deactivateAuctionContract(uint256 contractId) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) DeactivateAuctionContract(contractId *big.Int) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.DeactivateAuctionContract(&_AuctionFactory.TransactOpts, contractId)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactoryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactorySession) RenounceOwnership() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.RenounceOwnership(&_AuctionFactory.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.RenounceOwnership(&_AuctionFactory.TransactOpts)
+}
+
+// SetAuctionTemplate is a paid mutator transaction binding the contract method 0xa889a83f.
+//
+// Solidity: function setAuctionTemplate(address _template) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) SetAuctionTemplate(opts *bind.TransactOpts, _template common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "setAuctionTemplate", _template)
+}
+
+// SetAuctionTemplate is a paid mutator transaction binding the contract method 0xa889a83f.
+//
+// Solidity: function setAuctionTemplate(address _template) returns()
+func (_AuctionFactory *AuctionFactorySession) SetAuctionTemplate(_template common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetAuctionTemplate(&_AuctionFactory.TransactOpts, _template)
+}
+
+// SetAuctionTemplate is a paid mutator transaction binding the contract method 0xa889a83f.
+//
+// Solidity: function setAuctionTemplate(address _template) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) SetAuctionTemplate(_template common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetAuctionTemplate(&_AuctionFactory.TransactOpts, _template)
+}
+
+// SetDefaultConfig is a paid mutator transaction binding the contract method 0xc3dbe6a5.
+//
+// Solidity: function setDefaultConfig(address _ethUsdPriceFeed, address _feeRecipient, uint256 _platformFeeRate) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) SetDefaultConfig(opts *bind.TransactOpts, _ethUsdPriceFeed common.Address, _feeRecipient common.Address, _platformFeeRate *big.Int) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "setDefaultConfig", _ethUsdPriceFeed, _feeRecipient, _platformFeeRate)
+}
+
+// SetDefaultConfig is a paid mutator transaction binding the contract method 0xc3dbe6a5.
+//
+// Solidity: function setDefaultConfig(address _ethUsdPriceFeed, address _feeRecipient, uint256 _platformFeeRate) returns()
+func (_AuctionFactory *AuctionFactorySession) SetDefaultConfig(_ethUsdPriceFeed common.Address, _feeRecipient common.Address, _platformFeeRate *big.Int) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetDefaultConfig(&_AuctionFactory.TransactOpts, _ethUsdPriceFeed, _feeRecipient, _platformFeeRate)
+}
+
+// SetDefaultConfig is a paid mutator transaction binding the contract method 0xc3dbe6a5.
+//
+// Solidity: function setDefaultConfig(address _ethUsdPriceFeed, address _feeRecipient, uint256 _platformFeeRate) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) SetDefaultConfig(_ethUsdPriceFeed common.Address, _feeRecipient common.Address, _platformFeeRate *big.Int) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetDefaultConfig(&_AuctionFactory.TransactOpts, _ethUsdPriceFeed, _feeRecipient, _platformFeeRate)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactorySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.TransferOwnership(&_AuctionFactory.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.TransferOwnership(&_AuctionFactory.TransactOpts, newOwner)
+}
+
+// AuctionFactoryAuctionContractCreatedIterator is returned from FilterAuctionContractCreated and is used to iterate over the raw logs and unpacked data for AuctionContractCreated events raised by the AuctionFactory contract.
+type AuctionFactoryAuctionContractCreatedIterator struct {
+	Event *AuctionFactoryAuctionContractCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryAuctionContractCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryAuctionContractCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryAuctionContractCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryAuctionContractCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryAuctionContractCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryAuctionContractCreated represents a AuctionContractCreated event raised by the AuctionFactory contract.
+type AuctionFactoryAuctionContractCreated struct {
+	ContractId      *big.Int
+	AuctionContract common.Address
+	Creator         common.Address
+	Name            string
+	Description     string
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionContractCreated is a free log retrieval operation binding the contract event 0xf74d63a24d9eecd3149494b7b369b06eeeb5d77fa5d976afb45c1ea01185d1e2.
+//
+// Solidity: event AuctionContractCreated(uint256 indexed contractId, address indexed auctionContract, address indexed creator, string name, string description)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterAuctionContractCreated(opts *bind.FilterOpts, contractId []*big.Int, auctionContract []common.Address, creator []common.Address) (*AuctionFactoryAuctionContractCreatedIterator, error) {
+
+	var contractIdRule []interface{}
+	for _, contractIdItem := range contractId {
+		contractIdRule = append(contractIdRule, contractIdItem)
+	}
+	var auctionContractRule []interface{}
+	for _, auctionContractItem := range auctionContract {
+		auctionContractRule = append(auctionContractRule, auctionContractItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "AuctionContractCreated", contractIdRule, auctionContractRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryAuctionContractCreatedIterator{contract: _AuctionFactory.contract, event: "AuctionContractCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionContractCreated is a free log subscription operation binding the contract event 0xf74d63a24d9eecd3149494b7b369b06eeeb5d77fa5d976afb45c1ea01185d1e2.
+//
+// Solidity: event AuctionContractCreated(uint256 indexed contractId, address indexed auctionContract, address indexed creator, string name, string description)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchAuctionContractCreated(opts *bind.WatchOpts, sink chan<- *AuctionFactoryAuctionContractCreated, contractId []*big.Int, auctionContract []common.Address, creator []common.Address) (event.Subscription, error) {
+
+	var contractIdRule []interface{}
+	for _, contractIdItem := range contractId {
+		contractIdRule = append(contractIdRule, contractIdItem)
+	}
+	var auctionContractRule []interface{}
+	for _, auctionContractItem := range auctionContract {
+		auctionContractRule = append(auctionContractRule, auctionContractItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "AuctionContractCreated", contractIdRule, auctionContractRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryAuctionContractCreated)
+				if err := _AuctionFactory.contract.UnpackLog(event, "AuctionContractCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionContractCreated is a log parse operation binding the contract event 0xf74d63a24d9eecd3149494b7b369b06eeeb5d77fa5d976afb45c1ea01185d1e2.
+//
+// Solidity: event AuctionContractCreated(uint256 indexed contractId, address indexed auctionContract, address indexed creator, string name, string description)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseAuctionContractCreated(log types.Log) (*AuctionFactoryAuctionContractCreated, error) {
+	event := new(AuctionFactoryAuctionContractCreated)
+	if err := _AuctionFactory.contract.UnpackLog(event, "AuctionContractCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryAuctionContractDeactivatedIterator is returned from FilterAuctionContractDeactivated and is used to iterate over the raw logs and unpacked data for AuctionContractDeactivated events raised by the AuctionFactory contract.
+type AuctionFactoryAuctionContractDeactivatedIterator struct {
+	Event *AuctionFactoryAuctionContractDeactivated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryAuctionContractDeactivatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryAuctionContractDeactivated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryAuctionContractDeactivated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryAuctionContractDeactivatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryAuctionContractDeactivatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryAuctionContractDeactivated represents a AuctionContractDeactivated event raised by the AuctionFactory contract.
+type AuctionFactoryAuctionContractDeactivated struct {
+	ContractId *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionContractDeactivated is a free log retrieval operation binding the contract event 0x420bd5bdf102e0ac055ee2e735311b2f50541ac267cf88868b805865fb6fd4f0.
+//
+// Solidity: event AuctionContractDeactivated(uint256 indexed contractId)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterAuctionContractDeactivated(opts *bind.FilterOpts, contractId []*big.Int) (*AuctionFactoryAuctionContractDeactivatedIterator, error) {
+
+	var contractIdRule []interface{}
+	for _, contractIdItem := range contractId {
+		contractIdRule = append(contractIdRule, contractIdItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "AuctionContractDeactivated", contractIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryAuctionContractDeactivatedIterator{contract: _AuctionFactory.contract, event: "AuctionContractDeactivated", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionContractDeactivated is a free log subscription operation binding the contract event 0x420bd5bdf102e0ac055ee2e735311b2f50541ac267cf88868b805865fb6fd4f0.
+//
+// Solidity: event AuctionContractDeactivated(uint256 indexed contractId)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchAuctionContractDeactivated(opts *bind.WatchOpts, sink chan<- *AuctionFactoryAuctionContractDeactivated, contractId []*big.Int) (event.Subscription, error) {
+
+	var contractIdRule []interface{}
+	for _, contractIdItem := range contractId {
+		contractIdRule = append(contractIdRule, contractIdItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "AuctionContractDeactivated", contractIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryAuctionContractDeactivated)
+				if err := _AuctionFactory.contract.UnpackLog(event, "AuctionContractDeactivated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionContractDeactivated is a log parse operation binding the contract event 0x420bd5bdf102e0ac055ee2e735311b2f50541ac267cf88868b805865fb6fd4f0.
+//
+// Solidity: event AuctionContractDeactivated(uint256 indexed contractId)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseAuctionContractDeactivated(log types.Log) (*AuctionFactoryAuctionContractDeactivated, error) {
+	event := new(AuctionFactoryAuctionContractDeactivated)
+	if err := _AuctionFactory.contract.UnpackLog(event, "AuctionContractDeactivated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryDefaultConfigUpdatedIterator is returned from FilterDefaultConfigUpdated and is used to iterate over the raw logs and unpacked data for DefaultConfigUpdated events raised by the AuctionFactory contract.
+type AuctionFactoryDefaultConfigUpdatedIterator struct {
+	Event *AuctionFactoryDefaultConfigUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryDefaultConfigUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryDefaultConfigUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryDefaultConfigUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryDefaultConfigUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryDefaultConfigUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryDefaultConfigUpdated represents a DefaultConfigUpdated event raised by the AuctionFactory contract.
+type AuctionFactoryDefaultConfigUpdated struct {
+	EthUsdPriceFeed common.Address
+	FeeRecipient    common.Address
+	PlatformFeeRate *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterDefaultConfigUpdated is a free log retrieval operation binding the contract event 0x7a3f3d06c2468a84ea9fbf86a4cf49ccec937bc307fb187e82fe1086cfaf9f26.
+//
+// Solidity: event DefaultConfigUpdated(address ethUsdPriceFeed, address feeRecipient, uint256 platformFeeRate)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterDefaultConfigUpdated(opts *bind.FilterOpts) (*AuctionFactoryDefaultConfigUpdatedIterator, error) {
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "DefaultConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryDefaultConfigUpdatedIterator{contract: _AuctionFactory.contract, event: "DefaultConfigUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchDefaultConfigUpdated is a free log subscription operation binding the contract event 0x7a3f3d06c2468a84ea9fbf86a4cf49ccec937bc307fb187e82fe1086cfaf9f26.
+//
+// Solidity: event DefaultConfigUpdated(address ethUsdPriceFeed, address feeRecipient, uint256 platformFeeRate)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchDefaultConfigUpdated(opts *bind.WatchOpts, sink chan<- *AuctionFactoryDefaultConfigUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "DefaultConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryDefaultConfigUpdated)
+				if err := _AuctionFactory.contract.UnpackLog(event, "DefaultConfigUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDefaultConfigUpdated is a log parse operation binding the contract event 0x7a3f3d06c2468a84ea9fbf86a4cf49ccec937bc307fb187e82fe1086cfaf9f26.
+//
+// Solidity: event DefaultConfigUpdated(address ethUsdPriceFeed, address feeRecipient, uint256 platformFeeRate)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseDefaultConfigUpdated(log types.Log) (*AuctionFactoryDefaultConfigUpdated, error) {
+	event := new(AuctionFactoryDefaultConfigUpdated)
+	if err := _AuctionFactory.contract.UnpackLog(event, "DefaultConfigUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the AuctionFactory contract.
+type AuctionFactoryOwnershipTransferredIterator struct {
+	Event *AuctionFactoryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryOwnershipTransferred represents a OwnershipTransferred event raised by the AuctionFactory contract.
+type AuctionFactoryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AuctionFactoryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryOwnershipTransferredIterator{contract: _AuctionFactory.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AuctionFactoryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryOwnershipTransferred)
+				if err := _AuctionFactory.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseOwnershipTransferred(log types.Log) (*AuctionFactoryOwnershipTransferred, error) {
+	event := new(AuctionFactoryOwnershipTransferred)
+	if err := _AuctionFactory.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryTemplateUpdatedIterator is returned from FilterTemplateUpdated and is used to iterate over the raw logs and unpacked data for TemplateUpdated events raised by the AuctionFactory contract.
+type AuctionFactoryTemplateUpdatedIterator struct {
+	Event *AuctionFactoryTemplateUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryTemplateUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryTemplateUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryTemplateUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryTemplateUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryTemplateUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryTemplateUpdated represents a TemplateUpdated event raised by the AuctionFactory contract.
+type AuctionFactoryTemplateUpdated struct {
+	NewTemplate common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTemplateUpdated is a free log retrieval operation binding the contract event 0x1362ac8b2a461ab79cb1efaa7f1a2a3877e769debabeb3620559a652de93f700.
+//
+// Solidity: event TemplateUpdated(address indexed newTemplate)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterTemplateUpdated(opts *bind.FilterOpts, newTemplate []common.Address) (*AuctionFactoryTemplateUpdatedIterator, error) {
+
+	var newTemplateRule []interface{}
+	for _, newTemplateItem := range newTemplate {
+		newTemplateRule = append(newTemplateRule, newTemplateItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "TemplateUpdated", newTemplateRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryTemplateUpdatedIterator{contract: _AuctionFactory.contract, event: "TemplateUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTemplateUpdated is a free log subscription operation binding the contract event 0x1362ac8b2a461ab79cb1efaa7f1a2a3877e769debabeb3620559a652de93f700.
+//
+// Solidity: event TemplateUpdated(address indexed newTemplate)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchTemplateUpdated(opts *bind.WatchOpts, sink chan<- *AuctionFactoryTemplateUpdated, newTemplate []common.Address) (event.Subscription, error) {
+
+	var newTemplateRule []interface{}
+	for _, newTemplateItem := range newTemplate {
+		newTemplateRule = append(newTemplateRule, newTemplateItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "TemplateUpdated", newTemplateRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryTemplateUpdated)
+				if err := _AuctionFactory.contract.UnpackLog(event, "TemplateUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTemplateUpdated is a log parse operation binding the contract event 0x1362ac8b2a461ab79cb1efaa7f1a2a3877e769debabeb3620559a652de93f700.
+//
+// Solidity: event TemplateUpdated(address indexed newTemplate)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseTemplateUpdated(log types.Log) (*AuctionFactoryTemplateUpdated, error) {
+	event := new(AuctionFactoryTemplateUpdated)
+	if err := _AuctionFactory.contract.UnpackLog(event, "TemplateUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1832 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package auction
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// NFTAuctionAuction is an auto generated low-level Go binding around an user-defined struct.
+type NFTAuctionAuction struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartPrice    *big.Int
+	ReservePrice  *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	HighestBidder common.Address
+	HighestBidUSD *big.Int
+	BidToken      common.Address
+	BidAmount     *big.Int
+	Status        uint8
+	BidIncrement  *big.Int
+}
+
+// NFTAuctionMetaData contains all meta data concerning the NFTAuction contract.
+var NFTAuctionMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_feeRecipient\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AuctionAlreadyEnded\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"AuctionNotActive\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"AuctionNotFound\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"BidTooLow\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidPrice\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidTimeRange\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotOwner\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotSeller\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PriceFeedNotSet\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TransferFailed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"AuctionCancelled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"seller\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"startPrice\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"reservePrice\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"AuctionCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"winner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"winningBidUSD\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"bidToken\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"bidAmount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"AuctionEnded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"bidAmountUSD\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"bidToken\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"bidAmount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"BidPlaced\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"auctionCounter\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"auctions\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"seller\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"reservePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"highestBidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"highestBidUSD\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"bidToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"bidAmount\",\"type\":\"uint256\"},{\"internalType\":\"enumNFTAuction.AuctionStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrement\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"bidWithERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"}],\"name\":\"bidWithETH\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"}],\"name\":\"cancelAuction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startPriceUSD\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"reservePriceUSD\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrementUSD\",\"type\":\"uint256\"}],\"name\":\"createAuction\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"emergencyWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"}],\"name\":\"endAuction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ethUsdPriceFeed\",\"outputs\":[{\"internalType\":\"contractAggregatorV3Interface\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeRecipient\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getActiveAuctions\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"}],\"name\":\"getAuction\",\"outputs\":[{\"internalType\":\"structNFTAuction.Auction\",\"name\":\"\",\"type\":\"tuple\",\"components\":[{\"internalType\":\"address\",\"name\":\"seller\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"reservePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"highestBidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"highestBidUSD\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"bidToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"bidAmount\",\"type\":\"uint256\"},{\"internalType\":\"enumNFTAuction.AuctionStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrement\",\"type\":\"uint256\"}]}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserAuctions\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getUserBids\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_feeRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"platformFeeRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"priceFeeds\",\"outputs\":[{\"internalType\":\"contractAggregatorV3Interface\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_feeRecipient\",\"type\":\"address\"}],\"name\":\"setFeeRecipient\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_feeRate\",\"type\":\"uint256\"}],\"name\":\"setPlatformFeeRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"priceFeed\",\"type\":\"address\"}],\"name\":\"setPriceFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"userAuctions\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"userBids\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// NFTAuctionABI is the input ABI used to generate the binding from.
+// Deprecated: Use NFTAuctionMetaData.ABI instead.
+var NFTAuctionABI = NFTAuctionMetaData.ABI
+
+// NFTAuction is an auto generated Go binding around an Ethereum contract.
+type NFTAuction struct {
+	NFTAuctionCaller     // Read-only binding to the contract
+	NFTAuctionTransactor // Write-only binding to the contract
+	NFTAuctionFilterer   // Log filterer for contract events
+}
+
+// NFTAuctionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NFTAuctionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NFTAuctionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NFTAuctionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NFTAuctionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NFTAuctionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NFTAuctionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NFTAuctionSession struct {
+	Contract     *NFTAuction       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NFTAuctionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NFTAuctionCallerSession struct {
+	Contract *NFTAuctionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// NFTAuctionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NFTAuctionTransactorSession struct {
+	Contract     *NFTAuctionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// NFTAuctionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NFTAuctionRaw struct {
+	Contract *NFTAuction // Generic contract binding to access the raw methods on
+}
+
+// NFTAuctionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NFTAuctionCallerRaw struct {
+	Contract *NFTAuctionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NFTAuctionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NFTAuctionTransactorRaw struct {
+	Contract *NFTAuctionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNFTAuction creates a new instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuction(address common.Address, backend bind.ContractBackend) (*NFTAuction, error) {
+	contract, err := bindNFTAuction(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuction{NFTAuctionCaller: NFTAuctionCaller{contract: contract}, NFTAuctionTransactor: NFTAuctionTransactor{contract: contract}, NFTAuctionFilterer: NFTAuctionFilterer{contract: contract}}, nil
+}
+
+// NewNFTAuctionCaller creates a new read-only instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuctionCaller(address common.Address, caller bind.ContractCaller) (*NFTAuctionCaller, error) {
+	contract, err := bindNFTAuction(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionCaller{contract: contract}, nil
+}
+
+// NewNFTAuctionTransactor creates a new write-only instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuctionTransactor(address common.Address, transactor bind.ContractTransactor) (*NFTAuctionTransactor, error) {
+	contract, err := bindNFTAuction(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionTransactor{contract: contract}, nil
+}
+
+// NewNFTAuctionFilterer creates a new log filterer instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuctionFilterer(address common.Address, filterer bind.ContractFilterer) (*NFTAuctionFilterer, error) {
+	contract, err := bindNFTAuction(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionFilterer{contract: contract}, nil
+}
+
+// bindNFTAuction binds a generic wrapper to an already deployed contract.
+func bindNFTAuction(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := NFTAuctionMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NFTAuction *NFTAuctionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NFTAuction.Contract.NFTAuctionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NFTAuction *NFTAuctionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NFTAuction.Contract.NFTAuctionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NFTAuction *NFTAuctionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NFTAuction.Contract.NFTAuctionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NFTAuction *NFTAuctionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NFTAuction.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NFTAuction *NFTAuctionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NFTAuction.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NFTAuction *NFTAuctionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NFTAuction.Contract.contract.Transact(opts, method, params...)
+}
+
+// AuctionCounter is a free data retrieval call binding the contract method 0xa7e76644.
+//
+// Solidity: function auctionCounter() view returns(uint256)
+func (_NFTAuction *NFTAuctionCaller) AuctionCounter(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "auctionCounter")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AuctionCounter is a free data retrieval call binding the contract method 0xa7e76644.
+//
+// Solidity: function auctionCounter() view returns(uint256)
+func (_NFTAuction *NFTAuctionSession) AuctionCounter() (*big.Int, error) {
+	return _NFTAuction.Contract.AuctionCounter(&_NFTAuction.CallOpts)
+}
+
+// AuctionCounter is a free data retrieval call binding the contract method 0xa7e76644.
+//
+// Solidity: function auctionCounter() view returns(uint256)
+func (_NFTAuction *NFTAuctionCallerSession) AuctionCounter() (*big.Int, error) {
+	return _NFTAuction.Contract.AuctionCounter(&_NFTAuction.CallOpts)
+}
+
+// Auctions is a free data retrieval call binding the contract method 0x571a26a0.
+//
+// Solidity: function auctions(uint256 ) view returns(address seller, address nftContract, uint256 tokenId, uint256 startPrice, uint256 reservePrice, uint256 startTime, uint256 endTime, address highestBidder, uint256 highestBidUSD, address bidToken, uint256 bidAmount, uint8 status, uint256 bidIncrement)
+func (_NFTAuction *NFTAuctionCaller) Auctions(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartPrice    *big.Int
+	ReservePrice  *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	HighestBidder common.Address
+	HighestBidUSD *big.Int
+	BidToken      common.Address
+	BidAmount     *big.Int
+	Status        uint8
+	BidIncrement  *big.Int
+}, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "auctions", arg0)
+
+	outstruct := new(struct {
+		Seller        common.Address
+		NftContract   common.Address
+		TokenId       *big.Int
+		StartPrice    *big.Int
+		ReservePrice  *big.Int
+		StartTime     *big.Int
+		EndTime       *big.Int
+		HighestBidder common.Address
+		HighestBidUSD *big.Int
+		BidToken      common.Address
+		BidAmount     *big.Int
+		Status        uint8
+		BidIncrement  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Seller = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.NftContract = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.TokenId = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.StartPrice = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.ReservePrice = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.StartTime = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.EndTime = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.HighestBidder = *abi.ConvertType(out[7], new(common.Address)).(*common.Address)
+	outstruct.HighestBidUSD = *abi.ConvertType(out[8], new(*big.Int)).(**big.Int)
+	outstruct.BidToken = *abi.ConvertType(out[9], new(common.Address)).(*common.Address)
+	outstruct.BidAmount = *abi.ConvertType(out[10], new(*big.Int)).(**big.Int)
+	outstruct.Status = *abi.ConvertType(out[11], new(uint8)).(*uint8)
+	outstruct.BidIncrement = *abi.ConvertType(out[12], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Auctions is a free data retrieval call binding the contract method 0x571a26a0.
+//
+// Solidity: function auctions(uint256 ) view returns(address seller, address nftContract, uint256 tokenId, uint256 startPrice, uint256 reservePrice, uint256 startTime, uint256 endTime, address highestBidder, uint256 highestBidUSD, address bidToken, uint256 bidAmount, uint8 status, uint256 bidIncrement)
+func (_NFTAuction *NFTAuctionSession) Auctions(arg0 *big.Int) (struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartPrice    *big.Int
+	ReservePrice  *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	HighestBidder common.Address
+	HighestBidUSD *big.Int
+	BidToken      common.Address
+	BidAmount     *big.Int
+	Status        uint8
+	BidIncrement  *big.Int
+}, error) {
+	return _NFTAuction.Contract.Auctions(&_NFTAuction.CallOpts, arg0)
+}
+
+// Auctions is a free data retrieval call binding the contract method 0x571a26a0.
+//
+// Solidity: function auctions(uint256 ) view returns(address seller, address nftContract, uint256 tokenId, uint256 startPrice, uint256 reservePrice, uint256 startTime, uint256 endTime, address highestBidder, uint256 highestBidUSD, address bidToken, uint256 bidAmount, uint8 status, uint256 bidIncrement)
+func (_NFTAuction *NFTAuctionCallerSession) Auctions(arg0 *big.Int) (struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartPrice    *big.Int
+	ReservePrice  *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	HighestBidder common.Address
+	HighestBidUSD *big.Int
+	BidToken      common.Address
+	BidAmount     *big.Int
+	Status        uint8
+	BidIncrement  *big.Int
+}, error) {
+	return _NFTAuction.Contract.Auctions(&_NFTAuction.CallOpts, arg0)
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_NFTAuction *NFTAuctionCaller) EthUsdPriceFeed(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "ethUsdPriceFeed")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_NFTAuction *NFTAuctionSession) EthUsdPriceFeed() (common.Address, error) {
+	return _NFTAuction.Contract.EthUsdPriceFeed(&_NFTAuction.CallOpts)
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_NFTAuction *NFTAuctionCallerSession) EthUsdPriceFeed() (common.Address, error) {
+	return _NFTAuction.Contract.EthUsdPriceFeed(&_NFTAuction.CallOpts)
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_NFTAuction *NFTAuctionCaller) FeeRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "feeRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_NFTAuction *NFTAuctionSession) FeeRecipient() (common.Address, error) {
+	return _NFTAuction.Contract.FeeRecipient(&_NFTAuction.CallOpts)
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_NFTAuction *NFTAuctionCallerSession) FeeRecipient() (common.Address, error) {
+	return _NFTAuction.Contract.FeeRecipient(&_NFTAuction.CallOpts)
+}
+
+// GetActiveAuctions is a free data retrieval call binding the contract method 0xcf44b5d5.
+//
+// Solidity: function getActiveAuctions() view returns(uint256[])
+func (_NFTAuction *NFTAuctionCaller) GetActiveAuctions(opts *bind.CallOpts) ([]*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "getActiveAuctions")
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetActiveAuctions is a free data retrieval call binding the contract method 0xcf44b5d5.
+//
+// Solidity: function getActiveAuctions() view returns(uint256[])
+func (_NFTAuction *NFTAuctionSession) GetActiveAuctions() ([]*big.Int, error) {
+	return _NFTAuction.Contract.GetActiveAuctions(&_NFTAuction.CallOpts)
+}
+
+// GetActiveAuctions is a free data retrieval call binding the contract method 0xcf44b5d5.
+//
+// Solidity: function getActiveAuctions() view returns(uint256[])
+func (_NFTAuction *NFTAuctionCallerSession) GetActiveAuctions() ([]*big.Int, error) {
+	return _NFTAuction.Contract.GetActiveAuctions(&_NFTAuction.CallOpts)
+}
+
+// GetAuction is a free data retrieval call binding the contract method 0x78bd7935.
+//
+// Solidity: function getAuction(uint256 auctionId) view returns((address,address,uint256,uint256,uint256,uint256,uint256,address,uint256,address,uint256,uint8,uint256))
+func (_NFTAuction *NFTAuctionCaller) GetAuction(opts *bind.CallOpts, auctionId *big.Int) (NFTAuctionAuction, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "getAuction", auctionId)
+
+	if err != nil {
+		return *new(NFTAuctionAuction), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(NFTAuctionAuction)).(*NFTAuctionAuction)
+
+	return out0, err
+
+}
+
+// GetAuction is a free data retrieval call binding the contract method 0x78bd7935.
+//
+// Solidity: function getAuction(uint256 auctionId) view returns((address,address,uint256,uint256,uint256,uint256,uint256,address,uint256,address,uint256,uint8,uint256))
+func (_NFTAuction *NFTAuctionSession) GetAuction(auctionId *big.Int) (NFTAuctionAuction, error) {
+	return _NFTAuction.Contract.GetAuction(&_NFTAuction.CallOpts, auctionId)
+}
+
+// GetAuction is a free data retrieval call binding the contract method 0x78bd7935.
+//
+// Solidity: function getAuction(uint256 auctionId) view returns((address,address,uint256,uint256,uint256,uint256,uint256,address,uint256,address,uint256,uint8,uint256))
+func (_NFTAuction *NFTAuctionCallerSession) GetAuction(auctionId *big.Int) (NFTAuctionAuction, error) {
+	return _NFTAuction.Contract.GetAuction(&_NFTAuction.CallOpts, auctionId)
+}
+
+// GetUserAuctions is a free data retrieval call binding the contract method 0xff3ad0b4.
+//
+// Solidity: function getUserAuctions(address user) view returns(uint256[])
+func (_NFTAuction *NFTAuctionCaller) GetUserAuctions(opts *bind.CallOpts, user common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "getUserAuctions", user)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetUserAuctions is a free data retrieval call binding the contract method 0xff3ad0b4.
+//
+// Solidity: function getUserAuctions(address user) view returns(uint256[])
+func (_NFTAuction *NFTAuctionSession) GetUserAuctions(user common.Address) ([]*big.Int, error) {
+	return _NFTAuction.Contract.GetUserAuctions(&_NFTAuction.CallOpts, user)
+}
+
+// GetUserAuctions is a free data retrieval call binding the contract method 0xff3ad0b4.
+//
+// Solidity: function getUserAuctions(address user) view returns(uint256[])
+func (_NFTAuction *NFTAuctionCallerSession) GetUserAuctions(user common.Address) ([]*big.Int, error) {
+	return _NFTAuction.Contract.GetUserAuctions(&_NFTAuction.CallOpts, user)
+}
+
+// GetUserBids is a free data retrieval call binding the contract method 0x6759789f.
+//
+// Solidity: function getUserBids(address user) view returns(uint256[])
+func (_NFTAuction *NFTAuctionCaller) GetUserBids(opts *bind.CallOpts, user common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "getUserBids", user)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetUserBids is a free data retrieval call binding the contract method 0x6759789f.
+//
+// Solidity: function getUserBids(address user) view returns(uint256[])
+func (_NFTAuction *NFTAuctionSession) GetUserBids(user common.Address) ([]*big.Int, error) {
+	return _NFTAuction.Contract.GetUserBids(&_NFTAuction.CallOpts, user)
+}
+
+// GetUserBids is a free data retrieval call binding the contract method 0x6759789f.
+//
+// Solidity: function getUserBids(address user) view returns(uint256[])
+func (_NFTAuction *NFTAuctionCallerSession) GetUserBids(user common.Address) ([]*big.Int, error) {
+	return _NFTAuction.Contract.GetUserBids(&_NFTAuction.CallOpts, user)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_NFTAuction *NFTAuctionCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_NFTAuction *NFTAuctionSession) Owner() (common.Address, error) {
+	return _NFTAuction.Contract.Owner(&_NFTAuction.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_NFTAuction *NFTAuctionCallerSession) Owner() (common.Address, error) {
+	return _NFTAuction.Contract.Owner(&_NFTAuction.CallOpts)
+}
+
+// PlatformFeeRate is a free data retrieval call binding the contract method 0xeeca08f0.
+//
+// Solidity: function platformFeeRate() view returns(uint256)
+func (_NFTAuction *NFTAuctionCaller) PlatformFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "platformFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PlatformFeeRate is a free data retrieval call binding the contract method 0xeeca08f0.
+//
+// Solidity: function platformFeeRate() view returns(uint256)
+func (_NFTAuction *NFTAuctionSession) PlatformFeeRate() (*big.Int, error) {
+	return _NFTAuction.Contract.PlatformFeeRate(&_NFTAuction.CallOpts)
+}
+
+// PlatformFeeRate is a free data retrieval call binding the contract method 0xeeca08f0.
+//
+// Solidity: function platformFeeRate() view returns(uint256)
+func (_NFTAuction *NFTAuctionCallerSession) PlatformFeeRate() (*big.Int, error) {
+	return _NFTAuction.Contract.PlatformFeeRate(&_NFTAuction.CallOpts)
+}
+
+// PriceFeeds is a free data retrieval call binding the contract method 0x9dcb511a.
+//
+// Solidity: function priceFeeds(address ) view returns(address)
+func (_NFTAuction *NFTAuctionCaller) PriceFeeds(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "priceFeeds", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PriceFeeds is a free data retrieval call binding the contract method 0x9dcb511a.
+//
+// Solidity: function priceFeeds(address ) view returns(address)
+func (_NFTAuction *NFTAuctionSession) PriceFeeds(arg0 common.Address) (common.Address, error) {
+	return _NFTAuction.Contract.PriceFeeds(&_NFTAuction.CallOpts, arg0)
+}
+
+// PriceFeeds is a free data retrieval call binding the contract method 0x9dcb511a.
+//
+// Solidity: function priceFeeds(address ) view returns(address)
+func (_NFTAuction *NFTAuctionCallerSession) PriceFeeds(arg0 common.Address) (common.Address, error) {
+	return _NFTAuction.Contract.PriceFeeds(&_NFTAuction.CallOpts, arg0)
+}
+
+// UserAuctions is a free data retrieval call binding the contract method 0xb4fbe80a.
+//
+// Solidity: function userAuctions(address , uint256 ) view returns(uint256)
+func (_NFTAuction *NFTAuctionCaller) UserAuctions(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "userAuctions", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UserAuctions is a free data retrieval call binding the contract method 0xb4fbe80a.
+//
+// Solidity: function userAuctions(address , uint256 ) view returns(uint256)
+func (_NFTAuction *NFTAuctionSession) UserAuctions(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _NFTAuction.Contract.UserAuctions(&_NFTAuction.CallOpts, arg0, arg1)
+}
+
+// UserAuctions is a free data retrieval call binding the contract method 0xb4fbe80a.
+//
+// Solidity: function userAuctions(address , uint256 ) view returns(uint256)
+func (_NFTAuction *NFTAuctionCallerSession) UserAuctions(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _NFTAuction.Contract.UserAuctions(&_NFTAuction.CallOpts, arg0, arg1)
+}
+
+// UserBids is a free data retrieval call binding the contract method 0x96ec50c3.
+//
+// Solidity: function userBids(address , uint256 ) view returns(uint256)
+func (_NFTAuction *NFTAuctionCaller) UserBids(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "userBids", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UserBids is a free data retrieval call binding the contract method 0x96ec50c3.
+//
+// Solidity: function userBids(address , uint256 ) view returns(uint256)
+func (_NFTAuction *NFTAuctionSession) UserBids(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _NFTAuction.Contract.UserBids(&_NFTAuction.CallOpts, arg0, arg1)
+}
+
+// UserBids is a free data retrieval call binding the contract method 0x96ec50c3.
+//
+// Solidity: function userBids(address , uint256 ) view returns(uint256)
+func (_NFTAuction *NFTAuctionCallerSession) UserBids(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _NFTAuction.Contract.UserBids(&_NFTAuction.CallOpts, arg0, arg1)
+}
+
+// BidWithERC20 is a paid mutator transaction binding the contract method 0x29b0af0a.
+//
+// Solidity: function bidWithERC20(uint256 auctionId, address token, uint256 amount) returns()
+func (_NFTAuction *NFTAuctionTransactor) BidWithERC20(opts *bind.TransactOpts, auctionId *big.Int, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "bidWithERC20", auctionId, token, amount)
+}
+
+// BidWithERC20 is a paid mutator transaction binding the contract method 0x29b0af0a.
+//
+// Solidity: function bidWithERC20(uint256 auctionId, address token, uint256 amount) returns()
+func (_NFTAuction *NFTAuctionSession) BidWithERC20(auctionId *big.Int, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.BidWithERC20(&_NFTAuction.TransactOpts, auctionId, token, amount)
+}
+
+// BidWithERC20 is a paid mutator transaction binding the contract method 0x29b0af0a.
+//
+// Solidity: function bidWithERC20(uint256 auctionId, address token, uint256 amount) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) BidWithERC20(auctionId *big.Int, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.BidWithERC20(&_NFTAuction.TransactOpts, auctionId, token, amount)
+}
+
+// BidWithETH is a paid mutator transaction binding the contract method 0xac9ad632.
+//
+// Solidity: function bidWithETH(uint256 auctionId) payable returns()
+func (_NFTAuction *NFTAuctionTransactor) BidWithETH(opts *bind.TransactOpts, auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "bidWithETH", auctionId)
+}
+
+// BidWithETH is a paid mutator transaction binding the contract method 0xac9ad632.
+//
+// Solidity: function bidWithETH(uint256 auctionId) payable returns()
+func (_NFTAuction *NFTAuctionSession) BidWithETH(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.BidWithETH(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// BidWithETH is a paid mutator transaction binding the contract method 0xac9ad632.
+//
+// Solidity: function bidWithETH(uint256 auctionId) payable returns()
+func (_NFTAuction *NFTAuctionTransactorSession) BidWithETH(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.BidWithETH(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// CancelAuction is a paid mutator transaction binding the contract method 0x96b5a755.
+//
+// Solidity: function cancelAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionTransactor) CancelAuction(opts *bind.TransactOpts, auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "cancelAuction", auctionId)
+}
+
+// CancelAuction is a paid mutator transaction binding the contract method 0x96b5a755.
+//
+// Solidity: function cancelAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionSession) CancelAuction(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.CancelAuction(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// CancelAuction is a paid mutator transaction binding the contract method 0x96b5a755.
+//
+// Solidity: function cancelAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) CancelAuction(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.CancelAuction(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0x791bb4ef.
+//
+// Solidity: function createAuction(address nftContract, uint256 tokenId, uint256 startPriceUSD, uint256 reservePriceUSD, uint256 duration, uint256 bidIncrementUSD) returns(uint256)
+func (_NFTAuction *NFTAuctionTransactor) CreateAuction(opts *bind.TransactOpts, nftContract common.Address, tokenId *big.Int, startPriceUSD *big.Int, reservePriceUSD *big.Int, duration *big.Int, bidIncrementUSD *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "createAuction", nftContract, tokenId, startPriceUSD, reservePriceUSD, duration, bidIncrementUSD)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0x791bb4ef.
+//
+// Solidity: function createAuction(address nftContract, uint256 tokenId, uint256 startPriceUSD, uint256 reservePriceUSD, uint256 duration, uint256 bidIncrementUSD) returns(uint256)
+func (_NFTAuction *NFTAuctionSession) CreateAuction(nftContract common.Address, tokenId *big.Int, startPriceUSD *big.Int, reservePriceUSD *big.Int, duration *big.Int, bidIncrementUSD *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.CreateAuction(&_NFTAuction.TransactOpts, nftContract, tokenId, startPriceUSD, reservePriceUSD, duration, bidIncrementUSD)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0x791bb4ef.
+//
+// Solidity: function createAuction(address nftContract, uint256 tokenId, uint256 startPriceUSD, uint256 reservePriceUSD, uint256 duration, uint256 bidIncrementUSD) returns(uint256)
+func (_NFTAuction *NFTAuctionTransactorSession) CreateAuction(nftContract common.Address, tokenId *big.Int, startPriceUSD *big.Int, reservePriceUSD *big.Int, duration *big.Int, bidIncrementUSD *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.CreateAuction(&_NFTAuction.TransactOpts, nftContract, tokenId, startPriceUSD, reservePriceUSD, duration, bidIncrementUSD)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address token, uint256 amount) returns()
+func (_NFTAuction *NFTAuctionTransactor) EmergencyWithdraw(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "emergencyWithdraw", token, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address token, uint256 amount) returns()
+func (_NFTAuction *NFTAuctionSession) EmergencyWithdraw(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.EmergencyWithdraw(&_NFTAuction.TransactOpts, token, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address token, uint256 amount) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) EmergencyWithdraw(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.EmergencyWithdraw(&_NFTAuction.TransactOpts, token, amount)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xb9a2de3a.
+//
+// Solidity: function endAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionTransactor) EndAuction(opts *bind.TransactOpts, auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "endAuction", auctionId)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xb9a2de3a.
+//
+// Solidity: function endAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionSession) EndAuction(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.EndAuction(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xb9a2de3a.
+//
+// Solidity: function endAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) EndAuction(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.EndAuction(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address _ethUsdPriceFeed, address _feeRecipient, address _owner) returns()
+func (_NFTAuction *NFTAuctionTransactor) Initialize(opts *bind.TransactOpts, _ethUsdPriceFeed common.Address, _feeRecipient common.Address, _owner common.Address) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "initialize", _ethUsdPriceFeed, _feeRecipient, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address _ethUsdPriceFeed, address _feeRecipient, address _owner) returns()
+func (_NFTAuction *NFTAuctionSession) Initialize(_ethUsdPriceFeed common.Address, _feeRecipient common.Address, _owner common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.Initialize(&_NFTAuction.TransactOpts, _ethUsdPriceFeed, _feeRecipient, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address _ethUsdPriceFeed, address _feeRecipient, address _owner) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) Initialize(_ethUsdPriceFeed common.Address, _feeRecipient common.Address, _owner common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.Initialize(&_NFTAuction.TransactOpts, _ethUsdPriceFeed, _feeRecipient, _owner)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_NFTAuction *NFTAuctionTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_NFTAuction *NFTAuctionSession) RenounceOwnership() (*types.Transaction, error) {
+	return _NFTAuction.Contract.RenounceOwnership(&_NFTAuction.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_NFTAuction *NFTAuctionTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _NFTAuction.Contract.RenounceOwnership(&_NFTAuction.TransactOpts)
+}
+
+// SetFeeRecipient is a paid mutator transaction binding the contract method 0xe74b981b.
+//
+// Solidity: function setFeeRecipient(address _feeRecipient) returns()
+func (_NFTAuction *NFTAuctionTransactor) SetFeeRecipient(opts *bind.TransactOpts, _feeRecipient common.Address) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "setFeeRecipient", _feeRecipient)
+}
+
+// SetFeeRecipient is a paid mutator transaction binding the contract method 0xe74b981b.
+//
+// Solidity: function setFeeRecipient(address _feeRecipient) returns()
+func (_NFTAuction *NFTAuctionSession) SetFeeRecipient(_feeRecipient common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetFeeRecipient(&_NFTAuction.TransactOpts, _feeRecipient)
+}
+
+// SetFeeRecipient is a paid mutator transaction binding the contract method 0xe74b981b.
+//
+// Solidity: function setFeeRecipient(address _feeRecipient) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) SetFeeRecipient(_feeRecipient common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetFeeRecipient(&_NFTAuction.TransactOpts, _feeRecipient)
+}
+
+// SetPlatformFeeRate is a paid mutator transaction binding the contract method 0x927fef2e.
+//
+// Solidity: function setPlatformFeeRate(uint256 _feeRate) returns()
+func (_NFTAuction *NFTAuctionTransactor) SetPlatformFeeRate(opts *bind.TransactOpts, _feeRate *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "setPlatformFeeRate", _feeRate)
+}
+
+// SetPlatformFeeRate is a paid mutator transaction binding the contract method 0x927fef2e.
+//
+// Solidity: function setPlatformFeeRate(uint256 _feeRate) returns()
+func (_NFTAuction *NFTAuctionSession) SetPlatformFeeRate(_feeRate *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetPlatformFeeRate(&_NFTAuction.TransactOpts, _feeRate)
+}
+
+// SetPlatformFeeRate is a paid mutator transaction binding the contract method 0x927fef2e.
+//
+// Solidity: function setPlatformFeeRate(uint256 _feeRate) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) SetPlatformFeeRate(_feeRate *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetPlatformFeeRate(&_NFTAuction.TransactOpts, _feeRate)
+}
+
+// SetPriceFeed is a paid mutator transaction binding the contract method 0x76e11286.
+//
+// Solidity: function setPriceFeed(address token, address priceFeed) returns()
+func (_NFTAuction *NFTAuctionTransactor) SetPriceFeed(opts *bind.TransactOpts, token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "setPriceFeed", token, priceFeed)
+}
+
+// SetPriceFeed is a paid mutator transaction binding the contract method 0x76e11286.
+//
+// Solidity: function setPriceFeed(address token, address priceFeed) returns()
+func (_NFTAuction *NFTAuctionSession) SetPriceFeed(token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetPriceFeed(&_NFTAuction.TransactOpts, token, priceFeed)
+}
+
+// SetPriceFeed is a paid mutator transaction binding the contract method 0x76e11286.
+//
+// Solidity: function setPriceFeed(address token, address priceFeed) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) SetPriceFeed(token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetPriceFeed(&_NFTAuction.TransactOpts, token, priceFeed)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_NFTAuction *NFTAuctionTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_NFTAuction *NFTAuctionSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.TransferOwnership(&_NFTAuction.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.TransferOwnership(&_NFTAuction.TransactOpts, newOwner)
+}
+
+// NFTAuctionAuctionCancelledIterator is returned from FilterAuctionCancelled and is used to iterate over the raw logs and unpacked data for AuctionCancelled events raised by the NFTAuction contract.
+type NFTAuctionAuctionCancelledIterator struct {
+	Event *NFTAuctionAuctionCancelled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionAuctionCancelledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionAuctionCancelled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionAuctionCancelled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionAuctionCancelledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionAuctionCancelledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionAuctionCancelled represents a AuctionCancelled event raised by the NFTAuction contract.
+type NFTAuctionAuctionCancelled struct {
+	AuctionId *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionCancelled is a free log retrieval operation binding the contract event 0x2809c7e17bf978fbc7194c0a694b638c4215e9140cacc6c38ca36010b45697df.
+//
+// Solidity: event AuctionCancelled(uint256 indexed auctionId)
+func (_NFTAuction *NFTAuctionFilterer) FilterAuctionCancelled(opts *bind.FilterOpts, auctionId []*big.Int) (*NFTAuctionAuctionCancelledIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "AuctionCancelled", auctionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionAuctionCancelledIterator{contract: _NFTAuction.contract, event: "AuctionCancelled", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionCancelled is a free log subscription operation binding the contract event 0x2809c7e17bf978fbc7194c0a694b638c4215e9140cacc6c38ca36010b45697df.
+//
+// Solidity: event AuctionCancelled(uint256 indexed auctionId)
+func (_NFTAuction *NFTAuctionFilterer) WatchAuctionCancelled(opts *bind.WatchOpts, sink chan<- *NFTAuctionAuctionCancelled, auctionId []*big.Int) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "AuctionCancelled", auctionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionAuctionCancelled)
+				if err := _NFTAuction.contract.UnpackLog(event, "AuctionCancelled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionCancelled is a log parse operation binding the contract event 0x2809c7e17bf978fbc7194c0a694b638c4215e9140cacc6c38ca36010b45697df.
+//
+// Solidity: event AuctionCancelled(uint256 indexed auctionId)
+func (_NFTAuction *NFTAuctionFilterer) ParseAuctionCancelled(log types.Log) (*NFTAuctionAuctionCancelled, error) {
+	event := new(NFTAuctionAuctionCancelled)
+	if err := _NFTAuction.contract.UnpackLog(event, "AuctionCancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionAuctionCreatedIterator is returned from FilterAuctionCreated and is used to iterate over the raw logs and unpacked data for AuctionCreated events raised by the NFTAuction contract.
+type NFTAuctionAuctionCreatedIterator struct {
+	Event *NFTAuctionAuctionCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionAuctionCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionAuctionCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionAuctionCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionAuctionCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionAuctionCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionAuctionCreated represents a AuctionCreated event raised by the NFTAuction contract.
+type NFTAuctionAuctionCreated struct {
+	AuctionId    *big.Int
+	Seller       common.Address
+	NftContract  common.Address
+	TokenId      *big.Int
+	StartPrice   *big.Int
+	ReservePrice *big.Int
+	StartTime    *big.Int
+	EndTime      *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionCreated is a free log retrieval operation binding the contract event 0xc415f0b2a44d885a1c207acb0b60c0179e4ac49da88f0e8917fb80302a9d782a.
+//
+// Solidity: event AuctionCreated(uint256 indexed auctionId, address indexed seller, address indexed nftContract, uint256 tokenId, uint256 startPrice, uint256 reservePrice, uint256 startTime, uint256 endTime)
+func (_NFTAuction *NFTAuctionFilterer) FilterAuctionCreated(opts *bind.FilterOpts, auctionId []*big.Int, seller []common.Address, nftContract []common.Address) (*NFTAuctionAuctionCreatedIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var sellerRule []interface{}
+	for _, sellerItem := range seller {
+		sellerRule = append(sellerRule, sellerItem)
+	}
+	var nftContractRule []interface{}
+	for _, nftContractItem := range nftContract {
+		nftContractRule = append(nftContractRule, nftContractItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "AuctionCreated", auctionIdRule, sellerRule, nftContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionAuctionCreatedIterator{contract: _NFTAuction.contract, event: "AuctionCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionCreated is a free log subscription operation binding the contract event 0xc415f0b2a44d885a1c207acb0b60c0179e4ac49da88f0e8917fb80302a9d782a.
+//
+// Solidity: event AuctionCreated(uint256 indexed auctionId, address indexed seller, address indexed nftContract, uint256 tokenId, uint256 startPrice, uint256 reservePrice, uint256 startTime, uint256 endTime)
+func (_NFTAuction *NFTAuctionFilterer) WatchAuctionCreated(opts *bind.WatchOpts, sink chan<- *NFTAuctionAuctionCreated, auctionId []*big.Int, seller []common.Address, nftContract []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var sellerRule []interface{}
+	for _, sellerItem := range seller {
+		sellerRule = append(sellerRule, sellerItem)
+	}
+	var nftContractRule []interface{}
+	for _, nftContractItem := range nftContract {
+		nftContractRule = append(nftContractRule, nftContractItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "AuctionCreated", auctionIdRule, sellerRule, nftContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionAuctionCreated)
+				if err := _NFTAuction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionCreated is a log parse operation binding the contract event 0xc415f0b2a44d885a1c207acb0b60c0179e4ac49da88f0e8917fb80302a9d782a.
+//
+// Solidity: event AuctionCreated(uint256 indexed auctionId, address indexed seller, address indexed nftContract, uint256 tokenId, uint256 startPrice, uint256 reservePrice, uint256 startTime, uint256 endTime)
+func (_NFTAuction *NFTAuctionFilterer) ParseAuctionCreated(log types.Log) (*NFTAuctionAuctionCreated, error) {
+	event := new(NFTAuctionAuctionCreated)
+	if err := _NFTAuction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionAuctionEndedIterator is returned from FilterAuctionEnded and is used to iterate over the raw logs and unpacked data for AuctionEnded events raised by the NFTAuction contract.
+type NFTAuctionAuctionEndedIterator struct {
+	Event *NFTAuctionAuctionEnded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionAuctionEndedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionAuctionEnded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionAuctionEnded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionAuctionEndedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionAuctionEndedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionAuctionEnded represents a AuctionEnded event raised by the NFTAuction contract.
+type NFTAuctionAuctionEnded struct {
+	AuctionId     *big.Int
+	Winner        common.Address
+	WinningBidUSD *big.Int
+	BidToken      common.Address
+	BidAmount     *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionEnded is a free log retrieval operation binding the contract event 0xe5475ecf90fac88802f904d6cb8390f7106dec2526f70b078ce579d6f7221251.
+//
+// Solidity: event AuctionEnded(uint256 indexed auctionId, address indexed winner, uint256 winningBidUSD, address bidToken, uint256 bidAmount)
+func (_NFTAuction *NFTAuctionFilterer) FilterAuctionEnded(opts *bind.FilterOpts, auctionId []*big.Int, winner []common.Address) (*NFTAuctionAuctionEndedIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "AuctionEnded", auctionIdRule, winnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionAuctionEndedIterator{contract: _NFTAuction.contract, event: "AuctionEnded", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionEnded is a free log subscription operation binding the contract event 0xe5475ecf90fac88802f904d6cb8390f7106dec2526f70b078ce579d6f7221251.
+//
+// Solidity: event AuctionEnded(uint256 indexed auctionId, address indexed winner, uint256 winningBidUSD, address bidToken, uint256 bidAmount)
+func (_NFTAuction *NFTAuctionFilterer) WatchAuctionEnded(opts *bind.WatchOpts, sink chan<- *NFTAuctionAuctionEnded, auctionId []*big.Int, winner []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "AuctionEnded", auctionIdRule, winnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionAuctionEnded)
+				if err := _NFTAuction.contract.UnpackLog(event, "AuctionEnded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionEnded is a log parse operation binding the contract event 0xe5475ecf90fac88802f904d6cb8390f7106dec2526f70b078ce579d6f7221251.
+//
+// Solidity: event AuctionEnded(uint256 indexed auctionId, address indexed winner, uint256 winningBidUSD, address bidToken, uint256 bidAmount)
+func (_NFTAuction *NFTAuctionFilterer) ParseAuctionEnded(log types.Log) (*NFTAuctionAuctionEnded, error) {
+	event := new(NFTAuctionAuctionEnded)
+	if err := _NFTAuction.contract.UnpackLog(event, "AuctionEnded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionBidPlacedIterator is returned from FilterBidPlaced and is used to iterate over the raw logs and unpacked data for BidPlaced events raised by the NFTAuction contract.
+type NFTAuctionBidPlacedIterator struct {
+	Event *NFTAuctionBidPlaced // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionBidPlacedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionBidPlaced)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionBidPlaced)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionBidPlacedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionBidPlacedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionBidPlaced represents a BidPlaced event raised by the NFTAuction contract.
+type NFTAuctionBidPlaced struct {
+	AuctionId    *big.Int
+	Bidder       common.Address
+	BidAmountUSD *big.Int
+	BidToken     common.Address
+	BidAmount    *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidPlaced is a free log retrieval operation binding the contract event 0x2e296671c28b83e813c76e2acf7481f5a2cc46aaeb9bcf33b3e048f50e9c33e9.
+//
+// Solidity: event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 bidAmountUSD, address bidToken, uint256 bidAmount)
+func (_NFTAuction *NFTAuctionFilterer) FilterBidPlaced(opts *bind.FilterOpts, auctionId []*big.Int, bidder []common.Address) (*NFTAuctionBidPlacedIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "BidPlaced", auctionIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionBidPlacedIterator{contract: _NFTAuction.contract, event: "BidPlaced", logs: logs, sub: sub}, nil
+}
+
+// WatchBidPlaced is a free log subscription operation binding the contract event 0x2e296671c28b83e813c76e2acf7481f5a2cc46aaeb9bcf33b3e048f50e9c33e9.
+//
+// Solidity: event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 bidAmountUSD, address bidToken, uint256 bidAmount)
+func (_NFTAuction *NFTAuctionFilterer) WatchBidPlaced(opts *bind.WatchOpts, sink chan<- *NFTAuctionBidPlaced, auctionId []*big.Int, bidder []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "BidPlaced", auctionIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionBidPlaced)
+				if err := _NFTAuction.contract.UnpackLog(event, "BidPlaced", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidPlaced is a log parse operation binding the contract event 0x2e296671c28b83e813c76e2acf7481f5a2cc46aaeb9bcf33b3e048f50e9c33e9.
+//
+// Solidity: event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 bidAmountUSD, address bidToken, uint256 bidAmount)
+func (_NFTAuction *NFTAuctionFilterer) ParseBidPlaced(log types.Log) (*NFTAuctionBidPlaced, error) {
+	event := new(NFTAuctionBidPlaced)
+	if err := _NFTAuction.contract.UnpackLog(event, "BidPlaced", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the NFTAuction contract.
+type NFTAuctionInitializedIterator struct {
+	Event *NFTAuctionInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionInitialized represents a Initialized event raised by the NFTAuction contract.
+type NFTAuctionInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_NFTAuction *NFTAuctionFilterer) FilterInitialized(opts *bind.FilterOpts) (*NFTAuctionInitializedIterator, error) {
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionInitializedIterator{contract: _NFTAuction.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_NFTAuction *NFTAuctionFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *NFTAuctionInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionInitialized)
+				if err := _NFTAuction.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_NFTAuction *NFTAuctionFilterer) ParseInitialized(log types.Log) (*NFTAuctionInitialized, error) {
+	event := new(NFTAuctionInitialized)
+	if err := _NFTAuction.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the NFTAuction contract.
+type NFTAuctionOwnershipTransferredIterator struct {
+	Event *NFTAuctionOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionOwnershipTransferred represents a OwnershipTransferred event raised by the NFTAuction contract.
+type NFTAuctionOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_NFTAuction *NFTAuctionFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*NFTAuctionOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionOwnershipTransferredIterator{contract: _NFTAuction.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_NFTAuction *NFTAuctionFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *NFTAuctionOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionOwnershipTransferred)
+				if err := _NFTAuction.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_NFTAuction *NFTAuctionFilterer) ParseOwnershipTransferred(log types.Log) (*NFTAuctionOwnershipTransferred, error) {
+	event := new(NFTAuctionOwnershipTransferred)
+	if err := _NFTAuction.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,11 @@
+// Package auction 由auction/contracts中NFTAuction.sol和AuctionFactory.sol的ABI生成的Go绑定
+//
+// 合约修改后，从Hardhat编译产物中导出ABI并重新生成：
+//
+//	jq .abi ../../../../../auction/artifacts/contracts/NFTAuction.sol/NFTAuction.json > NFTAuction.abi
+//	jq .abi ../../../../../auction/artifacts/contracts/AuctionFactory.sol/AuctionFactory.json > AuctionFactory.abi
+//	go generate ./internal/bindings/auction
+package auction
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi NFTAuction.abi --pkg auction --type NFTAuction --out NFTAuction.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi AuctionFactory.abi --pkg auction --type AuctionFactory --out AuctionFactory.go
@@ -0,0 +1,1154 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package begging
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BeggingContractMetaData contains all meta data concerning the BeggingContract contract.
+var BeggingContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"DonationAmountZero\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"DonationEnded\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"DonationNotStarted\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidTimeRange\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NoFundsToWithdraw\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyOwner\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrantCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"WithdrawalFailed\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"donor\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Donation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"DonationTimeSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Withdrawal\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"disableTimeLimit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"donate\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"donationEndTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"donationStartTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"donations\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"donors\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getContractBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"donor\",\"type\":\"address\"}],\"name\":\"getDonation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getDonationTimeInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentTime\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getDonorCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTopDonors\",\"outputs\":[{\"internalType\":\"address[3]\",\"name\":\"topDonors\",\"type\":\"address[3]\"},{\"internalType\":\"uint256[3]\",\"name\":\"topAmounts\",\"type\":\"uint256[3]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"hasDonated\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_endTime\",\"type\":\"uint256\"}],\"name\":\"setDonationTime\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"timeLimitEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+}
+
+// BeggingContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use BeggingContractMetaData.ABI instead.
+var BeggingContractABI = BeggingContractMetaData.ABI
+
+// BeggingContract is an auto generated Go binding around an Ethereum contract.
+type BeggingContract struct {
+	BeggingContractCaller     // Read-only binding to the contract
+	BeggingContractTransactor // Write-only binding to the contract
+	BeggingContractFilterer   // Log filterer for contract events
+}
+
+// BeggingContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BeggingContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BeggingContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BeggingContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BeggingContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BeggingContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BeggingContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BeggingContractSession struct {
+	Contract     *BeggingContract  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BeggingContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BeggingContractCallerSession struct {
+	Contract *BeggingContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// BeggingContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BeggingContractTransactorSession struct {
+	Contract     *BeggingContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// BeggingContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BeggingContractRaw struct {
+	Contract *BeggingContract // Generic contract binding to access the raw methods on
+}
+
+// BeggingContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BeggingContractCallerRaw struct {
+	Contract *BeggingContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BeggingContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BeggingContractTransactorRaw struct {
+	Contract *BeggingContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBeggingContract creates a new instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContract(address common.Address, backend bind.ContractBackend) (*BeggingContract, error) {
+	contract, err := bindBeggingContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContract{BeggingContractCaller: BeggingContractCaller{contract: contract}, BeggingContractTransactor: BeggingContractTransactor{contract: contract}, BeggingContractFilterer: BeggingContractFilterer{contract: contract}}, nil
+}
+
+// NewBeggingContractCaller creates a new read-only instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContractCaller(address common.Address, caller bind.ContractCaller) (*BeggingContractCaller, error) {
+	contract, err := bindBeggingContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractCaller{contract: contract}, nil
+}
+
+// NewBeggingContractTransactor creates a new write-only instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContractTransactor(address common.Address, transactor bind.ContractTransactor) (*BeggingContractTransactor, error) {
+	contract, err := bindBeggingContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractTransactor{contract: contract}, nil
+}
+
+// NewBeggingContractFilterer creates a new log filterer instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContractFilterer(address common.Address, filterer bind.ContractFilterer) (*BeggingContractFilterer, error) {
+	contract, err := bindBeggingContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractFilterer{contract: contract}, nil
+}
+
+// bindBeggingContract binds a generic wrapper to an already deployed contract.
+func bindBeggingContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BeggingContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BeggingContract *BeggingContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BeggingContract.Contract.BeggingContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BeggingContract *BeggingContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.Contract.BeggingContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BeggingContract *BeggingContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BeggingContract.Contract.BeggingContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BeggingContract *BeggingContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BeggingContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BeggingContract *BeggingContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BeggingContract *BeggingContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BeggingContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// DonationEndTime is a free data retrieval call binding the contract method 0xf12a4a53.
+//
+// Solidity: function donationEndTime() view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) DonationEndTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "donationEndTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DonationEndTime is a free data retrieval call binding the contract method 0xf12a4a53.
+//
+// Solidity: function donationEndTime() view returns(uint256)
+func (_BeggingContract *BeggingContractSession) DonationEndTime() (*big.Int, error) {
+	return _BeggingContract.Contract.DonationEndTime(&_BeggingContract.CallOpts)
+}
+
+// DonationEndTime is a free data retrieval call binding the contract method 0xf12a4a53.
+//
+// Solidity: function donationEndTime() view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) DonationEndTime() (*big.Int, error) {
+	return _BeggingContract.Contract.DonationEndTime(&_BeggingContract.CallOpts)
+}
+
+// DonationStartTime is a free data retrieval call binding the contract method 0x78331552.
+//
+// Solidity: function donationStartTime() view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) DonationStartTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "donationStartTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DonationStartTime is a free data retrieval call binding the contract method 0x78331552.
+//
+// Solidity: function donationStartTime() view returns(uint256)
+func (_BeggingContract *BeggingContractSession) DonationStartTime() (*big.Int, error) {
+	return _BeggingContract.Contract.DonationStartTime(&_BeggingContract.CallOpts)
+}
+
+// DonationStartTime is a free data retrieval call binding the contract method 0x78331552.
+//
+// Solidity: function donationStartTime() view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) DonationStartTime() (*big.Int, error) {
+	return _BeggingContract.Contract.DonationStartTime(&_BeggingContract.CallOpts)
+}
+
+// Donations is a free data retrieval call binding the contract method 0xcc6cb19a.
+//
+// Solidity: function donations(address ) view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) Donations(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "donations", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Donations is a free data retrieval call binding the contract method 0xcc6cb19a.
+//
+// Solidity: function donations(address ) view returns(uint256)
+func (_BeggingContract *BeggingContractSession) Donations(arg0 common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.Donations(&_BeggingContract.CallOpts, arg0)
+}
+
+// Donations is a free data retrieval call binding the contract method 0xcc6cb19a.
+//
+// Solidity: function donations(address ) view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) Donations(arg0 common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.Donations(&_BeggingContract.CallOpts, arg0)
+}
+
+// Donors is a free data retrieval call binding the contract method 0x4abfa163.
+//
+// Solidity: function donors(uint256 ) view returns(address)
+func (_BeggingContract *BeggingContractCaller) Donors(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "donors", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Donors is a free data retrieval call binding the contract method 0x4abfa163.
+//
+// Solidity: function donors(uint256 ) view returns(address)
+func (_BeggingContract *BeggingContractSession) Donors(arg0 *big.Int) (common.Address, error) {
+	return _BeggingContract.Contract.Donors(&_BeggingContract.CallOpts, arg0)
+}
+
+// Donors is a free data retrieval call binding the contract method 0x4abfa163.
+//
+// Solidity: function donors(uint256 ) view returns(address)
+func (_BeggingContract *BeggingContractCallerSession) Donors(arg0 *big.Int) (common.Address, error) {
+	return _BeggingContract.Contract.Donors(&_BeggingContract.CallOpts, arg0)
+}
+
+// GetContractBalance is a free data retrieval call binding the contract method 0x6f9fb98a.
+//
+// Solidity: function getContractBalance() view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) GetContractBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getContractBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetContractBalance is a free data retrieval call binding the contract method 0x6f9fb98a.
+//
+// Solidity: function getContractBalance() view returns(uint256)
+func (_BeggingContract *BeggingContractSession) GetContractBalance() (*big.Int, error) {
+	return _BeggingContract.Contract.GetContractBalance(&_BeggingContract.CallOpts)
+}
+
+// GetContractBalance is a free data retrieval call binding the contract method 0x6f9fb98a.
+//
+// Solidity: function getContractBalance() view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) GetContractBalance() (*big.Int, error) {
+	return _BeggingContract.Contract.GetContractBalance(&_BeggingContract.CallOpts)
+}
+
+// GetDonation is a free data retrieval call binding the contract method 0x410a1d32.
+//
+// Solidity: function getDonation(address donor) view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) GetDonation(opts *bind.CallOpts, donor common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getDonation", donor)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetDonation is a free data retrieval call binding the contract method 0x410a1d32.
+//
+// Solidity: function getDonation(address donor) view returns(uint256)
+func (_BeggingContract *BeggingContractSession) GetDonation(donor common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.GetDonation(&_BeggingContract.CallOpts, donor)
+}
+
+// GetDonation is a free data retrieval call binding the contract method 0x410a1d32.
+//
+// Solidity: function getDonation(address donor) view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) GetDonation(donor common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.GetDonation(&_BeggingContract.CallOpts, donor)
+}
+
+// GetDonationTimeInfo is a free data retrieval call binding the contract method 0xa2b1eda4.
+//
+// Solidity: function getDonationTimeInfo() view returns(bool enabled, uint256 startTime, uint256 endTime, uint256 currentTime)
+func (_BeggingContract *BeggingContractCaller) GetDonationTimeInfo(opts *bind.CallOpts) (struct {
+	Enabled     bool
+	StartTime   *big.Int
+	EndTime     *big.Int
+	CurrentTime *big.Int
+}, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getDonationTimeInfo")
+
+	outstruct := new(struct {
+		Enabled     bool
+		StartTime   *big.Int
+		EndTime     *big.Int
+		CurrentTime *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.StartTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.EndTime = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.CurrentTime = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetDonationTimeInfo is a free data retrieval call binding the contract method 0xa2b1eda4.
+//
+// Solidity: function getDonationTimeInfo() view returns(bool enabled, uint256 startTime, uint256 endTime, uint256 currentTime)
+func (_BeggingContract *BeggingContractSession) GetDonationTimeInfo() (struct {
+	Enabled     bool
+	StartTime   *big.Int
+	EndTime     *big.Int
+	CurrentTime *big.Int
+}, error) {
+	return _BeggingContract.Contract.GetDonationTimeInfo(&_BeggingContract.CallOpts)
+}
+
+// GetDonationTimeInfo is a free data retrieval call binding the contract method 0xa2b1eda4.
+//
+// Solidity: function getDonationTimeInfo() view returns(bool enabled, uint256 startTime, uint256 endTime, uint256 currentTime)
+func (_BeggingContract *BeggingContractCallerSession) GetDonationTimeInfo() (struct {
+	Enabled     bool
+	StartTime   *big.Int
+	EndTime     *big.Int
+	CurrentTime *big.Int
+}, error) {
+	return _BeggingContract.Contract.GetDonationTimeInfo(&_BeggingContract.CallOpts)
+}
+
+// GetDonorCount is a free data retrieval call binding the contract method 0x69bc2f1e.
+//
+// Solidity: function getDonorCount() view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) GetDonorCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getDonorCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetDonorCount is a free data retrieval call binding the contract method 0x69bc2f1e.
+//
+// Solidity: function getDonorCount() view returns(uint256)
+func (_BeggingContract *BeggingContractSession) GetDonorCount() (*big.Int, error) {
+	return _BeggingContract.Contract.GetDonorCount(&_BeggingContract.CallOpts)
+}
+
+// GetDonorCount is a free data retrieval call binding the contract method 0x69bc2f1e.
+//
+// Solidity: function getDonorCount() view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) GetDonorCount() (*big.Int, error) {
+	return _BeggingContract.Contract.GetDonorCount(&_BeggingContract.CallOpts)
+}
+
+// GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
+//
+// Solidity: function getOwner() view returns(address)
+func (_BeggingContract *BeggingContractCaller) GetOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getOwner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
+//
+// Solidity: function getOwner() view returns(address)
+func (_BeggingContract *BeggingContractSession) GetOwner() (common.Address, error) {
+	return _BeggingContract.Contract.GetOwner(&_BeggingContract.CallOpts)
+}
+
+// GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
+//
+// Solidity: function getOwner() view returns(address)
+func (_BeggingContract *BeggingContractCallerSession) GetOwner() (common.Address, error) {
+	return _BeggingContract.Contract.GetOwner(&_BeggingContract.CallOpts)
+}
+
+// GetTopDonors is a free data retrieval call binding the contract method 0xd6387ed8.
+//
+// Solidity: function getTopDonors() view returns(address[3] topDonors, uint256[3] topAmounts)
+func (_BeggingContract *BeggingContractCaller) GetTopDonors(opts *bind.CallOpts) (struct {
+	TopDonors  [3]common.Address
+	TopAmounts [3]*big.Int
+}, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getTopDonors")
+
+	outstruct := new(struct {
+		TopDonors  [3]common.Address
+		TopAmounts [3]*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TopDonors = *abi.ConvertType(out[0], new([3]common.Address)).(*[3]common.Address)
+	outstruct.TopAmounts = *abi.ConvertType(out[1], new([3]*big.Int)).(*[3]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetTopDonors is a free data retrieval call binding the contract method 0xd6387ed8.
+//
+// Solidity: function getTopDonors() view returns(address[3] topDonors, uint256[3] topAmounts)
+func (_BeggingContract *BeggingContractSession) GetTopDonors() (struct {
+	TopDonors  [3]common.Address
+	TopAmounts [3]*big.Int
+}, error) {
+	return _BeggingContract.Contract.GetTopDonors(&_BeggingContract.CallOpts)
+}
+
+// GetTopDonors is a free data retrieval call binding the contract method 0xd6387ed8.
+//
+// Solidity: function getTopDonors() view returns(address[3] topDonors, uint256[3] topAmounts)
+func (_BeggingContract *BeggingContractCallerSession) GetTopDonors() (struct {
+	TopDonors  [3]common.Address
+	TopAmounts [3]*big.Int
+}, error) {
+	return _BeggingContract.Contract.GetTopDonors(&_BeggingContract.CallOpts)
+}
+
+// HasDonated is a free data retrieval call binding the contract method 0x17294a11.
+//
+// Solidity: function hasDonated(address ) view returns(bool)
+func (_BeggingContract *BeggingContractCaller) HasDonated(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "hasDonated", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasDonated is a free data retrieval call binding the contract method 0x17294a11.
+//
+// Solidity: function hasDonated(address ) view returns(bool)
+func (_BeggingContract *BeggingContractSession) HasDonated(arg0 common.Address) (bool, error) {
+	return _BeggingContract.Contract.HasDonated(&_BeggingContract.CallOpts, arg0)
+}
+
+// HasDonated is a free data retrieval call binding the contract method 0x17294a11.
+//
+// Solidity: function hasDonated(address ) view returns(bool)
+func (_BeggingContract *BeggingContractCallerSession) HasDonated(arg0 common.Address) (bool, error) {
+	return _BeggingContract.Contract.HasDonated(&_BeggingContract.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BeggingContract *BeggingContractCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BeggingContract *BeggingContractSession) Owner() (common.Address, error) {
+	return _BeggingContract.Contract.Owner(&_BeggingContract.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BeggingContract *BeggingContractCallerSession) Owner() (common.Address, error) {
+	return _BeggingContract.Contract.Owner(&_BeggingContract.CallOpts)
+}
+
+// TimeLimitEnabled is a free data retrieval call binding the contract method 0xaf50705f.
+//
+// Solidity: function timeLimitEnabled() view returns(bool)
+func (_BeggingContract *BeggingContractCaller) TimeLimitEnabled(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "timeLimitEnabled")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// TimeLimitEnabled is a free data retrieval call binding the contract method 0xaf50705f.
+//
+// Solidity: function timeLimitEnabled() view returns(bool)
+func (_BeggingContract *BeggingContractSession) TimeLimitEnabled() (bool, error) {
+	return _BeggingContract.Contract.TimeLimitEnabled(&_BeggingContract.CallOpts)
+}
+
+// TimeLimitEnabled is a free data retrieval call binding the contract method 0xaf50705f.
+//
+// Solidity: function timeLimitEnabled() view returns(bool)
+func (_BeggingContract *BeggingContractCallerSession) TimeLimitEnabled() (bool, error) {
+	return _BeggingContract.Contract.TimeLimitEnabled(&_BeggingContract.CallOpts)
+}
+
+// DisableTimeLimit is a paid mutator transaction binding the contract method 0x66218f44.
+//
+// Solidity: function disableTimeLimit() returns()
+func (_BeggingContract *BeggingContractTransactor) DisableTimeLimit(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.contract.Transact(opts, "disableTimeLimit")
+}
+
+// DisableTimeLimit is a paid mutator transaction binding the contract method 0x66218f44.
+//
+// Solidity: function disableTimeLimit() returns()
+func (_BeggingContract *BeggingContractSession) DisableTimeLimit() (*types.Transaction, error) {
+	return _BeggingContract.Contract.DisableTimeLimit(&_BeggingContract.TransactOpts)
+}
+
+// DisableTimeLimit is a paid mutator transaction binding the contract method 0x66218f44.
+//
+// Solidity: function disableTimeLimit() returns()
+func (_BeggingContract *BeggingContractTransactorSession) DisableTimeLimit() (*types.Transaction, error) {
+	return _BeggingContract.Contract.DisableTimeLimit(&_BeggingContract.TransactOpts)
+}
+
+// Donate is a paid mutator transaction binding the contract method 0xed88c68e.
+//
+// Solidity: function donate() payable returns()
+func (_BeggingContract *BeggingContractTransactor) Donate(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.contract.Transact(opts, "donate")
+}
+
+// Donate is a paid mutator transaction binding the contract method 0xed88c68e.
+//
+// Solidity: function donate() payable returns()
+func (_BeggingContract *BeggingContractSession) Donate() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Donate(&_BeggingContract.TransactOpts)
+}
+
+// Donate is a paid mutator transaction binding the contract method 0xed88c68e.
+//
+// Solidity: function donate() payable returns()
+func (_BeggingContract *BeggingContractTransactorSession) Donate() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Donate(&_BeggingContract.TransactOpts)
+}
+
+// SetDonationTime is a paid mutator transaction binding the contract method 0x19146f54.
+//
+// Solidity: function setDonationTime(uint256 _startTime, uint256 _endTime) returns()
+func (_BeggingContract *BeggingContractTransactor) SetDonationTime(opts *bind.TransactOpts, _startTime *big.Int, _endTime *big.Int) (*types.Transaction, error) {
+	return _BeggingContract.contract.Transact(opts, "setDonationTime", _startTime, _endTime)
+}
+
+// SetDonationTime is a paid mutator transaction binding the contract method 0x19146f54.
+//
+// Solidity: function setDonationTime(uint256 _startTime, uint256 _endTime) returns()
+func (_BeggingContract *BeggingContractSession) SetDonationTime(_startTime *big.Int, _endTime *big.Int) (*types.Transaction, error) {
+	return _BeggingContract.Contract.SetDonationTime(&_BeggingContract.TransactOpts, _startTime, _endTime)
+}
+
+// SetDonationTime is a paid mutator transaction binding the contract method 0x19146f54.
+//
+// Solidity: function setDonationTime(uint256 _startTime, uint256 _endTime) returns()
+func (_BeggingContract *BeggingContractTransactorSession) SetDonationTime(_startTime *big.Int, _endTime *big.Int) (*types.Transaction, error) {
+	return _BeggingContract.Contract.SetDonationTime(&_BeggingContract.TransactOpts, _startTime, _endTime)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_BeggingContract *BeggingContractTransactor) Withdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.contract.Transact(opts, "withdraw")
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_BeggingContract *BeggingContractSession) Withdraw() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Withdraw(&_BeggingContract.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_BeggingContract *BeggingContractTransactorSession) Withdraw() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Withdraw(&_BeggingContract.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BeggingContract *BeggingContractTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BeggingContract *BeggingContractSession) Receive() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Receive(&_BeggingContract.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BeggingContract *BeggingContractTransactorSession) Receive() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Receive(&_BeggingContract.TransactOpts)
+}
+
+// BeggingContractDonationIterator is returned from FilterDonation and is used to iterate over the raw logs and unpacked data for Donation events raised by the BeggingContract contract.
+type BeggingContractDonationIterator struct {
+	Event *BeggingContractDonation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BeggingContractDonationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BeggingContractDonation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BeggingContractDonation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BeggingContractDonationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BeggingContractDonationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BeggingContractDonation represents a Donation event raised by the BeggingContract contract.
+type BeggingContractDonation struct {
+	Donor     common.Address
+	Amount    *big.Int
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDonation is a free log retrieval operation binding the contract event 0x106aac375bbcf013d1e52338bbf9e740009a1a3a6869f8daa1b72aa1620f5fec.
+//
+// Solidity: event Donation(address indexed donor, uint256 amount, uint256 timestamp)
+func (_BeggingContract *BeggingContractFilterer) FilterDonation(opts *bind.FilterOpts, donor []common.Address) (*BeggingContractDonationIterator, error) {
+
+	var donorRule []interface{}
+	for _, donorItem := range donor {
+		donorRule = append(donorRule, donorItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.FilterLogs(opts, "Donation", donorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractDonationIterator{contract: _BeggingContract.contract, event: "Donation", logs: logs, sub: sub}, nil
+}
+
+// WatchDonation is a free log subscription operation binding the contract event 0x106aac375bbcf013d1e52338bbf9e740009a1a3a6869f8daa1b72aa1620f5fec.
+//
+// Solidity: event Donation(address indexed donor, uint256 amount, uint256 timestamp)
+func (_BeggingContract *BeggingContractFilterer) WatchDonation(opts *bind.WatchOpts, sink chan<- *BeggingContractDonation, donor []common.Address) (event.Subscription, error) {
+
+	var donorRule []interface{}
+	for _, donorItem := range donor {
+		donorRule = append(donorRule, donorItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.WatchLogs(opts, "Donation", donorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BeggingContractDonation)
+				if err := _BeggingContract.contract.UnpackLog(event, "Donation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDonation is a log parse operation binding the contract event 0x106aac375bbcf013d1e52338bbf9e740009a1a3a6869f8daa1b72aa1620f5fec.
+//
+// Solidity: event Donation(address indexed donor, uint256 amount, uint256 timestamp)
+func (_BeggingContract *BeggingContractFilterer) ParseDonation(log types.Log) (*BeggingContractDonation, error) {
+	event := new(BeggingContractDonation)
+	if err := _BeggingContract.contract.UnpackLog(event, "Donation", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BeggingContractDonationTimeSetIterator is returned from FilterDonationTimeSet and is used to iterate over the raw logs and unpacked data for DonationTimeSet events raised by the BeggingContract contract.
+type BeggingContractDonationTimeSetIterator struct {
+	Event *BeggingContractDonationTimeSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BeggingContractDonationTimeSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BeggingContractDonationTimeSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BeggingContractDonationTimeSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BeggingContractDonationTimeSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BeggingContractDonationTimeSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BeggingContractDonationTimeSet represents a DonationTimeSet event raised by the BeggingContract contract.
+type BeggingContractDonationTimeSet struct {
+	StartTime *big.Int
+	EndTime   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDonationTimeSet is a free log retrieval operation binding the contract event 0x433b2172ed300185962a672741aaabc9d3b48501a0588b9a4d4f2a0236e93fe7.
+//
+// Solidity: event DonationTimeSet(uint256 startTime, uint256 endTime)
+func (_BeggingContract *BeggingContractFilterer) FilterDonationTimeSet(opts *bind.FilterOpts) (*BeggingContractDonationTimeSetIterator, error) {
+
+	logs, sub, err := _BeggingContract.contract.FilterLogs(opts, "DonationTimeSet")
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractDonationTimeSetIterator{contract: _BeggingContract.contract, event: "DonationTimeSet", logs: logs, sub: sub}, nil
+}
+
+// WatchDonationTimeSet is a free log subscription operation binding the contract event 0x433b2172ed300185962a672741aaabc9d3b48501a0588b9a4d4f2a0236e93fe7.
+//
+// Solidity: event DonationTimeSet(uint256 startTime, uint256 endTime)
+func (_BeggingContract *BeggingContractFilterer) WatchDonationTimeSet(opts *bind.WatchOpts, sink chan<- *BeggingContractDonationTimeSet) (event.Subscription, error) {
+
+	logs, sub, err := _BeggingContract.contract.WatchLogs(opts, "DonationTimeSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BeggingContractDonationTimeSet)
+				if err := _BeggingContract.contract.UnpackLog(event, "DonationTimeSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDonationTimeSet is a log parse operation binding the contract event 0x433b2172ed300185962a672741aaabc9d3b48501a0588b9a4d4f2a0236e93fe7.
+//
+// Solidity: event DonationTimeSet(uint256 startTime, uint256 endTime)
+func (_BeggingContract *BeggingContractFilterer) ParseDonationTimeSet(log types.Log) (*BeggingContractDonationTimeSet, error) {
+	event := new(BeggingContractDonationTimeSet)
+	if err := _BeggingContract.contract.UnpackLog(event, "DonationTimeSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BeggingContractWithdrawalIterator is returned from FilterWithdrawal and is used to iterate over the raw logs and unpacked data for Withdrawal events raised by the BeggingContract contract.
+type BeggingContractWithdrawalIterator struct {
+	Event *BeggingContractWithdrawal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BeggingContractWithdrawalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BeggingContractWithdrawal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BeggingContractWithdrawal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BeggingContractWithdrawalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BeggingContractWithdrawalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BeggingContractWithdrawal represents a Withdrawal event raised by the BeggingContract contract.
+type BeggingContractWithdrawal struct {
+	Owner     common.Address
+	Amount    *big.Int
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawal is a free log retrieval operation binding the contract event 0xdf273cb619d95419a9cd0ec88123a0538c85064229baa6363788f743fff90deb.
+//
+// Solidity: event Withdrawal(address indexed owner, uint256 amount, uint256 timestamp)
+func (_BeggingContract *BeggingContractFilterer) FilterWithdrawal(opts *bind.FilterOpts, owner []common.Address) (*BeggingContractWithdrawalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.FilterLogs(opts, "Withdrawal", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractWithdrawalIterator{contract: _BeggingContract.contract, event: "Withdrawal", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawal is a free log subscription operation binding the contract event 0xdf273cb619d95419a9cd0ec88123a0538c85064229baa6363788f743fff90deb.
+//
+// Solidity: event Withdrawal(address indexed owner, uint256 amount, uint256 timestamp)
+func (_BeggingContract *BeggingContractFilterer) WatchWithdrawal(opts *bind.WatchOpts, sink chan<- *BeggingContractWithdrawal, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.WatchLogs(opts, "Withdrawal", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BeggingContractWithdrawal)
+				if err := _BeggingContract.contract.UnpackLog(event, "Withdrawal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawal is a log parse operation binding the contract event 0xdf273cb619d95419a9cd0ec88123a0538c85064229baa6363788f743fff90deb.
+//
+// Solidity: event Withdrawal(address indexed owner, uint256 amount, uint256 timestamp)
+func (_BeggingContract *BeggingContractFilterer) ParseWithdrawal(log types.Log) (*BeggingContractWithdrawal, error) {
+	event := new(BeggingContractWithdrawal)
+	if err := _BeggingContract.contract.UnpackLog(event, "Withdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,9 @@
+// Package begging 由begging-contract/contracts/BeggingContract.sol的ABI生成的Go绑定
+//
+// 合约修改后，从Hardhat编译产物中导出ABI并重新生成：
+//
+//	jq .abi ../../../../../begging-contract/artifacts/contracts/BeggingContract.sol/BeggingContract.json > BeggingContract.abi
+//	go generate ./internal/bindings/begging
+package begging
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi BeggingContract.abi --pkg begging --type BeggingContract --out BeggingContract.go
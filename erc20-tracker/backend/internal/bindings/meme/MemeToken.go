@@ -0,0 +1,2932 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package meme
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MemeTokenMetaData contains all meta data concerning the MemeToken contract.
+var MemeTokenMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_liquidityWallet\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_marketingWallet\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_uniswapV2Router\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bool\",\"name\":\"cooldownEnabled\",\"type\":\"bool\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"cooldownSeconds\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"bool\",\"name\":\"dailyLimitEnabled\",\"type\":\"bool\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"maxDailyTxCount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"FrequencyParamsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bool\",\"name\":\"autoLpEnabled\",\"type\":\"bool\",\"indexed\":false},{\"internalType\":\"bool\",\"name\":\"userLpEnabled\",\"type\":\"bool\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"slippagePercent\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"deadlineMinutes\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"LpConfigUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokensSwapped\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"ethReceived\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"tokensIntoLiquidity\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"SwapAndLiquify\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"tax\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"TaxCollected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"liquidity\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"marketing\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"burn\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"TaxDistributionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"buyTax\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"sellTax\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"transferTax\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"TaxRatesUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"TokensBurned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\",\"indexed\":false}],\"name\":\"TradingEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"tokenAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"ethAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"lpTokens\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"UserAddLiquidity\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"lpTokens\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"tokenAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"ethAmount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"UserRemoveLiquidity\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_SUPPLY\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TAX_DENOMINATOR\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"burnTaxShare\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"buyTaxRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getStats\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"totalSupply_\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalTaxCollected_\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalBurned_\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"tradingEnabled_\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"swapEnabled_\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTaxInfo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"buyTax\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"sellTax\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"transferTax\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"liquidityShare\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"marketingShare\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"burnShare\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isAMMPair\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isExcludedFromTax\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"liquidityTaxShare\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"liquidityWallet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"marketingTaxShare\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"marketingWallet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sellTaxRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalBurned\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalTaxCollected\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"transferTaxRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"uniswapV2Pair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+}
+
+// MemeTokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use MemeTokenMetaData.ABI instead.
+var MemeTokenABI = MemeTokenMetaData.ABI
+
+// MemeToken is an auto generated Go binding around an Ethereum contract.
+type MemeToken struct {
+	MemeTokenCaller     // Read-only binding to the contract
+	MemeTokenTransactor // Write-only binding to the contract
+	MemeTokenFilterer   // Log filterer for contract events
+}
+
+// MemeTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MemeTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MemeTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MemeTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MemeTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MemeTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MemeTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MemeTokenSession struct {
+	Contract     *MemeToken        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MemeTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MemeTokenCallerSession struct {
+	Contract *MemeTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// MemeTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MemeTokenTransactorSession struct {
+	Contract     *MemeTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// MemeTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MemeTokenRaw struct {
+	Contract *MemeToken // Generic contract binding to access the raw methods on
+}
+
+// MemeTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MemeTokenCallerRaw struct {
+	Contract *MemeTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MemeTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MemeTokenTransactorRaw struct {
+	Contract *MemeTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMemeToken creates a new instance of MemeToken, bound to a specific deployed contract.
+func NewMemeToken(address common.Address, backend bind.ContractBackend) (*MemeToken, error) {
+	contract, err := bindMemeToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeToken{MemeTokenCaller: MemeTokenCaller{contract: contract}, MemeTokenTransactor: MemeTokenTransactor{contract: contract}, MemeTokenFilterer: MemeTokenFilterer{contract: contract}}, nil
+}
+
+// NewMemeTokenCaller creates a new read-only instance of MemeToken, bound to a specific deployed contract.
+func NewMemeTokenCaller(address common.Address, caller bind.ContractCaller) (*MemeTokenCaller, error) {
+	contract, err := bindMemeToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenCaller{contract: contract}, nil
+}
+
+// NewMemeTokenTransactor creates a new write-only instance of MemeToken, bound to a specific deployed contract.
+func NewMemeTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*MemeTokenTransactor, error) {
+	contract, err := bindMemeToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTransactor{contract: contract}, nil
+}
+
+// NewMemeTokenFilterer creates a new log filterer instance of MemeToken, bound to a specific deployed contract.
+func NewMemeTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*MemeTokenFilterer, error) {
+	contract, err := bindMemeToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenFilterer{contract: contract}, nil
+}
+
+// bindMemeToken binds a generic wrapper to an already deployed contract.
+func bindMemeToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MemeTokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MemeToken *MemeTokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MemeToken.Contract.MemeTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MemeToken *MemeTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MemeToken.Contract.MemeTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MemeToken *MemeTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MemeToken.Contract.MemeTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MemeToken *MemeTokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MemeToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MemeToken *MemeTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MemeToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MemeToken *MemeTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MemeToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) MAXSUPPLY(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "MAX_SUPPLY")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_MemeToken *MemeTokenSession) MAXSUPPLY() (*big.Int, error) {
+	return _MemeToken.Contract.MAXSUPPLY(&_MemeToken.CallOpts)
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) MAXSUPPLY() (*big.Int, error) {
+	return _MemeToken.Contract.MAXSUPPLY(&_MemeToken.CallOpts)
+}
+
+// TAXDENOMINATOR is a free data retrieval call binding the contract method 0xa51c9ace.
+//
+// Solidity: function TAX_DENOMINATOR() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) TAXDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "TAX_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TAXDENOMINATOR is a free data retrieval call binding the contract method 0xa51c9ace.
+//
+// Solidity: function TAX_DENOMINATOR() view returns(uint256)
+func (_MemeToken *MemeTokenSession) TAXDENOMINATOR() (*big.Int, error) {
+	return _MemeToken.Contract.TAXDENOMINATOR(&_MemeToken.CallOpts)
+}
+
+// TAXDENOMINATOR is a free data retrieval call binding the contract method 0xa51c9ace.
+//
+// Solidity: function TAX_DENOMINATOR() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) TAXDENOMINATOR() (*big.Int, error) {
+	return _MemeToken.Contract.TAXDENOMINATOR(&_MemeToken.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_MemeToken *MemeTokenCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_MemeToken *MemeTokenSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _MemeToken.Contract.Allowance(&_MemeToken.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _MemeToken.Contract.Allowance(&_MemeToken.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_MemeToken *MemeTokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_MemeToken *MemeTokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _MemeToken.Contract.BalanceOf(&_MemeToken.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _MemeToken.Contract.BalanceOf(&_MemeToken.CallOpts, account)
+}
+
+// BurnTaxShare is a free data retrieval call binding the contract method 0x49e2b687.
+//
+// Solidity: function burnTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) BurnTaxShare(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "burnTaxShare")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BurnTaxShare is a free data retrieval call binding the contract method 0x49e2b687.
+//
+// Solidity: function burnTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenSession) BurnTaxShare() (*big.Int, error) {
+	return _MemeToken.Contract.BurnTaxShare(&_MemeToken.CallOpts)
+}
+
+// BurnTaxShare is a free data retrieval call binding the contract method 0x49e2b687.
+//
+// Solidity: function burnTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) BurnTaxShare() (*big.Int, error) {
+	return _MemeToken.Contract.BurnTaxShare(&_MemeToken.CallOpts)
+}
+
+// BuyTaxRate is a free data retrieval call binding the contract method 0x691f224f.
+//
+// Solidity: function buyTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) BuyTaxRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "buyTaxRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BuyTaxRate is a free data retrieval call binding the contract method 0x691f224f.
+//
+// Solidity: function buyTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenSession) BuyTaxRate() (*big.Int, error) {
+	return _MemeToken.Contract.BuyTaxRate(&_MemeToken.CallOpts)
+}
+
+// BuyTaxRate is a free data retrieval call binding the contract method 0x691f224f.
+//
+// Solidity: function buyTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) BuyTaxRate() (*big.Int, error) {
+	return _MemeToken.Contract.BuyTaxRate(&_MemeToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_MemeToken *MemeTokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_MemeToken *MemeTokenSession) Decimals() (uint8, error) {
+	return _MemeToken.Contract.Decimals(&_MemeToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_MemeToken *MemeTokenCallerSession) Decimals() (uint8, error) {
+	return _MemeToken.Contract.Decimals(&_MemeToken.CallOpts)
+}
+
+// GetStats is a free data retrieval call binding the contract method 0xc59d4847.
+//
+// Solidity: function getStats() view returns(uint256 totalSupply_, uint256 totalTaxCollected_, uint256 totalBurned_, bool tradingEnabled_, bool swapEnabled_)
+func (_MemeToken *MemeTokenCaller) GetStats(opts *bind.CallOpts) (struct {
+	TotalSupply       *big.Int
+	TotalTaxCollected *big.Int
+	TotalBurned       *big.Int
+	TradingEnabled    bool
+	SwapEnabled       bool
+}, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "getStats")
+
+	outstruct := new(struct {
+		TotalSupply       *big.Int
+		TotalTaxCollected *big.Int
+		TotalBurned       *big.Int
+		TradingEnabled    bool
+		SwapEnabled       bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TotalSupply = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.TotalTaxCollected = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TotalBurned = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.TradingEnabled = *abi.ConvertType(out[3], new(bool)).(*bool)
+	outstruct.SwapEnabled = *abi.ConvertType(out[4], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetStats is a free data retrieval call binding the contract method 0xc59d4847.
+//
+// Solidity: function getStats() view returns(uint256 totalSupply_, uint256 totalTaxCollected_, uint256 totalBurned_, bool tradingEnabled_, bool swapEnabled_)
+func (_MemeToken *MemeTokenSession) GetStats() (struct {
+	TotalSupply       *big.Int
+	TotalTaxCollected *big.Int
+	TotalBurned       *big.Int
+	TradingEnabled    bool
+	SwapEnabled       bool
+}, error) {
+	return _MemeToken.Contract.GetStats(&_MemeToken.CallOpts)
+}
+
+// GetStats is a free data retrieval call binding the contract method 0xc59d4847.
+//
+// Solidity: function getStats() view returns(uint256 totalSupply_, uint256 totalTaxCollected_, uint256 totalBurned_, bool tradingEnabled_, bool swapEnabled_)
+func (_MemeToken *MemeTokenCallerSession) GetStats() (struct {
+	TotalSupply       *big.Int
+	TotalTaxCollected *big.Int
+	TotalBurned       *big.Int
+	TradingEnabled    bool
+	SwapEnabled       bool
+}, error) {
+	return _MemeToken.Contract.GetStats(&_MemeToken.CallOpts)
+}
+
+// GetTaxInfo is a free data retrieval call binding the contract method 0x22a62e1e.
+//
+// Solidity: function getTaxInfo() view returns(uint256 buyTax, uint256 sellTax, uint256 transferTax, uint256 liquidityShare, uint256 marketingShare, uint256 burnShare)
+func (_MemeToken *MemeTokenCaller) GetTaxInfo(opts *bind.CallOpts) (struct {
+	BuyTax         *big.Int
+	SellTax        *big.Int
+	TransferTax    *big.Int
+	LiquidityShare *big.Int
+	MarketingShare *big.Int
+	BurnShare      *big.Int
+}, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "getTaxInfo")
+
+	outstruct := new(struct {
+		BuyTax         *big.Int
+		SellTax        *big.Int
+		TransferTax    *big.Int
+		LiquidityShare *big.Int
+		MarketingShare *big.Int
+		BurnShare      *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.BuyTax = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.SellTax = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TransferTax = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.LiquidityShare = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.MarketingShare = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.BurnShare = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetTaxInfo is a free data retrieval call binding the contract method 0x22a62e1e.
+//
+// Solidity: function getTaxInfo() view returns(uint256 buyTax, uint256 sellTax, uint256 transferTax, uint256 liquidityShare, uint256 marketingShare, uint256 burnShare)
+func (_MemeToken *MemeTokenSession) GetTaxInfo() (struct {
+	BuyTax         *big.Int
+	SellTax        *big.Int
+	TransferTax    *big.Int
+	LiquidityShare *big.Int
+	MarketingShare *big.Int
+	BurnShare      *big.Int
+}, error) {
+	return _MemeToken.Contract.GetTaxInfo(&_MemeToken.CallOpts)
+}
+
+// GetTaxInfo is a free data retrieval call binding the contract method 0x22a62e1e.
+//
+// Solidity: function getTaxInfo() view returns(uint256 buyTax, uint256 sellTax, uint256 transferTax, uint256 liquidityShare, uint256 marketingShare, uint256 burnShare)
+func (_MemeToken *MemeTokenCallerSession) GetTaxInfo() (struct {
+	BuyTax         *big.Int
+	SellTax        *big.Int
+	TransferTax    *big.Int
+	LiquidityShare *big.Int
+	MarketingShare *big.Int
+	BurnShare      *big.Int
+}, error) {
+	return _MemeToken.Contract.GetTaxInfo(&_MemeToken.CallOpts)
+}
+
+// IsAMMPair is a free data retrieval call binding the contract method 0xb0249cc6.
+//
+// Solidity: function isAMMPair(address ) view returns(bool)
+func (_MemeToken *MemeTokenCaller) IsAMMPair(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "isAMMPair", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsAMMPair is a free data retrieval call binding the contract method 0xb0249cc6.
+//
+// Solidity: function isAMMPair(address ) view returns(bool)
+func (_MemeToken *MemeTokenSession) IsAMMPair(arg0 common.Address) (bool, error) {
+	return _MemeToken.Contract.IsAMMPair(&_MemeToken.CallOpts, arg0)
+}
+
+// IsAMMPair is a free data retrieval call binding the contract method 0xb0249cc6.
+//
+// Solidity: function isAMMPair(address ) view returns(bool)
+func (_MemeToken *MemeTokenCallerSession) IsAMMPair(arg0 common.Address) (bool, error) {
+	return _MemeToken.Contract.IsAMMPair(&_MemeToken.CallOpts, arg0)
+}
+
+// IsExcludedFromTax is a free data retrieval call binding the contract method 0xcb4ca631.
+//
+// Solidity: function isExcludedFromTax(address ) view returns(bool)
+func (_MemeToken *MemeTokenCaller) IsExcludedFromTax(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "isExcludedFromTax", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsExcludedFromTax is a free data retrieval call binding the contract method 0xcb4ca631.
+//
+// Solidity: function isExcludedFromTax(address ) view returns(bool)
+func (_MemeToken *MemeTokenSession) IsExcludedFromTax(arg0 common.Address) (bool, error) {
+	return _MemeToken.Contract.IsExcludedFromTax(&_MemeToken.CallOpts, arg0)
+}
+
+// IsExcludedFromTax is a free data retrieval call binding the contract method 0xcb4ca631.
+//
+// Solidity: function isExcludedFromTax(address ) view returns(bool)
+func (_MemeToken *MemeTokenCallerSession) IsExcludedFromTax(arg0 common.Address) (bool, error) {
+	return _MemeToken.Contract.IsExcludedFromTax(&_MemeToken.CallOpts, arg0)
+}
+
+// LiquidityTaxShare is a free data retrieval call binding the contract method 0xbe85dc8d.
+//
+// Solidity: function liquidityTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) LiquidityTaxShare(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "liquidityTaxShare")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LiquidityTaxShare is a free data retrieval call binding the contract method 0xbe85dc8d.
+//
+// Solidity: function liquidityTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenSession) LiquidityTaxShare() (*big.Int, error) {
+	return _MemeToken.Contract.LiquidityTaxShare(&_MemeToken.CallOpts)
+}
+
+// LiquidityTaxShare is a free data retrieval call binding the contract method 0xbe85dc8d.
+//
+// Solidity: function liquidityTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) LiquidityTaxShare() (*big.Int, error) {
+	return _MemeToken.Contract.LiquidityTaxShare(&_MemeToken.CallOpts)
+}
+
+// LiquidityWallet is a free data retrieval call binding the contract method 0xd4698016.
+//
+// Solidity: function liquidityWallet() view returns(address)
+func (_MemeToken *MemeTokenCaller) LiquidityWallet(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "liquidityWallet")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LiquidityWallet is a free data retrieval call binding the contract method 0xd4698016.
+//
+// Solidity: function liquidityWallet() view returns(address)
+func (_MemeToken *MemeTokenSession) LiquidityWallet() (common.Address, error) {
+	return _MemeToken.Contract.LiquidityWallet(&_MemeToken.CallOpts)
+}
+
+// LiquidityWallet is a free data retrieval call binding the contract method 0xd4698016.
+//
+// Solidity: function liquidityWallet() view returns(address)
+func (_MemeToken *MemeTokenCallerSession) LiquidityWallet() (common.Address, error) {
+	return _MemeToken.Contract.LiquidityWallet(&_MemeToken.CallOpts)
+}
+
+// MarketingTaxShare is a free data retrieval call binding the contract method 0x3f583c71.
+//
+// Solidity: function marketingTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) MarketingTaxShare(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "marketingTaxShare")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MarketingTaxShare is a free data retrieval call binding the contract method 0x3f583c71.
+//
+// Solidity: function marketingTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenSession) MarketingTaxShare() (*big.Int, error) {
+	return _MemeToken.Contract.MarketingTaxShare(&_MemeToken.CallOpts)
+}
+
+// MarketingTaxShare is a free data retrieval call binding the contract method 0x3f583c71.
+//
+// Solidity: function marketingTaxShare() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) MarketingTaxShare() (*big.Int, error) {
+	return _MemeToken.Contract.MarketingTaxShare(&_MemeToken.CallOpts)
+}
+
+// MarketingWallet is a free data retrieval call binding the contract method 0x75f0a874.
+//
+// Solidity: function marketingWallet() view returns(address)
+func (_MemeToken *MemeTokenCaller) MarketingWallet(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "marketingWallet")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MarketingWallet is a free data retrieval call binding the contract method 0x75f0a874.
+//
+// Solidity: function marketingWallet() view returns(address)
+func (_MemeToken *MemeTokenSession) MarketingWallet() (common.Address, error) {
+	return _MemeToken.Contract.MarketingWallet(&_MemeToken.CallOpts)
+}
+
+// MarketingWallet is a free data retrieval call binding the contract method 0x75f0a874.
+//
+// Solidity: function marketingWallet() view returns(address)
+func (_MemeToken *MemeTokenCallerSession) MarketingWallet() (common.Address, error) {
+	return _MemeToken.Contract.MarketingWallet(&_MemeToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_MemeToken *MemeTokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_MemeToken *MemeTokenSession) Name() (string, error) {
+	return _MemeToken.Contract.Name(&_MemeToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_MemeToken *MemeTokenCallerSession) Name() (string, error) {
+	return _MemeToken.Contract.Name(&_MemeToken.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_MemeToken *MemeTokenCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_MemeToken *MemeTokenSession) Owner() (common.Address, error) {
+	return _MemeToken.Contract.Owner(&_MemeToken.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_MemeToken *MemeTokenCallerSession) Owner() (common.Address, error) {
+	return _MemeToken.Contract.Owner(&_MemeToken.CallOpts)
+}
+
+// SellTaxRate is a free data retrieval call binding the contract method 0x24024efd.
+//
+// Solidity: function sellTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) SellTaxRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "sellTaxRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SellTaxRate is a free data retrieval call binding the contract method 0x24024efd.
+//
+// Solidity: function sellTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenSession) SellTaxRate() (*big.Int, error) {
+	return _MemeToken.Contract.SellTaxRate(&_MemeToken.CallOpts)
+}
+
+// SellTaxRate is a free data retrieval call binding the contract method 0x24024efd.
+//
+// Solidity: function sellTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) SellTaxRate() (*big.Int, error) {
+	return _MemeToken.Contract.SellTaxRate(&_MemeToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_MemeToken *MemeTokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_MemeToken *MemeTokenSession) Symbol() (string, error) {
+	return _MemeToken.Contract.Symbol(&_MemeToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_MemeToken *MemeTokenCallerSession) Symbol() (string, error) {
+	return _MemeToken.Contract.Symbol(&_MemeToken.CallOpts)
+}
+
+// TotalBurned is a free data retrieval call binding the contract method 0xd89135cd.
+//
+// Solidity: function totalBurned() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) TotalBurned(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "totalBurned")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalBurned is a free data retrieval call binding the contract method 0xd89135cd.
+//
+// Solidity: function totalBurned() view returns(uint256)
+func (_MemeToken *MemeTokenSession) TotalBurned() (*big.Int, error) {
+	return _MemeToken.Contract.TotalBurned(&_MemeToken.CallOpts)
+}
+
+// TotalBurned is a free data retrieval call binding the contract method 0xd89135cd.
+//
+// Solidity: function totalBurned() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) TotalBurned() (*big.Int, error) {
+	return _MemeToken.Contract.TotalBurned(&_MemeToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_MemeToken *MemeTokenSession) TotalSupply() (*big.Int, error) {
+	return _MemeToken.Contract.TotalSupply(&_MemeToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _MemeToken.Contract.TotalSupply(&_MemeToken.CallOpts)
+}
+
+// TotalTaxCollected is a free data retrieval call binding the contract method 0xd8454a82.
+//
+// Solidity: function totalTaxCollected() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) TotalTaxCollected(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "totalTaxCollected")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalTaxCollected is a free data retrieval call binding the contract method 0xd8454a82.
+//
+// Solidity: function totalTaxCollected() view returns(uint256)
+func (_MemeToken *MemeTokenSession) TotalTaxCollected() (*big.Int, error) {
+	return _MemeToken.Contract.TotalTaxCollected(&_MemeToken.CallOpts)
+}
+
+// TotalTaxCollected is a free data retrieval call binding the contract method 0xd8454a82.
+//
+// Solidity: function totalTaxCollected() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) TotalTaxCollected() (*big.Int, error) {
+	return _MemeToken.Contract.TotalTaxCollected(&_MemeToken.CallOpts)
+}
+
+// TransferTaxRate is a free data retrieval call binding the contract method 0xb65d08b0.
+//
+// Solidity: function transferTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenCaller) TransferTaxRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "transferTaxRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferTaxRate is a free data retrieval call binding the contract method 0xb65d08b0.
+//
+// Solidity: function transferTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenSession) TransferTaxRate() (*big.Int, error) {
+	return _MemeToken.Contract.TransferTaxRate(&_MemeToken.CallOpts)
+}
+
+// TransferTaxRate is a free data retrieval call binding the contract method 0xb65d08b0.
+//
+// Solidity: function transferTaxRate() view returns(uint256)
+func (_MemeToken *MemeTokenCallerSession) TransferTaxRate() (*big.Int, error) {
+	return _MemeToken.Contract.TransferTaxRate(&_MemeToken.CallOpts)
+}
+
+// UniswapV2Pair is a free data retrieval call binding the contract method 0x49bd5a5e.
+//
+// Solidity: function uniswapV2Pair() view returns(address)
+func (_MemeToken *MemeTokenCaller) UniswapV2Pair(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MemeToken.contract.Call(opts, &out, "uniswapV2Pair")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// UniswapV2Pair is a free data retrieval call binding the contract method 0x49bd5a5e.
+//
+// Solidity: function uniswapV2Pair() view returns(address)
+func (_MemeToken *MemeTokenSession) UniswapV2Pair() (common.Address, error) {
+	return _MemeToken.Contract.UniswapV2Pair(&_MemeToken.CallOpts)
+}
+
+// UniswapV2Pair is a free data retrieval call binding the contract method 0x49bd5a5e.
+//
+// Solidity: function uniswapV2Pair() view returns(address)
+func (_MemeToken *MemeTokenCallerSession) UniswapV2Pair() (common.Address, error) {
+	return _MemeToken.Contract.UniswapV2Pair(&_MemeToken.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.Contract.Approve(&_MemeToken.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.Contract.Approve(&_MemeToken.TransactOpts, spender, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.Contract.Transfer(&_MemeToken.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.Contract.Transfer(&_MemeToken.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.Contract.TransferFrom(&_MemeToken.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_MemeToken *MemeTokenTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _MemeToken.Contract.TransferFrom(&_MemeToken.TransactOpts, from, to, value)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MemeToken *MemeTokenTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MemeToken.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MemeToken *MemeTokenSession) Receive() (*types.Transaction, error) {
+	return _MemeToken.Contract.Receive(&_MemeToken.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MemeToken *MemeTokenTransactorSession) Receive() (*types.Transaction, error) {
+	return _MemeToken.Contract.Receive(&_MemeToken.TransactOpts)
+}
+
+// MemeTokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the MemeToken contract.
+type MemeTokenApprovalIterator struct {
+	Event *MemeTokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenApproval represents a Approval event raised by the MemeToken contract.
+type MemeTokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_MemeToken *MemeTokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*MemeTokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenApprovalIterator{contract: _MemeToken.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_MemeToken *MemeTokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *MemeTokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenApproval)
+				if err := _MemeToken.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_MemeToken *MemeTokenFilterer) ParseApproval(log types.Log) (*MemeTokenApproval, error) {
+	event := new(MemeTokenApproval)
+	if err := _MemeToken.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenFrequencyParamsUpdatedIterator is returned from FilterFrequencyParamsUpdated and is used to iterate over the raw logs and unpacked data for FrequencyParamsUpdated events raised by the MemeToken contract.
+type MemeTokenFrequencyParamsUpdatedIterator struct {
+	Event *MemeTokenFrequencyParamsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenFrequencyParamsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenFrequencyParamsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenFrequencyParamsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenFrequencyParamsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenFrequencyParamsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenFrequencyParamsUpdated represents a FrequencyParamsUpdated event raised by the MemeToken contract.
+type MemeTokenFrequencyParamsUpdated struct {
+	CooldownEnabled   bool
+	CooldownSeconds   *big.Int
+	DailyLimitEnabled bool
+	MaxDailyTxCount   *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterFrequencyParamsUpdated is a free log retrieval operation binding the contract event 0xcaf66b0e71eb004a5d245b8ed0f70c50a9b8906559ad076ffda630a3168bb735.
+//
+// Solidity: event FrequencyParamsUpdated(bool cooldownEnabled, uint256 cooldownSeconds, bool dailyLimitEnabled, uint256 maxDailyTxCount)
+func (_MemeToken *MemeTokenFilterer) FilterFrequencyParamsUpdated(opts *bind.FilterOpts) (*MemeTokenFrequencyParamsUpdatedIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "FrequencyParamsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenFrequencyParamsUpdatedIterator{contract: _MemeToken.contract, event: "FrequencyParamsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFrequencyParamsUpdated is a free log subscription operation binding the contract event 0xcaf66b0e71eb004a5d245b8ed0f70c50a9b8906559ad076ffda630a3168bb735.
+//
+// Solidity: event FrequencyParamsUpdated(bool cooldownEnabled, uint256 cooldownSeconds, bool dailyLimitEnabled, uint256 maxDailyTxCount)
+func (_MemeToken *MemeTokenFilterer) WatchFrequencyParamsUpdated(opts *bind.WatchOpts, sink chan<- *MemeTokenFrequencyParamsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "FrequencyParamsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenFrequencyParamsUpdated)
+				if err := _MemeToken.contract.UnpackLog(event, "FrequencyParamsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFrequencyParamsUpdated is a log parse operation binding the contract event 0xcaf66b0e71eb004a5d245b8ed0f70c50a9b8906559ad076ffda630a3168bb735.
+//
+// Solidity: event FrequencyParamsUpdated(bool cooldownEnabled, uint256 cooldownSeconds, bool dailyLimitEnabled, uint256 maxDailyTxCount)
+func (_MemeToken *MemeTokenFilterer) ParseFrequencyParamsUpdated(log types.Log) (*MemeTokenFrequencyParamsUpdated, error) {
+	event := new(MemeTokenFrequencyParamsUpdated)
+	if err := _MemeToken.contract.UnpackLog(event, "FrequencyParamsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenLpConfigUpdatedIterator is returned from FilterLpConfigUpdated and is used to iterate over the raw logs and unpacked data for LpConfigUpdated events raised by the MemeToken contract.
+type MemeTokenLpConfigUpdatedIterator struct {
+	Event *MemeTokenLpConfigUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenLpConfigUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenLpConfigUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenLpConfigUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenLpConfigUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenLpConfigUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenLpConfigUpdated represents a LpConfigUpdated event raised by the MemeToken contract.
+type MemeTokenLpConfigUpdated struct {
+	AutoLpEnabled   bool
+	UserLpEnabled   bool
+	SlippagePercent *big.Int
+	DeadlineMinutes *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterLpConfigUpdated is a free log retrieval operation binding the contract event 0xc00153fda4ea76b36bc6caa897443eb5bc9709769695bf0aca277088cf7f9235.
+//
+// Solidity: event LpConfigUpdated(bool autoLpEnabled, bool userLpEnabled, uint256 slippagePercent, uint256 deadlineMinutes)
+func (_MemeToken *MemeTokenFilterer) FilterLpConfigUpdated(opts *bind.FilterOpts) (*MemeTokenLpConfigUpdatedIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "LpConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenLpConfigUpdatedIterator{contract: _MemeToken.contract, event: "LpConfigUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchLpConfigUpdated is a free log subscription operation binding the contract event 0xc00153fda4ea76b36bc6caa897443eb5bc9709769695bf0aca277088cf7f9235.
+//
+// Solidity: event LpConfigUpdated(bool autoLpEnabled, bool userLpEnabled, uint256 slippagePercent, uint256 deadlineMinutes)
+func (_MemeToken *MemeTokenFilterer) WatchLpConfigUpdated(opts *bind.WatchOpts, sink chan<- *MemeTokenLpConfigUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "LpConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenLpConfigUpdated)
+				if err := _MemeToken.contract.UnpackLog(event, "LpConfigUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLpConfigUpdated is a log parse operation binding the contract event 0xc00153fda4ea76b36bc6caa897443eb5bc9709769695bf0aca277088cf7f9235.
+//
+// Solidity: event LpConfigUpdated(bool autoLpEnabled, bool userLpEnabled, uint256 slippagePercent, uint256 deadlineMinutes)
+func (_MemeToken *MemeTokenFilterer) ParseLpConfigUpdated(log types.Log) (*MemeTokenLpConfigUpdated, error) {
+	event := new(MemeTokenLpConfigUpdated)
+	if err := _MemeToken.contract.UnpackLog(event, "LpConfigUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the MemeToken contract.
+type MemeTokenOwnershipTransferredIterator struct {
+	Event *MemeTokenOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenOwnershipTransferred represents a OwnershipTransferred event raised by the MemeToken contract.
+type MemeTokenOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_MemeToken *MemeTokenFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*MemeTokenOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenOwnershipTransferredIterator{contract: _MemeToken.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_MemeToken *MemeTokenFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *MemeTokenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenOwnershipTransferred)
+				if err := _MemeToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_MemeToken *MemeTokenFilterer) ParseOwnershipTransferred(log types.Log) (*MemeTokenOwnershipTransferred, error) {
+	event := new(MemeTokenOwnershipTransferred)
+	if err := _MemeToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenSwapAndLiquifyIterator is returned from FilterSwapAndLiquify and is used to iterate over the raw logs and unpacked data for SwapAndLiquify events raised by the MemeToken contract.
+type MemeTokenSwapAndLiquifyIterator struct {
+	Event *MemeTokenSwapAndLiquify // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenSwapAndLiquifyIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenSwapAndLiquify)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenSwapAndLiquify)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenSwapAndLiquifyIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenSwapAndLiquifyIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenSwapAndLiquify represents a SwapAndLiquify event raised by the MemeToken contract.
+type MemeTokenSwapAndLiquify struct {
+	TokensSwapped       *big.Int
+	EthReceived         *big.Int
+	TokensIntoLiquidity *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterSwapAndLiquify is a free log retrieval operation binding the contract event 0x17bbfb9a6069321b6ded73bd96327c9e6b7212a5cd51ff219cd61370acafb561.
+//
+// Solidity: event SwapAndLiquify(uint256 tokensSwapped, uint256 ethReceived, uint256 tokensIntoLiquidity)
+func (_MemeToken *MemeTokenFilterer) FilterSwapAndLiquify(opts *bind.FilterOpts) (*MemeTokenSwapAndLiquifyIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "SwapAndLiquify")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenSwapAndLiquifyIterator{contract: _MemeToken.contract, event: "SwapAndLiquify", logs: logs, sub: sub}, nil
+}
+
+// WatchSwapAndLiquify is a free log subscription operation binding the contract event 0x17bbfb9a6069321b6ded73bd96327c9e6b7212a5cd51ff219cd61370acafb561.
+//
+// Solidity: event SwapAndLiquify(uint256 tokensSwapped, uint256 ethReceived, uint256 tokensIntoLiquidity)
+func (_MemeToken *MemeTokenFilterer) WatchSwapAndLiquify(opts *bind.WatchOpts, sink chan<- *MemeTokenSwapAndLiquify) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "SwapAndLiquify")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenSwapAndLiquify)
+				if err := _MemeToken.contract.UnpackLog(event, "SwapAndLiquify", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSwapAndLiquify is a log parse operation binding the contract event 0x17bbfb9a6069321b6ded73bd96327c9e6b7212a5cd51ff219cd61370acafb561.
+//
+// Solidity: event SwapAndLiquify(uint256 tokensSwapped, uint256 ethReceived, uint256 tokensIntoLiquidity)
+func (_MemeToken *MemeTokenFilterer) ParseSwapAndLiquify(log types.Log) (*MemeTokenSwapAndLiquify, error) {
+	event := new(MemeTokenSwapAndLiquify)
+	if err := _MemeToken.contract.UnpackLog(event, "SwapAndLiquify", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenTaxCollectedIterator is returned from FilterTaxCollected and is used to iterate over the raw logs and unpacked data for TaxCollected events raised by the MemeToken contract.
+type MemeTokenTaxCollectedIterator struct {
+	Event *MemeTokenTaxCollected // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenTaxCollectedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenTaxCollected)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenTaxCollected)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenTaxCollectedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenTaxCollectedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenTaxCollected represents a TaxCollected event raised by the MemeToken contract.
+type MemeTokenTaxCollected struct {
+	From   common.Address
+	To     common.Address
+	Amount *big.Int
+	Tax    *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTaxCollected is a free log retrieval operation binding the contract event 0xddb30886d90db45adc1b2edcbabe12227896c25143cd155d3bf62ec8eff67856.
+//
+// Solidity: event TaxCollected(address indexed from, address indexed to, uint256 amount, uint256 tax)
+func (_MemeToken *MemeTokenFilterer) FilterTaxCollected(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*MemeTokenTaxCollectedIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "TaxCollected", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTaxCollectedIterator{contract: _MemeToken.contract, event: "TaxCollected", logs: logs, sub: sub}, nil
+}
+
+// WatchTaxCollected is a free log subscription operation binding the contract event 0xddb30886d90db45adc1b2edcbabe12227896c25143cd155d3bf62ec8eff67856.
+//
+// Solidity: event TaxCollected(address indexed from, address indexed to, uint256 amount, uint256 tax)
+func (_MemeToken *MemeTokenFilterer) WatchTaxCollected(opts *bind.WatchOpts, sink chan<- *MemeTokenTaxCollected, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "TaxCollected", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenTaxCollected)
+				if err := _MemeToken.contract.UnpackLog(event, "TaxCollected", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTaxCollected is a log parse operation binding the contract event 0xddb30886d90db45adc1b2edcbabe12227896c25143cd155d3bf62ec8eff67856.
+//
+// Solidity: event TaxCollected(address indexed from, address indexed to, uint256 amount, uint256 tax)
+func (_MemeToken *MemeTokenFilterer) ParseTaxCollected(log types.Log) (*MemeTokenTaxCollected, error) {
+	event := new(MemeTokenTaxCollected)
+	if err := _MemeToken.contract.UnpackLog(event, "TaxCollected", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenTaxDistributionUpdatedIterator is returned from FilterTaxDistributionUpdated and is used to iterate over the raw logs and unpacked data for TaxDistributionUpdated events raised by the MemeToken contract.
+type MemeTokenTaxDistributionUpdatedIterator struct {
+	Event *MemeTokenTaxDistributionUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenTaxDistributionUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenTaxDistributionUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenTaxDistributionUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenTaxDistributionUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenTaxDistributionUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenTaxDistributionUpdated represents a TaxDistributionUpdated event raised by the MemeToken contract.
+type MemeTokenTaxDistributionUpdated struct {
+	Liquidity *big.Int
+	Marketing *big.Int
+	Burn      *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTaxDistributionUpdated is a free log retrieval operation binding the contract event 0x76dd5907ef5de0037bd517b0d9493e4ceb4aa869e476a00b40b55854602c2f21.
+//
+// Solidity: event TaxDistributionUpdated(uint256 liquidity, uint256 marketing, uint256 burn)
+func (_MemeToken *MemeTokenFilterer) FilterTaxDistributionUpdated(opts *bind.FilterOpts) (*MemeTokenTaxDistributionUpdatedIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "TaxDistributionUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTaxDistributionUpdatedIterator{contract: _MemeToken.contract, event: "TaxDistributionUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTaxDistributionUpdated is a free log subscription operation binding the contract event 0x76dd5907ef5de0037bd517b0d9493e4ceb4aa869e476a00b40b55854602c2f21.
+//
+// Solidity: event TaxDistributionUpdated(uint256 liquidity, uint256 marketing, uint256 burn)
+func (_MemeToken *MemeTokenFilterer) WatchTaxDistributionUpdated(opts *bind.WatchOpts, sink chan<- *MemeTokenTaxDistributionUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "TaxDistributionUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenTaxDistributionUpdated)
+				if err := _MemeToken.contract.UnpackLog(event, "TaxDistributionUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTaxDistributionUpdated is a log parse operation binding the contract event 0x76dd5907ef5de0037bd517b0d9493e4ceb4aa869e476a00b40b55854602c2f21.
+//
+// Solidity: event TaxDistributionUpdated(uint256 liquidity, uint256 marketing, uint256 burn)
+func (_MemeToken *MemeTokenFilterer) ParseTaxDistributionUpdated(log types.Log) (*MemeTokenTaxDistributionUpdated, error) {
+	event := new(MemeTokenTaxDistributionUpdated)
+	if err := _MemeToken.contract.UnpackLog(event, "TaxDistributionUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenTaxRatesUpdatedIterator is returned from FilterTaxRatesUpdated and is used to iterate over the raw logs and unpacked data for TaxRatesUpdated events raised by the MemeToken contract.
+type MemeTokenTaxRatesUpdatedIterator struct {
+	Event *MemeTokenTaxRatesUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenTaxRatesUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenTaxRatesUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenTaxRatesUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenTaxRatesUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenTaxRatesUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenTaxRatesUpdated represents a TaxRatesUpdated event raised by the MemeToken contract.
+type MemeTokenTaxRatesUpdated struct {
+	BuyTax      *big.Int
+	SellTax     *big.Int
+	TransferTax *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTaxRatesUpdated is a free log retrieval operation binding the contract event 0xba0348bbe161ef8825a48a9ea42d954e13697dd49fcf5ae8601dfc4be679ef77.
+//
+// Solidity: event TaxRatesUpdated(uint256 buyTax, uint256 sellTax, uint256 transferTax)
+func (_MemeToken *MemeTokenFilterer) FilterTaxRatesUpdated(opts *bind.FilterOpts) (*MemeTokenTaxRatesUpdatedIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "TaxRatesUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTaxRatesUpdatedIterator{contract: _MemeToken.contract, event: "TaxRatesUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTaxRatesUpdated is a free log subscription operation binding the contract event 0xba0348bbe161ef8825a48a9ea42d954e13697dd49fcf5ae8601dfc4be679ef77.
+//
+// Solidity: event TaxRatesUpdated(uint256 buyTax, uint256 sellTax, uint256 transferTax)
+func (_MemeToken *MemeTokenFilterer) WatchTaxRatesUpdated(opts *bind.WatchOpts, sink chan<- *MemeTokenTaxRatesUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "TaxRatesUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenTaxRatesUpdated)
+				if err := _MemeToken.contract.UnpackLog(event, "TaxRatesUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTaxRatesUpdated is a log parse operation binding the contract event 0xba0348bbe161ef8825a48a9ea42d954e13697dd49fcf5ae8601dfc4be679ef77.
+//
+// Solidity: event TaxRatesUpdated(uint256 buyTax, uint256 sellTax, uint256 transferTax)
+func (_MemeToken *MemeTokenFilterer) ParseTaxRatesUpdated(log types.Log) (*MemeTokenTaxRatesUpdated, error) {
+	event := new(MemeTokenTaxRatesUpdated)
+	if err := _MemeToken.contract.UnpackLog(event, "TaxRatesUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenTokensBurnedIterator is returned from FilterTokensBurned and is used to iterate over the raw logs and unpacked data for TokensBurned events raised by the MemeToken contract.
+type MemeTokenTokensBurnedIterator struct {
+	Event *MemeTokenTokensBurned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenTokensBurnedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenTokensBurned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenTokensBurned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenTokensBurnedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenTokensBurnedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenTokensBurned represents a TokensBurned event raised by the MemeToken contract.
+type MemeTokenTokensBurned struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensBurned is a free log retrieval operation binding the contract event 0x6ef4855b666dcc7884561072e4358b28dfe01feb1b7f4dcebc00e62d50394ac7.
+//
+// Solidity: event TokensBurned(uint256 amount)
+func (_MemeToken *MemeTokenFilterer) FilterTokensBurned(opts *bind.FilterOpts) (*MemeTokenTokensBurnedIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "TokensBurned")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTokensBurnedIterator{contract: _MemeToken.contract, event: "TokensBurned", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensBurned is a free log subscription operation binding the contract event 0x6ef4855b666dcc7884561072e4358b28dfe01feb1b7f4dcebc00e62d50394ac7.
+//
+// Solidity: event TokensBurned(uint256 amount)
+func (_MemeToken *MemeTokenFilterer) WatchTokensBurned(opts *bind.WatchOpts, sink chan<- *MemeTokenTokensBurned) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "TokensBurned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenTokensBurned)
+				if err := _MemeToken.contract.UnpackLog(event, "TokensBurned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensBurned is a log parse operation binding the contract event 0x6ef4855b666dcc7884561072e4358b28dfe01feb1b7f4dcebc00e62d50394ac7.
+//
+// Solidity: event TokensBurned(uint256 amount)
+func (_MemeToken *MemeTokenFilterer) ParseTokensBurned(log types.Log) (*MemeTokenTokensBurned, error) {
+	event := new(MemeTokenTokensBurned)
+	if err := _MemeToken.contract.UnpackLog(event, "TokensBurned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenTradingEnabledIterator is returned from FilterTradingEnabled and is used to iterate over the raw logs and unpacked data for TradingEnabled events raised by the MemeToken contract.
+type MemeTokenTradingEnabledIterator struct {
+	Event *MemeTokenTradingEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenTradingEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenTradingEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenTradingEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenTradingEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenTradingEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenTradingEnabled represents a TradingEnabled event raised by the MemeToken contract.
+type MemeTokenTradingEnabled struct {
+	Enabled bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTradingEnabled is a free log retrieval operation binding the contract event 0xbeda7dca7bc1b3e80b871f4818129ec73b771581f803d553aeb3484098e5f65a.
+//
+// Solidity: event TradingEnabled(bool enabled)
+func (_MemeToken *MemeTokenFilterer) FilterTradingEnabled(opts *bind.FilterOpts) (*MemeTokenTradingEnabledIterator, error) {
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "TradingEnabled")
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTradingEnabledIterator{contract: _MemeToken.contract, event: "TradingEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchTradingEnabled is a free log subscription operation binding the contract event 0xbeda7dca7bc1b3e80b871f4818129ec73b771581f803d553aeb3484098e5f65a.
+//
+// Solidity: event TradingEnabled(bool enabled)
+func (_MemeToken *MemeTokenFilterer) WatchTradingEnabled(opts *bind.WatchOpts, sink chan<- *MemeTokenTradingEnabled) (event.Subscription, error) {
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "TradingEnabled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenTradingEnabled)
+				if err := _MemeToken.contract.UnpackLog(event, "TradingEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTradingEnabled is a log parse operation binding the contract event 0xbeda7dca7bc1b3e80b871f4818129ec73b771581f803d553aeb3484098e5f65a.
+//
+// Solidity: event TradingEnabled(bool enabled)
+func (_MemeToken *MemeTokenFilterer) ParseTradingEnabled(log types.Log) (*MemeTokenTradingEnabled, error) {
+	event := new(MemeTokenTradingEnabled)
+	if err := _MemeToken.contract.UnpackLog(event, "TradingEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the MemeToken contract.
+type MemeTokenTransferIterator struct {
+	Event *MemeTokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenTransfer represents a Transfer event raised by the MemeToken contract.
+type MemeTokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_MemeToken *MemeTokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*MemeTokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenTransferIterator{contract: _MemeToken.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_MemeToken *MemeTokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *MemeTokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenTransfer)
+				if err := _MemeToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_MemeToken *MemeTokenFilterer) ParseTransfer(log types.Log) (*MemeTokenTransfer, error) {
+	event := new(MemeTokenTransfer)
+	if err := _MemeToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenUserAddLiquidityIterator is returned from FilterUserAddLiquidity and is used to iterate over the raw logs and unpacked data for UserAddLiquidity events raised by the MemeToken contract.
+type MemeTokenUserAddLiquidityIterator struct {
+	Event *MemeTokenUserAddLiquidity // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenUserAddLiquidityIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenUserAddLiquidity)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenUserAddLiquidity)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenUserAddLiquidityIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenUserAddLiquidityIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenUserAddLiquidity represents a UserAddLiquidity event raised by the MemeToken contract.
+type MemeTokenUserAddLiquidity struct {
+	User        common.Address
+	TokenAmount *big.Int
+	EthAmount   *big.Int
+	LpTokens    *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserAddLiquidity is a free log retrieval operation binding the contract event 0x6f31552d67c30b8cfd896d3b1770d505df1229a326e789a550cec35509c4b564.
+//
+// Solidity: event UserAddLiquidity(address indexed user, uint256 tokenAmount, uint256 ethAmount, uint256 lpTokens)
+func (_MemeToken *MemeTokenFilterer) FilterUserAddLiquidity(opts *bind.FilterOpts, user []common.Address) (*MemeTokenUserAddLiquidityIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "UserAddLiquidity", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenUserAddLiquidityIterator{contract: _MemeToken.contract, event: "UserAddLiquidity", logs: logs, sub: sub}, nil
+}
+
+// WatchUserAddLiquidity is a free log subscription operation binding the contract event 0x6f31552d67c30b8cfd896d3b1770d505df1229a326e789a550cec35509c4b564.
+//
+// Solidity: event UserAddLiquidity(address indexed user, uint256 tokenAmount, uint256 ethAmount, uint256 lpTokens)
+func (_MemeToken *MemeTokenFilterer) WatchUserAddLiquidity(opts *bind.WatchOpts, sink chan<- *MemeTokenUserAddLiquidity, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "UserAddLiquidity", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenUserAddLiquidity)
+				if err := _MemeToken.contract.UnpackLog(event, "UserAddLiquidity", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserAddLiquidity is a log parse operation binding the contract event 0x6f31552d67c30b8cfd896d3b1770d505df1229a326e789a550cec35509c4b564.
+//
+// Solidity: event UserAddLiquidity(address indexed user, uint256 tokenAmount, uint256 ethAmount, uint256 lpTokens)
+func (_MemeToken *MemeTokenFilterer) ParseUserAddLiquidity(log types.Log) (*MemeTokenUserAddLiquidity, error) {
+	event := new(MemeTokenUserAddLiquidity)
+	if err := _MemeToken.contract.UnpackLog(event, "UserAddLiquidity", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MemeTokenUserRemoveLiquidityIterator is returned from FilterUserRemoveLiquidity and is used to iterate over the raw logs and unpacked data for UserRemoveLiquidity events raised by the MemeToken contract.
+type MemeTokenUserRemoveLiquidityIterator struct {
+	Event *MemeTokenUserRemoveLiquidity // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MemeTokenUserRemoveLiquidityIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MemeTokenUserRemoveLiquidity)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MemeTokenUserRemoveLiquidity)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MemeTokenUserRemoveLiquidityIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MemeTokenUserRemoveLiquidityIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MemeTokenUserRemoveLiquidity represents a UserRemoveLiquidity event raised by the MemeToken contract.
+type MemeTokenUserRemoveLiquidity struct {
+	User        common.Address
+	LpTokens    *big.Int
+	TokenAmount *big.Int
+	EthAmount   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterUserRemoveLiquidity is a free log retrieval operation binding the contract event 0xe9dbd46cb87a24950a01836059567ffc99ef33e142f9c564abdf954fa195d4e1.
+//
+// Solidity: event UserRemoveLiquidity(address indexed user, uint256 lpTokens, uint256 tokenAmount, uint256 ethAmount)
+func (_MemeToken *MemeTokenFilterer) FilterUserRemoveLiquidity(opts *bind.FilterOpts, user []common.Address) (*MemeTokenUserRemoveLiquidityIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.FilterLogs(opts, "UserRemoveLiquidity", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MemeTokenUserRemoveLiquidityIterator{contract: _MemeToken.contract, event: "UserRemoveLiquidity", logs: logs, sub: sub}, nil
+}
+
+// WatchUserRemoveLiquidity is a free log subscription operation binding the contract event 0xe9dbd46cb87a24950a01836059567ffc99ef33e142f9c564abdf954fa195d4e1.
+//
+// Solidity: event UserRemoveLiquidity(address indexed user, uint256 lpTokens, uint256 tokenAmount, uint256 ethAmount)
+func (_MemeToken *MemeTokenFilterer) WatchUserRemoveLiquidity(opts *bind.WatchOpts, sink chan<- *MemeTokenUserRemoveLiquidity, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _MemeToken.contract.WatchLogs(opts, "UserRemoveLiquidity", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MemeTokenUserRemoveLiquidity)
+				if err := _MemeToken.contract.UnpackLog(event, "UserRemoveLiquidity", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUserRemoveLiquidity is a log parse operation binding the contract event 0xe9dbd46cb87a24950a01836059567ffc99ef33e142f9c564abdf954fa195d4e1.
+//
+// Solidity: event UserRemoveLiquidity(address indexed user, uint256 lpTokens, uint256 tokenAmount, uint256 ethAmount)
+func (_MemeToken *MemeTokenFilterer) ParseUserRemoveLiquidity(log types.Log) (*MemeTokenUserRemoveLiquidity, error) {
+	event := new(MemeTokenUserRemoveLiquidity)
+	if err := _MemeToken.contract.UnpackLog(event, "UserRemoveLiquidity", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,9 @@
+// Package meme 由meme/contracts/MemeToken.sol的ABI生成的Go绑定
+//
+// 只包含事件、税费和统计相关的查询函数。合约修改后，从Hardhat编译产物中导出ABI并重新生成：
+//
+//	jq .abi ../../../../../meme/artifacts/contracts/MemeToken.sol/MemeToken.json > MemeToken.abi
+//	go generate ./internal/bindings/meme
+package meme
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi MemeToken.abi --pkg meme --type MemeToken --out MemeToken.go
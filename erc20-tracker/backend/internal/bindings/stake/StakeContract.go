@@ -0,0 +1,2927 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package stake
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// StakeContractMetaData contains all meta data concerning the StakeContract contract.
+var StakeContractMetaData = &bind.MetaData{
+	ABI: "[{\"stateMutability\":\"payable\",\"type\":\"receive\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"string\",\"name\":\"operation\",\"type\":\"string\",\"indexed\":false},{\"internalType\":\"bool\",\"name\":\"paused\",\"type\":\"bool\",\"indexed\":false}],\"name\":\"OperationPausedChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":false}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"stTokenAddress\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolWeight\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"minDepositAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"unstakeLockedBlocks\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"PoolAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolWeight\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"minDepositAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"unstakeLockedBlocks\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"PoolUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"RewardClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\",\"indexed\":true}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\",\"indexed\":true}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Staked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"unlockBlock\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"UnstakeRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Unstaked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":false}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\",\"indexed\":true}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_stTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_poolWeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_minDepositAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_unstakeLockedBlocks\",\"type\":\"uint256\"}],\"name\":\"addPool\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"name\":\"claimReward\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"emergencyWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getUserRequest\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unlockBlock\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"getUserRequestsLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_metaNodeToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_metaNodePerBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_startBlock\",\"type\":\"uint256\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"massUpdatePools\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"metaNodePerBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"metaNodeToken\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"operationPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"pendingReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"poolLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"pools\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"stTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"poolWeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastRewardBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"accMetaNodePerST\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"stTokenAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minDepositAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unstakeLockedBlocks\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"requestUnstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_metaNodePerBlock\",\"type\":\"uint256\"}],\"name\":\"setMetaNodePerBlock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_operation\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"_paused\",\"type\":\"bool\"}],\"name\":\"setOperationPaused\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"stake\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"startBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalPoolWeight\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_requestIndex\",\"type\":\"uint256\"}],\"name\":\"unstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"name\":\"updatePool\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_poolWeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_minDepositAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_unstakeLockedBlocks\",\"type\":\"uint256\"}],\"name\":\"updatePool\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"users\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"stAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"finishedMetaNode\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"pendingMetaNode\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StakeContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use StakeContractMetaData.ABI instead.
+var StakeContractABI = StakeContractMetaData.ABI
+
+// StakeContract is an auto generated Go binding around an Ethereum contract.
+type StakeContract struct {
+	StakeContractCaller     // Read-only binding to the contract
+	StakeContractTransactor // Write-only binding to the contract
+	StakeContractFilterer   // Log filterer for contract events
+}
+
+// StakeContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StakeContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakeContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StakeContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakeContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StakeContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakeContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StakeContractSession struct {
+	Contract     *StakeContract    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StakeContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StakeContractCallerSession struct {
+	Contract *StakeContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// StakeContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StakeContractTransactorSession struct {
+	Contract     *StakeContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// StakeContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StakeContractRaw struct {
+	Contract *StakeContract // Generic contract binding to access the raw methods on
+}
+
+// StakeContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StakeContractCallerRaw struct {
+	Contract *StakeContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StakeContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StakeContractTransactorRaw struct {
+	Contract *StakeContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStakeContract creates a new instance of StakeContract, bound to a specific deployed contract.
+func NewStakeContract(address common.Address, backend bind.ContractBackend) (*StakeContract, error) {
+	contract, err := bindStakeContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContract{StakeContractCaller: StakeContractCaller{contract: contract}, StakeContractTransactor: StakeContractTransactor{contract: contract}, StakeContractFilterer: StakeContractFilterer{contract: contract}}, nil
+}
+
+// NewStakeContractCaller creates a new read-only instance of StakeContract, bound to a specific deployed contract.
+func NewStakeContractCaller(address common.Address, caller bind.ContractCaller) (*StakeContractCaller, error) {
+	contract, err := bindStakeContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractCaller{contract: contract}, nil
+}
+
+// NewStakeContractTransactor creates a new write-only instance of StakeContract, bound to a specific deployed contract.
+func NewStakeContractTransactor(address common.Address, transactor bind.ContractTransactor) (*StakeContractTransactor, error) {
+	contract, err := bindStakeContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractTransactor{contract: contract}, nil
+}
+
+// NewStakeContractFilterer creates a new log filterer instance of StakeContract, bound to a specific deployed contract.
+func NewStakeContractFilterer(address common.Address, filterer bind.ContractFilterer) (*StakeContractFilterer, error) {
+	contract, err := bindStakeContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractFilterer{contract: contract}, nil
+}
+
+// bindStakeContract binds a generic wrapper to an already deployed contract.
+func bindStakeContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StakeContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StakeContract *StakeContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StakeContract.Contract.StakeContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StakeContract *StakeContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakeContract.Contract.StakeContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StakeContract *StakeContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StakeContract.Contract.StakeContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StakeContract *StakeContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StakeContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StakeContract *StakeContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakeContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StakeContract *StakeContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StakeContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_StakeContract *StakeContractCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_StakeContract *StakeContractSession) ADMINROLE() ([32]byte, error) {
+	return _StakeContract.Contract.ADMINROLE(&_StakeContract.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_StakeContract *StakeContractCallerSession) ADMINROLE() ([32]byte, error) {
+	return _StakeContract.Contract.ADMINROLE(&_StakeContract.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_StakeContract *StakeContractCaller) UPGRADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "UPGRADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_StakeContract *StakeContractSession) UPGRADERROLE() ([32]byte, error) {
+	return _StakeContract.Contract.UPGRADERROLE(&_StakeContract.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_StakeContract *StakeContractCallerSession) UPGRADERROLE() ([32]byte, error) {
+	return _StakeContract.Contract.UPGRADERROLE(&_StakeContract.CallOpts)
+}
+
+// GetUserRequest is a free data retrieval call binding the contract method 0x20c8a49f.
+//
+// Solidity: function getUserRequest(uint256 _pid, address _user, uint256 _index) view returns(uint256 amount, uint256 unlockBlock)
+func (_StakeContract *StakeContractCaller) GetUserRequest(opts *bind.CallOpts, _pid *big.Int, _user common.Address, _index *big.Int) (struct {
+	Amount      *big.Int
+	UnlockBlock *big.Int
+}, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "getUserRequest", _pid, _user, _index)
+
+	outstruct := new(struct {
+		Amount      *big.Int
+		UnlockBlock *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Amount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.UnlockBlock = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetUserRequest is a free data retrieval call binding the contract method 0x20c8a49f.
+//
+// Solidity: function getUserRequest(uint256 _pid, address _user, uint256 _index) view returns(uint256 amount, uint256 unlockBlock)
+func (_StakeContract *StakeContractSession) GetUserRequest(_pid *big.Int, _user common.Address, _index *big.Int) (struct {
+	Amount      *big.Int
+	UnlockBlock *big.Int
+}, error) {
+	return _StakeContract.Contract.GetUserRequest(&_StakeContract.CallOpts, _pid, _user, _index)
+}
+
+// GetUserRequest is a free data retrieval call binding the contract method 0x20c8a49f.
+//
+// Solidity: function getUserRequest(uint256 _pid, address _user, uint256 _index) view returns(uint256 amount, uint256 unlockBlock)
+func (_StakeContract *StakeContractCallerSession) GetUserRequest(_pid *big.Int, _user common.Address, _index *big.Int) (struct {
+	Amount      *big.Int
+	UnlockBlock *big.Int
+}, error) {
+	return _StakeContract.Contract.GetUserRequest(&_StakeContract.CallOpts, _pid, _user, _index)
+}
+
+// GetUserRequestsLength is a free data retrieval call binding the contract method 0x2fe83db2.
+//
+// Solidity: function getUserRequestsLength(uint256 _pid, address _user) view returns(uint256)
+func (_StakeContract *StakeContractCaller) GetUserRequestsLength(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "getUserRequestsLength", _pid, _user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUserRequestsLength is a free data retrieval call binding the contract method 0x2fe83db2.
+//
+// Solidity: function getUserRequestsLength(uint256 _pid, address _user) view returns(uint256)
+func (_StakeContract *StakeContractSession) GetUserRequestsLength(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _StakeContract.Contract.GetUserRequestsLength(&_StakeContract.CallOpts, _pid, _user)
+}
+
+// GetUserRequestsLength is a free data retrieval call binding the contract method 0x2fe83db2.
+//
+// Solidity: function getUserRequestsLength(uint256 _pid, address _user) view returns(uint256)
+func (_StakeContract *StakeContractCallerSession) GetUserRequestsLength(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _StakeContract.Contract.GetUserRequestsLength(&_StakeContract.CallOpts, _pid, _user)
+}
+
+// MetaNodePerBlock is a free data retrieval call binding the contract method 0x7276d925.
+//
+// Solidity: function metaNodePerBlock() view returns(uint256)
+func (_StakeContract *StakeContractCaller) MetaNodePerBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "metaNodePerBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MetaNodePerBlock is a free data retrieval call binding the contract method 0x7276d925.
+//
+// Solidity: function metaNodePerBlock() view returns(uint256)
+func (_StakeContract *StakeContractSession) MetaNodePerBlock() (*big.Int, error) {
+	return _StakeContract.Contract.MetaNodePerBlock(&_StakeContract.CallOpts)
+}
+
+// MetaNodePerBlock is a free data retrieval call binding the contract method 0x7276d925.
+//
+// Solidity: function metaNodePerBlock() view returns(uint256)
+func (_StakeContract *StakeContractCallerSession) MetaNodePerBlock() (*big.Int, error) {
+	return _StakeContract.Contract.MetaNodePerBlock(&_StakeContract.CallOpts)
+}
+
+// MetaNodeToken is a free data retrieval call binding the contract method 0xfeccaba5.
+//
+// Solidity: function metaNodeToken() view returns(address)
+func (_StakeContract *StakeContractCaller) MetaNodeToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "metaNodeToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MetaNodeToken is a free data retrieval call binding the contract method 0xfeccaba5.
+//
+// Solidity: function metaNodeToken() view returns(address)
+func (_StakeContract *StakeContractSession) MetaNodeToken() (common.Address, error) {
+	return _StakeContract.Contract.MetaNodeToken(&_StakeContract.CallOpts)
+}
+
+// MetaNodeToken is a free data retrieval call binding the contract method 0xfeccaba5.
+//
+// Solidity: function metaNodeToken() view returns(address)
+func (_StakeContract *StakeContractCallerSession) MetaNodeToken() (common.Address, error) {
+	return _StakeContract.Contract.MetaNodeToken(&_StakeContract.CallOpts)
+}
+
+// OperationPaused is a free data retrieval call binding the contract method 0xc5620ed5.
+//
+// Solidity: function operationPaused(string ) view returns(bool)
+func (_StakeContract *StakeContractCaller) OperationPaused(opts *bind.CallOpts, arg0 string) (bool, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "operationPaused", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// OperationPaused is a free data retrieval call binding the contract method 0xc5620ed5.
+//
+// Solidity: function operationPaused(string ) view returns(bool)
+func (_StakeContract *StakeContractSession) OperationPaused(arg0 string) (bool, error) {
+	return _StakeContract.Contract.OperationPaused(&_StakeContract.CallOpts, arg0)
+}
+
+// OperationPaused is a free data retrieval call binding the contract method 0xc5620ed5.
+//
+// Solidity: function operationPaused(string ) view returns(bool)
+func (_StakeContract *StakeContractCallerSession) OperationPaused(arg0 string) (bool, error) {
+	return _StakeContract.Contract.OperationPaused(&_StakeContract.CallOpts, arg0)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_StakeContract *StakeContractCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_StakeContract *StakeContractSession) Paused() (bool, error) {
+	return _StakeContract.Contract.Paused(&_StakeContract.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_StakeContract *StakeContractCallerSession) Paused() (bool, error) {
+	return _StakeContract.Contract.Paused(&_StakeContract.CallOpts)
+}
+
+// PendingReward is a free data retrieval call binding the contract method 0x98969e82.
+//
+// Solidity: function pendingReward(uint256 _pid, address _user) view returns(uint256)
+func (_StakeContract *StakeContractCaller) PendingReward(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "pendingReward", _pid, _user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingReward is a free data retrieval call binding the contract method 0x98969e82.
+//
+// Solidity: function pendingReward(uint256 _pid, address _user) view returns(uint256)
+func (_StakeContract *StakeContractSession) PendingReward(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _StakeContract.Contract.PendingReward(&_StakeContract.CallOpts, _pid, _user)
+}
+
+// PendingReward is a free data retrieval call binding the contract method 0x98969e82.
+//
+// Solidity: function pendingReward(uint256 _pid, address _user) view returns(uint256)
+func (_StakeContract *StakeContractCallerSession) PendingReward(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _StakeContract.Contract.PendingReward(&_StakeContract.CallOpts, _pid, _user)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_StakeContract *StakeContractCaller) PoolLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "poolLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_StakeContract *StakeContractSession) PoolLength() (*big.Int, error) {
+	return _StakeContract.Contract.PoolLength(&_StakeContract.CallOpts)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_StakeContract *StakeContractCallerSession) PoolLength() (*big.Int, error) {
+	return _StakeContract.Contract.PoolLength(&_StakeContract.CallOpts)
+}
+
+// Pools is a free data retrieval call binding the contract method 0xac4afa38.
+//
+// Solidity: function pools(uint256 ) view returns(address stTokenAddress, uint256 poolWeight, uint256 lastRewardBlock, uint256 accMetaNodePerST, uint256 stTokenAmount, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractCaller) Pools(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	AccMetaNodePerST    *big.Int
+	StTokenAmount       *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+}, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "pools", arg0)
+
+	outstruct := new(struct {
+		StTokenAddress      common.Address
+		PoolWeight          *big.Int
+		LastRewardBlock     *big.Int
+		AccMetaNodePerST    *big.Int
+		StTokenAmount       *big.Int
+		MinDepositAmount    *big.Int
+		UnstakeLockedBlocks *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StTokenAddress = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.PoolWeight = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.LastRewardBlock = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.AccMetaNodePerST = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.StTokenAmount = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.MinDepositAmount = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.UnstakeLockedBlocks = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Pools is a free data retrieval call binding the contract method 0xac4afa38.
+//
+// Solidity: function pools(uint256 ) view returns(address stTokenAddress, uint256 poolWeight, uint256 lastRewardBlock, uint256 accMetaNodePerST, uint256 stTokenAmount, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractSession) Pools(arg0 *big.Int) (struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	AccMetaNodePerST    *big.Int
+	StTokenAmount       *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+}, error) {
+	return _StakeContract.Contract.Pools(&_StakeContract.CallOpts, arg0)
+}
+
+// Pools is a free data retrieval call binding the contract method 0xac4afa38.
+//
+// Solidity: function pools(uint256 ) view returns(address stTokenAddress, uint256 poolWeight, uint256 lastRewardBlock, uint256 accMetaNodePerST, uint256 stTokenAmount, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractCallerSession) Pools(arg0 *big.Int) (struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	AccMetaNodePerST    *big.Int
+	StTokenAmount       *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+}, error) {
+	return _StakeContract.Contract.Pools(&_StakeContract.CallOpts, arg0)
+}
+
+// StartBlock is a free data retrieval call binding the contract method 0x48cd4cb1.
+//
+// Solidity: function startBlock() view returns(uint256)
+func (_StakeContract *StakeContractCaller) StartBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "startBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StartBlock is a free data retrieval call binding the contract method 0x48cd4cb1.
+//
+// Solidity: function startBlock() view returns(uint256)
+func (_StakeContract *StakeContractSession) StartBlock() (*big.Int, error) {
+	return _StakeContract.Contract.StartBlock(&_StakeContract.CallOpts)
+}
+
+// StartBlock is a free data retrieval call binding the contract method 0x48cd4cb1.
+//
+// Solidity: function startBlock() view returns(uint256)
+func (_StakeContract *StakeContractCallerSession) StartBlock() (*big.Int, error) {
+	return _StakeContract.Contract.StartBlock(&_StakeContract.CallOpts)
+}
+
+// TotalPoolWeight is a free data retrieval call binding the contract method 0x02559004.
+//
+// Solidity: function totalPoolWeight() view returns(uint256)
+func (_StakeContract *StakeContractCaller) TotalPoolWeight(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "totalPoolWeight")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalPoolWeight is a free data retrieval call binding the contract method 0x02559004.
+//
+// Solidity: function totalPoolWeight() view returns(uint256)
+func (_StakeContract *StakeContractSession) TotalPoolWeight() (*big.Int, error) {
+	return _StakeContract.Contract.TotalPoolWeight(&_StakeContract.CallOpts)
+}
+
+// TotalPoolWeight is a free data retrieval call binding the contract method 0x02559004.
+//
+// Solidity: function totalPoolWeight() view returns(uint256)
+func (_StakeContract *StakeContractCallerSession) TotalPoolWeight() (*big.Int, error) {
+	return _StakeContract.Contract.TotalPoolWeight(&_StakeContract.CallOpts)
+}
+
+// Users is a free data retrieval call binding the contract method 0xb9d02df4.
+//
+// Solidity: function users(uint256 , address ) view returns(uint256 stAmount, uint256 finishedMetaNode, uint256 pendingMetaNode)
+func (_StakeContract *StakeContractCaller) Users(opts *bind.CallOpts, arg0 *big.Int, arg1 common.Address) (struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}, error) {
+	var out []interface{}
+	err := _StakeContract.contract.Call(opts, &out, "users", arg0, arg1)
+
+	outstruct := new(struct {
+		StAmount         *big.Int
+		FinishedMetaNode *big.Int
+		PendingMetaNode  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StAmount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.FinishedMetaNode = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.PendingMetaNode = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Users is a free data retrieval call binding the contract method 0xb9d02df4.
+//
+// Solidity: function users(uint256 , address ) view returns(uint256 stAmount, uint256 finishedMetaNode, uint256 pendingMetaNode)
+func (_StakeContract *StakeContractSession) Users(arg0 *big.Int, arg1 common.Address) (struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}, error) {
+	return _StakeContract.Contract.Users(&_StakeContract.CallOpts, arg0, arg1)
+}
+
+// Users is a free data retrieval call binding the contract method 0xb9d02df4.
+//
+// Solidity: function users(uint256 , address ) view returns(uint256 stAmount, uint256 finishedMetaNode, uint256 pendingMetaNode)
+func (_StakeContract *StakeContractCallerSession) Users(arg0 *big.Int, arg1 common.Address) (struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}, error) {
+	return _StakeContract.Contract.Users(&_StakeContract.CallOpts, arg0, arg1)
+}
+
+// AddPool is a paid mutator transaction binding the contract method 0x5a4ec0ca.
+//
+// Solidity: function addPool(address _stTokenAddress, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_StakeContract *StakeContractTransactor) AddPool(opts *bind.TransactOpts, _stTokenAddress common.Address, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "addPool", _stTokenAddress, _poolWeight, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// AddPool is a paid mutator transaction binding the contract method 0x5a4ec0ca.
+//
+// Solidity: function addPool(address _stTokenAddress, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_StakeContract *StakeContractSession) AddPool(_stTokenAddress common.Address, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.AddPool(&_StakeContract.TransactOpts, _stTokenAddress, _poolWeight, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// AddPool is a paid mutator transaction binding the contract method 0x5a4ec0ca.
+//
+// Solidity: function addPool(address _stTokenAddress, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_StakeContract *StakeContractTransactorSession) AddPool(_stTokenAddress common.Address, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.AddPool(&_StakeContract.TransactOpts, _stTokenAddress, _poolWeight, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// ClaimReward is a paid mutator transaction binding the contract method 0xae169a50.
+//
+// Solidity: function claimReward(uint256 _pid) returns()
+func (_StakeContract *StakeContractTransactor) ClaimReward(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "claimReward", _pid)
+}
+
+// ClaimReward is a paid mutator transaction binding the contract method 0xae169a50.
+//
+// Solidity: function claimReward(uint256 _pid) returns()
+func (_StakeContract *StakeContractSession) ClaimReward(_pid *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.ClaimReward(&_StakeContract.TransactOpts, _pid)
+}
+
+// ClaimReward is a paid mutator transaction binding the contract method 0xae169a50.
+//
+// Solidity: function claimReward(uint256 _pid) returns()
+func (_StakeContract *StakeContractTransactorSession) ClaimReward(_pid *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.ClaimReward(&_StakeContract.TransactOpts, _pid)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address _token, uint256 _amount) returns()
+func (_StakeContract *StakeContractTransactor) EmergencyWithdraw(opts *bind.TransactOpts, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "emergencyWithdraw", _token, _amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address _token, uint256 _amount) returns()
+func (_StakeContract *StakeContractSession) EmergencyWithdraw(_token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.EmergencyWithdraw(&_StakeContract.TransactOpts, _token, _amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address _token, uint256 _amount) returns()
+func (_StakeContract *StakeContractTransactorSession) EmergencyWithdraw(_token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.EmergencyWithdraw(&_StakeContract.TransactOpts, _token, _amount)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7a1ac61e.
+//
+// Solidity: function initialize(address _metaNodeToken, uint256 _metaNodePerBlock, uint256 _startBlock) returns()
+func (_StakeContract *StakeContractTransactor) Initialize(opts *bind.TransactOpts, _metaNodeToken common.Address, _metaNodePerBlock *big.Int, _startBlock *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "initialize", _metaNodeToken, _metaNodePerBlock, _startBlock)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7a1ac61e.
+//
+// Solidity: function initialize(address _metaNodeToken, uint256 _metaNodePerBlock, uint256 _startBlock) returns()
+func (_StakeContract *StakeContractSession) Initialize(_metaNodeToken common.Address, _metaNodePerBlock *big.Int, _startBlock *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.Initialize(&_StakeContract.TransactOpts, _metaNodeToken, _metaNodePerBlock, _startBlock)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7a1ac61e.
+//
+// Solidity: function initialize(address _metaNodeToken, uint256 _metaNodePerBlock, uint256 _startBlock) returns()
+func (_StakeContract *StakeContractTransactorSession) Initialize(_metaNodeToken common.Address, _metaNodePerBlock *big.Int, _startBlock *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.Initialize(&_StakeContract.TransactOpts, _metaNodeToken, _metaNodePerBlock, _startBlock)
+}
+
+// MassUpdatePools is a paid mutator transaction binding the contract method 0x630b5ba1.
+//
+// Solidity: function massUpdatePools() returns()
+func (_StakeContract *StakeContractTransactor) MassUpdatePools(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "massUpdatePools")
+}
+
+// MassUpdatePools is a paid mutator transaction binding the contract method 0x630b5ba1.
+//
+// Solidity: function massUpdatePools() returns()
+func (_StakeContract *StakeContractSession) MassUpdatePools() (*types.Transaction, error) {
+	return _StakeContract.Contract.MassUpdatePools(&_StakeContract.TransactOpts)
+}
+
+// MassUpdatePools is a paid mutator transaction binding the contract method 0x630b5ba1.
+//
+// Solidity: function massUpdatePools() returns()
+func (_StakeContract *StakeContractTransactorSession) MassUpdatePools() (*types.Transaction, error) {
+	return _StakeContract.Contract.MassUpdatePools(&_StakeContract.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_StakeContract *StakeContractTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_StakeContract *StakeContractSession) Pause() (*types.Transaction, error) {
+	return _StakeContract.Contract.Pause(&_StakeContract.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_StakeContract *StakeContractTransactorSession) Pause() (*types.Transaction, error) {
+	return _StakeContract.Contract.Pause(&_StakeContract.TransactOpts)
+}
+
+// RequestUnstake is a paid mutator transaction binding the contract method 0x68d4341f.
+//
+// Solidity: function requestUnstake(uint256 _pid, uint256 _amount) returns()
+func (_StakeContract *StakeContractTransactor) RequestUnstake(opts *bind.TransactOpts, _pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "requestUnstake", _pid, _amount)
+}
+
+// RequestUnstake is a paid mutator transaction binding the contract method 0x68d4341f.
+//
+// Solidity: function requestUnstake(uint256 _pid, uint256 _amount) returns()
+func (_StakeContract *StakeContractSession) RequestUnstake(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.RequestUnstake(&_StakeContract.TransactOpts, _pid, _amount)
+}
+
+// RequestUnstake is a paid mutator transaction binding the contract method 0x68d4341f.
+//
+// Solidity: function requestUnstake(uint256 _pid, uint256 _amount) returns()
+func (_StakeContract *StakeContractTransactorSession) RequestUnstake(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.RequestUnstake(&_StakeContract.TransactOpts, _pid, _amount)
+}
+
+// SetMetaNodePerBlock is a paid mutator transaction binding the contract method 0xd7bc1769.
+//
+// Solidity: function setMetaNodePerBlock(uint256 _metaNodePerBlock) returns()
+func (_StakeContract *StakeContractTransactor) SetMetaNodePerBlock(opts *bind.TransactOpts, _metaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "setMetaNodePerBlock", _metaNodePerBlock)
+}
+
+// SetMetaNodePerBlock is a paid mutator transaction binding the contract method 0xd7bc1769.
+//
+// Solidity: function setMetaNodePerBlock(uint256 _metaNodePerBlock) returns()
+func (_StakeContract *StakeContractSession) SetMetaNodePerBlock(_metaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.SetMetaNodePerBlock(&_StakeContract.TransactOpts, _metaNodePerBlock)
+}
+
+// SetMetaNodePerBlock is a paid mutator transaction binding the contract method 0xd7bc1769.
+//
+// Solidity: function setMetaNodePerBlock(uint256 _metaNodePerBlock) returns()
+func (_StakeContract *StakeContractTransactorSession) SetMetaNodePerBlock(_metaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.SetMetaNodePerBlock(&_StakeContract.TransactOpts, _metaNodePerBlock)
+}
+
+// SetOperationPaused is a paid mutator transaction binding the contract method 0x99446925.
+//
+// Solidity: function setOperationPaused(string _operation, bool _paused) returns()
+func (_StakeContract *StakeContractTransactor) SetOperationPaused(opts *bind.TransactOpts, _operation string, _paused bool) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "setOperationPaused", _operation, _paused)
+}
+
+// SetOperationPaused is a paid mutator transaction binding the contract method 0x99446925.
+//
+// Solidity: function setOperationPaused(string _operation, bool _paused) returns()
+func (_StakeContract *StakeContractSession) SetOperationPaused(_operation string, _paused bool) (*types.Transaction, error) {
+	return _StakeContract.Contract.SetOperationPaused(&_StakeContract.TransactOpts, _operation, _paused)
+}
+
+// SetOperationPaused is a paid mutator transaction binding the contract method 0x99446925.
+//
+// Solidity: function setOperationPaused(string _operation, bool _paused) returns()
+func (_StakeContract *StakeContractTransactorSession) SetOperationPaused(_operation string, _paused bool) (*types.Transaction, error) {
+	return _StakeContract.Contract.SetOperationPaused(&_StakeContract.TransactOpts, _operation, _paused)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x7b0472f0.
+//
+// Solidity: function stake(uint256 _pid, uint256 _amount) payable returns()
+func (_StakeContract *StakeContractTransactor) Stake(opts *bind.TransactOpts, _pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "stake", _pid, _amount)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x7b0472f0.
+//
+// Solidity: function stake(uint256 _pid, uint256 _amount) payable returns()
+func (_StakeContract *StakeContractSession) Stake(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.Stake(&_StakeContract.TransactOpts, _pid, _amount)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x7b0472f0.
+//
+// Solidity: function stake(uint256 _pid, uint256 _amount) payable returns()
+func (_StakeContract *StakeContractTransactorSession) Stake(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.Stake(&_StakeContract.TransactOpts, _pid, _amount)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_StakeContract *StakeContractTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_StakeContract *StakeContractSession) Unpause() (*types.Transaction, error) {
+	return _StakeContract.Contract.Unpause(&_StakeContract.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_StakeContract *StakeContractTransactorSession) Unpause() (*types.Transaction, error) {
+	return _StakeContract.Contract.Unpause(&_StakeContract.TransactOpts)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0x9e2c8a5b.
+//
+// Solidity: function unstake(uint256 _pid, uint256 _requestIndex) returns()
+func (_StakeContract *StakeContractTransactor) Unstake(opts *bind.TransactOpts, _pid *big.Int, _requestIndex *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "unstake", _pid, _requestIndex)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0x9e2c8a5b.
+//
+// Solidity: function unstake(uint256 _pid, uint256 _requestIndex) returns()
+func (_StakeContract *StakeContractSession) Unstake(_pid *big.Int, _requestIndex *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.Unstake(&_StakeContract.TransactOpts, _pid, _requestIndex)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0x9e2c8a5b.
+//
+// Solidity: function unstake(uint256 _pid, uint256 _requestIndex) returns()
+func (_StakeContract *StakeContractTransactorSession) Unstake(_pid *big.Int, _requestIndex *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.Unstake(&_StakeContract.TransactOpts, _pid, _requestIndex)
+}
+
+// UpdatePool is a paid mutator transaction binding the contract method 0x51eb05a6.
+//
+// Solidity: function updatePool(uint256 _pid) returns()
+func (_StakeContract *StakeContractTransactor) UpdatePool(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "updatePool", _pid)
+}
+
+// UpdatePool is a paid mutator transaction binding the contract method 0x51eb05a6.
+//
+// Solidity: function updatePool(uint256 _pid) returns()
+func (_StakeContract *StakeContractSession) UpdatePool(_pid *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.UpdatePool(&_StakeContract.TransactOpts, _pid)
+}
+
+// UpdatePool is a paid mutator transaction binding the contract method 0x51eb05a6.
+//
+// Solidity: function updatePool(uint256 _pid) returns()
+func (_StakeContract *StakeContractTransactorSession) UpdatePool(_pid *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.UpdatePool(&_StakeContract.TransactOpts, _pid)
+}
+
+// UpdatePool0 is a paid mutator transaction binding the contract method 0x27acce41.
+//
+// Solidity: function updatePool(uint256 _pid, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_StakeContract *StakeContractTransactor) UpdatePool0(opts *bind.TransactOpts, _pid *big.Int, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _StakeContract.contract.Transact(opts, "updatePool0", _pid, _poolWeight, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// UpdatePool0 is a paid mutator transaction binding the contract method 0x27acce41.
+//
+// Solidity: function updatePool(uint256 _pid, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_StakeContract *StakeContractSession) UpdatePool0(_pid *big.Int, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.UpdatePool0(&_StakeContract.TransactOpts, _pid, _poolWeight, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// UpdatePool0 is a paid mutator transaction binding the contract method 0x27acce41.
+//
+// Solidity: function updatePool(uint256 _pid, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_StakeContract *StakeContractTransactorSession) UpdatePool0(_pid *big.Int, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _StakeContract.Contract.UpdatePool0(&_StakeContract.TransactOpts, _pid, _poolWeight, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_StakeContract *StakeContractTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakeContract.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_StakeContract *StakeContractSession) Receive() (*types.Transaction, error) {
+	return _StakeContract.Contract.Receive(&_StakeContract.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_StakeContract *StakeContractTransactorSession) Receive() (*types.Transaction, error) {
+	return _StakeContract.Contract.Receive(&_StakeContract.TransactOpts)
+}
+
+// StakeContractInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the StakeContract contract.
+type StakeContractInitializedIterator struct {
+	Event *StakeContractInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractInitialized represents a Initialized event raised by the StakeContract contract.
+type StakeContractInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_StakeContract *StakeContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*StakeContractInitializedIterator, error) {
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractInitializedIterator{contract: _StakeContract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_StakeContract *StakeContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *StakeContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractInitialized)
+				if err := _StakeContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_StakeContract *StakeContractFilterer) ParseInitialized(log types.Log) (*StakeContractInitialized, error) {
+	event := new(StakeContractInitialized)
+	if err := _StakeContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractOperationPausedChangedIterator is returned from FilterOperationPausedChanged and is used to iterate over the raw logs and unpacked data for OperationPausedChanged events raised by the StakeContract contract.
+type StakeContractOperationPausedChangedIterator struct {
+	Event *StakeContractOperationPausedChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractOperationPausedChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractOperationPausedChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractOperationPausedChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractOperationPausedChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractOperationPausedChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractOperationPausedChanged represents a OperationPausedChanged event raised by the StakeContract contract.
+type StakeContractOperationPausedChanged struct {
+	Operation string
+	Paused    bool
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperationPausedChanged is a free log retrieval operation binding the contract event 0xa49b2f10d7a0d4490229c20acd2f3a389b73f6225b46320dc80a2322adb5f121.
+//
+// Solidity: event OperationPausedChanged(string operation, bool paused)
+func (_StakeContract *StakeContractFilterer) FilterOperationPausedChanged(opts *bind.FilterOpts) (*StakeContractOperationPausedChangedIterator, error) {
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "OperationPausedChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractOperationPausedChangedIterator{contract: _StakeContract.contract, event: "OperationPausedChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchOperationPausedChanged is a free log subscription operation binding the contract event 0xa49b2f10d7a0d4490229c20acd2f3a389b73f6225b46320dc80a2322adb5f121.
+//
+// Solidity: event OperationPausedChanged(string operation, bool paused)
+func (_StakeContract *StakeContractFilterer) WatchOperationPausedChanged(opts *bind.WatchOpts, sink chan<- *StakeContractOperationPausedChanged) (event.Subscription, error) {
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "OperationPausedChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractOperationPausedChanged)
+				if err := _StakeContract.contract.UnpackLog(event, "OperationPausedChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperationPausedChanged is a log parse operation binding the contract event 0xa49b2f10d7a0d4490229c20acd2f3a389b73f6225b46320dc80a2322adb5f121.
+//
+// Solidity: event OperationPausedChanged(string operation, bool paused)
+func (_StakeContract *StakeContractFilterer) ParseOperationPausedChanged(log types.Log) (*StakeContractOperationPausedChanged, error) {
+	event := new(StakeContractOperationPausedChanged)
+	if err := _StakeContract.contract.UnpackLog(event, "OperationPausedChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the StakeContract contract.
+type StakeContractPausedIterator struct {
+	Event *StakeContractPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractPaused represents a Paused event raised by the StakeContract contract.
+type StakeContractPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_StakeContract *StakeContractFilterer) FilterPaused(opts *bind.FilterOpts) (*StakeContractPausedIterator, error) {
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractPausedIterator{contract: _StakeContract.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_StakeContract *StakeContractFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *StakeContractPaused) (event.Subscription, error) {
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractPaused)
+				if err := _StakeContract.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_StakeContract *StakeContractFilterer) ParsePaused(log types.Log) (*StakeContractPaused, error) {
+	event := new(StakeContractPaused)
+	if err := _StakeContract.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractPoolAddedIterator is returned from FilterPoolAdded and is used to iterate over the raw logs and unpacked data for PoolAdded events raised by the StakeContract contract.
+type StakeContractPoolAddedIterator struct {
+	Event *StakeContractPoolAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractPoolAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractPoolAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractPoolAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractPoolAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractPoolAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractPoolAdded represents a PoolAdded event raised by the StakeContract contract.
+type StakeContractPoolAdded struct {
+	Pid                 *big.Int
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterPoolAdded is a free log retrieval operation binding the contract event 0xdcad60f82308d160bd8a3814cf84788a2c98294718485b86d238d14cbf166dab.
+//
+// Solidity: event PoolAdded(uint256 indexed pid, address indexed stTokenAddress, uint256 poolWeight, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractFilterer) FilterPoolAdded(opts *bind.FilterOpts, pid []*big.Int, stTokenAddress []common.Address) (*StakeContractPoolAddedIterator, error) {
+
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+	var stTokenAddressRule []interface{}
+	for _, stTokenAddressItem := range stTokenAddress {
+		stTokenAddressRule = append(stTokenAddressRule, stTokenAddressItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "PoolAdded", pidRule, stTokenAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractPoolAddedIterator{contract: _StakeContract.contract, event: "PoolAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchPoolAdded is a free log subscription operation binding the contract event 0xdcad60f82308d160bd8a3814cf84788a2c98294718485b86d238d14cbf166dab.
+//
+// Solidity: event PoolAdded(uint256 indexed pid, address indexed stTokenAddress, uint256 poolWeight, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractFilterer) WatchPoolAdded(opts *bind.WatchOpts, sink chan<- *StakeContractPoolAdded, pid []*big.Int, stTokenAddress []common.Address) (event.Subscription, error) {
+
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+	var stTokenAddressRule []interface{}
+	for _, stTokenAddressItem := range stTokenAddress {
+		stTokenAddressRule = append(stTokenAddressRule, stTokenAddressItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "PoolAdded", pidRule, stTokenAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractPoolAdded)
+				if err := _StakeContract.contract.UnpackLog(event, "PoolAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePoolAdded is a log parse operation binding the contract event 0xdcad60f82308d160bd8a3814cf84788a2c98294718485b86d238d14cbf166dab.
+//
+// Solidity: event PoolAdded(uint256 indexed pid, address indexed stTokenAddress, uint256 poolWeight, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractFilterer) ParsePoolAdded(log types.Log) (*StakeContractPoolAdded, error) {
+	event := new(StakeContractPoolAdded)
+	if err := _StakeContract.contract.UnpackLog(event, "PoolAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractPoolUpdatedIterator is returned from FilterPoolUpdated and is used to iterate over the raw logs and unpacked data for PoolUpdated events raised by the StakeContract contract.
+type StakeContractPoolUpdatedIterator struct {
+	Event *StakeContractPoolUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractPoolUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractPoolUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractPoolUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractPoolUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractPoolUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractPoolUpdated represents a PoolUpdated event raised by the StakeContract contract.
+type StakeContractPoolUpdated struct {
+	Pid                 *big.Int
+	PoolWeight          *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterPoolUpdated is a free log retrieval operation binding the contract event 0xb0a2ded49817748754bcca0474b24011f01d4574dd5c40e14197ffa2e6540fef.
+//
+// Solidity: event PoolUpdated(uint256 indexed pid, uint256 poolWeight, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractFilterer) FilterPoolUpdated(opts *bind.FilterOpts, pid []*big.Int) (*StakeContractPoolUpdatedIterator, error) {
+
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "PoolUpdated", pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractPoolUpdatedIterator{contract: _StakeContract.contract, event: "PoolUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPoolUpdated is a free log subscription operation binding the contract event 0xb0a2ded49817748754bcca0474b24011f01d4574dd5c40e14197ffa2e6540fef.
+//
+// Solidity: event PoolUpdated(uint256 indexed pid, uint256 poolWeight, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractFilterer) WatchPoolUpdated(opts *bind.WatchOpts, sink chan<- *StakeContractPoolUpdated, pid []*big.Int) (event.Subscription, error) {
+
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "PoolUpdated", pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractPoolUpdated)
+				if err := _StakeContract.contract.UnpackLog(event, "PoolUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePoolUpdated is a log parse operation binding the contract event 0xb0a2ded49817748754bcca0474b24011f01d4574dd5c40e14197ffa2e6540fef.
+//
+// Solidity: event PoolUpdated(uint256 indexed pid, uint256 poolWeight, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_StakeContract *StakeContractFilterer) ParsePoolUpdated(log types.Log) (*StakeContractPoolUpdated, error) {
+	event := new(StakeContractPoolUpdated)
+	if err := _StakeContract.contract.UnpackLog(event, "PoolUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractRewardClaimedIterator is returned from FilterRewardClaimed and is used to iterate over the raw logs and unpacked data for RewardClaimed events raised by the StakeContract contract.
+type StakeContractRewardClaimedIterator struct {
+	Event *StakeContractRewardClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractRewardClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractRewardClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractRewardClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractRewardClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractRewardClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractRewardClaimed represents a RewardClaimed event raised by the StakeContract contract.
+type StakeContractRewardClaimed struct {
+	User   common.Address
+	Pid    *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardClaimed is a free log retrieval operation binding the contract event 0xf01da32686223933d8a18a391060918c7f11a3648639edd87ae013e2e2731743.
+//
+// Solidity: event RewardClaimed(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) FilterRewardClaimed(opts *bind.FilterOpts, user []common.Address, pid []*big.Int) (*StakeContractRewardClaimedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "RewardClaimed", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractRewardClaimedIterator{contract: _StakeContract.contract, event: "RewardClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardClaimed is a free log subscription operation binding the contract event 0xf01da32686223933d8a18a391060918c7f11a3648639edd87ae013e2e2731743.
+//
+// Solidity: event RewardClaimed(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) WatchRewardClaimed(opts *bind.WatchOpts, sink chan<- *StakeContractRewardClaimed, user []common.Address, pid []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "RewardClaimed", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractRewardClaimed)
+				if err := _StakeContract.contract.UnpackLog(event, "RewardClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardClaimed is a log parse operation binding the contract event 0xf01da32686223933d8a18a391060918c7f11a3648639edd87ae013e2e2731743.
+//
+// Solidity: event RewardClaimed(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) ParseRewardClaimed(log types.Log) (*StakeContractRewardClaimed, error) {
+	event := new(StakeContractRewardClaimed)
+	if err := _StakeContract.contract.UnpackLog(event, "RewardClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the StakeContract contract.
+type StakeContractRoleGrantedIterator struct {
+	Event *StakeContractRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractRoleGranted represents a RoleGranted event raised by the StakeContract contract.
+type StakeContractRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_StakeContract *StakeContractFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*StakeContractRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractRoleGrantedIterator{contract: _StakeContract.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_StakeContract *StakeContractFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *StakeContractRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractRoleGranted)
+				if err := _StakeContract.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_StakeContract *StakeContractFilterer) ParseRoleGranted(log types.Log) (*StakeContractRoleGranted, error) {
+	event := new(StakeContractRoleGranted)
+	if err := _StakeContract.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the StakeContract contract.
+type StakeContractRoleRevokedIterator struct {
+	Event *StakeContractRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractRoleRevoked represents a RoleRevoked event raised by the StakeContract contract.
+type StakeContractRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_StakeContract *StakeContractFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*StakeContractRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractRoleRevokedIterator{contract: _StakeContract.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_StakeContract *StakeContractFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *StakeContractRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractRoleRevoked)
+				if err := _StakeContract.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_StakeContract *StakeContractFilterer) ParseRoleRevoked(log types.Log) (*StakeContractRoleRevoked, error) {
+	event := new(StakeContractRoleRevoked)
+	if err := _StakeContract.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractStakedIterator is returned from FilterStaked and is used to iterate over the raw logs and unpacked data for Staked events raised by the StakeContract contract.
+type StakeContractStakedIterator struct {
+	Event *StakeContractStaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractStakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractStaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractStaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractStakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractStakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractStaked represents a Staked event raised by the StakeContract contract.
+type StakeContractStaked struct {
+	User   common.Address
+	Pid    *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterStaked is a free log retrieval operation binding the contract event 0x1449c6dd7851abc30abf37f57715f492010519147cc2652fbc38202c18a6ee90.
+//
+// Solidity: event Staked(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) FilterStaked(opts *bind.FilterOpts, user []common.Address, pid []*big.Int) (*StakeContractStakedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "Staked", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractStakedIterator{contract: _StakeContract.contract, event: "Staked", logs: logs, sub: sub}, nil
+}
+
+// WatchStaked is a free log subscription operation binding the contract event 0x1449c6dd7851abc30abf37f57715f492010519147cc2652fbc38202c18a6ee90.
+//
+// Solidity: event Staked(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) WatchStaked(opts *bind.WatchOpts, sink chan<- *StakeContractStaked, user []common.Address, pid []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "Staked", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractStaked)
+				if err := _StakeContract.contract.UnpackLog(event, "Staked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStaked is a log parse operation binding the contract event 0x1449c6dd7851abc30abf37f57715f492010519147cc2652fbc38202c18a6ee90.
+//
+// Solidity: event Staked(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) ParseStaked(log types.Log) (*StakeContractStaked, error) {
+	event := new(StakeContractStaked)
+	if err := _StakeContract.contract.UnpackLog(event, "Staked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the StakeContract contract.
+type StakeContractUnpausedIterator struct {
+	Event *StakeContractUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractUnpaused represents a Unpaused event raised by the StakeContract contract.
+type StakeContractUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_StakeContract *StakeContractFilterer) FilterUnpaused(opts *bind.FilterOpts) (*StakeContractUnpausedIterator, error) {
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractUnpausedIterator{contract: _StakeContract.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_StakeContract *StakeContractFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *StakeContractUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractUnpaused)
+				if err := _StakeContract.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_StakeContract *StakeContractFilterer) ParseUnpaused(log types.Log) (*StakeContractUnpaused, error) {
+	event := new(StakeContractUnpaused)
+	if err := _StakeContract.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractUnstakeRequestedIterator is returned from FilterUnstakeRequested and is used to iterate over the raw logs and unpacked data for UnstakeRequested events raised by the StakeContract contract.
+type StakeContractUnstakeRequestedIterator struct {
+	Event *StakeContractUnstakeRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractUnstakeRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractUnstakeRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractUnstakeRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractUnstakeRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractUnstakeRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractUnstakeRequested represents a UnstakeRequested event raised by the StakeContract contract.
+type StakeContractUnstakeRequested struct {
+	User        common.Address
+	Pid         *big.Int
+	Amount      *big.Int
+	UnlockBlock *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnstakeRequested is a free log retrieval operation binding the contract event 0x6930caaa0f0843978bf16992d58b9fd54913ce2e45b8acdd34f5b44f95419db2.
+//
+// Solidity: event UnstakeRequested(address indexed user, uint256 indexed pid, uint256 amount, uint256 unlockBlock)
+func (_StakeContract *StakeContractFilterer) FilterUnstakeRequested(opts *bind.FilterOpts, user []common.Address, pid []*big.Int) (*StakeContractUnstakeRequestedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "UnstakeRequested", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractUnstakeRequestedIterator{contract: _StakeContract.contract, event: "UnstakeRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchUnstakeRequested is a free log subscription operation binding the contract event 0x6930caaa0f0843978bf16992d58b9fd54913ce2e45b8acdd34f5b44f95419db2.
+//
+// Solidity: event UnstakeRequested(address indexed user, uint256 indexed pid, uint256 amount, uint256 unlockBlock)
+func (_StakeContract *StakeContractFilterer) WatchUnstakeRequested(opts *bind.WatchOpts, sink chan<- *StakeContractUnstakeRequested, user []common.Address, pid []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "UnstakeRequested", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractUnstakeRequested)
+				if err := _StakeContract.contract.UnpackLog(event, "UnstakeRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnstakeRequested is a log parse operation binding the contract event 0x6930caaa0f0843978bf16992d58b9fd54913ce2e45b8acdd34f5b44f95419db2.
+//
+// Solidity: event UnstakeRequested(address indexed user, uint256 indexed pid, uint256 amount, uint256 unlockBlock)
+func (_StakeContract *StakeContractFilterer) ParseUnstakeRequested(log types.Log) (*StakeContractUnstakeRequested, error) {
+	event := new(StakeContractUnstakeRequested)
+	if err := _StakeContract.contract.UnpackLog(event, "UnstakeRequested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractUnstakedIterator is returned from FilterUnstaked and is used to iterate over the raw logs and unpacked data for Unstaked events raised by the StakeContract contract.
+type StakeContractUnstakedIterator struct {
+	Event *StakeContractUnstaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractUnstakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractUnstaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractUnstaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractUnstakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractUnstakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractUnstaked represents a Unstaked event raised by the StakeContract contract.
+type StakeContractUnstaked struct {
+	User   common.Address
+	Pid    *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnstaked is a free log retrieval operation binding the contract event 0x7fc4727e062e336010f2c282598ef5f14facb3de68cf8195c2f23e1454b2b74e.
+//
+// Solidity: event Unstaked(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) FilterUnstaked(opts *bind.FilterOpts, user []common.Address, pid []*big.Int) (*StakeContractUnstakedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "Unstaked", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractUnstakedIterator{contract: _StakeContract.contract, event: "Unstaked", logs: logs, sub: sub}, nil
+}
+
+// WatchUnstaked is a free log subscription operation binding the contract event 0x7fc4727e062e336010f2c282598ef5f14facb3de68cf8195c2f23e1454b2b74e.
+//
+// Solidity: event Unstaked(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) WatchUnstaked(opts *bind.WatchOpts, sink chan<- *StakeContractUnstaked, user []common.Address, pid []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "Unstaked", userRule, pidRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractUnstaked)
+				if err := _StakeContract.contract.UnpackLog(event, "Unstaked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnstaked is a log parse operation binding the contract event 0x7fc4727e062e336010f2c282598ef5f14facb3de68cf8195c2f23e1454b2b74e.
+//
+// Solidity: event Unstaked(address indexed user, uint256 indexed pid, uint256 amount)
+func (_StakeContract *StakeContractFilterer) ParseUnstaked(log types.Log) (*StakeContractUnstaked, error) {
+	event := new(StakeContractUnstaked)
+	if err := _StakeContract.contract.UnpackLog(event, "Unstaked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakeContractUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the StakeContract contract.
+type StakeContractUpgradedIterator struct {
+	Event *StakeContractUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakeContractUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakeContractUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakeContractUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakeContractUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakeContractUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakeContractUpgraded represents a Upgraded event raised by the StakeContract contract.
+type StakeContractUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StakeContract *StakeContractFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*StakeContractUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeContractUpgradedIterator{contract: _StakeContract.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StakeContract *StakeContractFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *StakeContractUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _StakeContract.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakeContractUpgraded)
+				if err := _StakeContract.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StakeContract *StakeContractFilterer) ParseUpgraded(log types.Log) (*StakeContractUpgraded, error) {
+	event := new(StakeContractUpgraded)
+	if err := _StakeContract.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,9 @@
+// Package stake 由stake/contracts/StakeContract.sol的ABI生成的Go绑定
+//
+// 合约修改后，从Hardhat编译产物中导出ABI并重新生成：
+//
+//	jq .abi ../../../../../stake/artifacts/contracts/StakeContract.sol/StakeContract.json > StakeContract.abi
+//	go generate ./internal/bindings/stake
+package stake
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi StakeContract.abi --pkg stake --type StakeContract --out StakeContract.go
@@ -0,0 +1,10 @@
+// Package uniswapv2 由Uniswap V2的UniswapV2Pair和UniswapV2Factory接口ABI生成的Go绑定
+//
+// 只包含索引需要的事件和查询函数，ABI与v2-core的IUniswapV2Pair.sol、IUniswapV2Factory.sol一致。
+// 修改ABI后重新生成：
+//
+//	go generate ./internal/bindings/uniswapv2
+package uniswapv2
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi UniswapV2Pair.abi --pkg uniswapv2 --type UniswapV2Pair --out UniswapV2Pair.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi UniswapV2Factory.abi --pkg uniswapv2 --type UniswapV2Factory --out UniswapV2Factory.go
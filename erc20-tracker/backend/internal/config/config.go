@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -24,6 +25,33 @@ type Config struct {
 	// 日志配置
 	Logging LoggingConfig `json:"logging"`
 
+	// 余额对账配置
+	Reconcile ReconcileConfig `json:"reconcile"`
+
+	// 告警配置
+	Alert AlertConfig `json:"alert"`
+
+	// 物化快照配置
+	Snapshot SnapshotConfig `json:"snapshot"`
+
+	// 查询API配置
+	API APIConfig `json:"api"`
+
+	// 持有人分析配置
+	Analytics AnalyticsConfig `json:"analytics"`
+
+	// Webhook通知配置
+	Webhook WebhookConfig `json:"webhook"`
+
+	// 事件流输出配置
+	Stream StreamConfig `json:"stream"`
+
+	// 事件解码配置
+	Decoder DecoderConfig `json:"decoder"`
+
+	// NFT拍卖索引配置
+	Auction AuctionConfig `json:"auction"`
+
 	// 时区配置
 	Timezone string `json:"timezone"`
 }
@@ -47,6 +75,34 @@ type ChainConfig struct {
 	StartBlock      uint64 `json:"start_block"`
 	Enabled         bool   `json:"enabled"`
 	Timezone        string `json:"timezone"`
+
+	// 额外跟踪的合约地址，其事件按ABI通用解码后保存，不影响代币余额
+	TrackedContracts []string `json:"tracked_contracts"`
+
+	// 质押合约（StakeContract）地址，为空时不索引质押事件
+	StakeContract string `json:"stake_contract"`
+
+	// 拍卖工厂（AuctionFactory）地址，工厂部署的拍卖合约会被自动发现并索引
+	AuctionFactory string `json:"auction_factory"`
+	// 不经工厂部署、需要单独索引的NFTAuction合约地址
+	AuctionContracts []string `json:"auction_contracts"`
+
+	// 捐赠合约（BeggingContract）地址，为空时不索引捐赠事件
+	BeggingContract string `json:"begging_contract"`
+
+	// 代币合约是否为带转账税的MemeToken，开启后索引税费事件并把税费转账归到付税方
+	TaxToken bool `json:"tax_token"`
+
+	// Uniswap V2工厂地址，监听其PairCreated发现代币与计价代币的交易对
+	UniswapV2Factory string `json:"uniswap_v2_factory"`
+	// 不是由上面的工厂创建的交易对地址，工厂创建的交易对启动时通过getPair查找
+	UniswapV2Pairs []string `json:"uniswap_v2_pairs"`
+	// 计价代币（通常是WETH）地址和精度，价格以每个代币值多少计价代币表示
+	UniswapV2QuoteToken    string `json:"uniswap_v2_quote_token"`
+	UniswapV2QuoteDecimals int    `json:"uniswap_v2_quote_decimals"`
+
+	// 积分资金池地址（质押合约或交易对），其持有的代币按份额计入受益人的积分，资金池地址本身不计积分
+	PointsVaults []string `json:"points_vaults"`
 }
 
 // SystemConfig 系统配置
@@ -68,6 +124,67 @@ type LoggingConfig struct {
 	Compress bool   `json:"compress"`
 }
 
+// ReconcileConfig 余额对账配置
+type ReconcileConfig struct {
+	Enabled    bool          `json:"enabled"`
+	Interval   time.Duration `json:"interval"`
+	SampleSize int           `json:"sample_size"` // 每轮抽样地址数，0表示全量扫描
+	AutoHeal   bool          `json:"auto_heal"`   // 是否自动写入修正记录
+}
+
+// AlertConfig 告警配置
+type AlertConfig struct {
+	WebhookURL string        `json:"webhook_url"` // 为空时只写日志
+	Timeout    time.Duration `json:"timeout"`
+}
+
+// SnapshotConfig 物化快照配置
+type SnapshotConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+}
+
+// APIConfig 查询API配置
+type APIConfig struct {
+	Enabled bool   `json:"enabled"`
+	Addr    string `json:"addr"`
+}
+
+// AnalyticsConfig 持有人分析配置
+type AnalyticsConfig struct {
+	RollupEnabled  bool          `json:"rollup_enabled"`  // 是否定时汇总每日统计
+	RollupInterval time.Duration `json:"rollup_interval"` // 汇总间隔
+}
+
+// WebhookConfig Webhook通知配置
+type WebhookConfig struct {
+	Enabled        bool          `json:"enabled"`
+	PollInterval   time.Duration `json:"poll_interval"`   // 发件箱轮询间隔
+	BatchSize      int           `json:"batch_size"`      // 每轮最多投递数
+	Timeout        time.Duration `json:"timeout"`         // 单次请求超时
+	MaxAttempts    int           `json:"max_attempts"`    // 超过后转入死信
+	RetryDelay     time.Duration `json:"retry_delay"`     // 指数退避的基础延迟
+	StallThreshold time.Duration `json:"stall_threshold"` // 同步状态超过该时长未更新视为停滞
+}
+
+// StreamConfig 事件流输出配置
+type StreamConfig struct {
+	Sinks     []string `json:"sinks"`      // 启用的输出类型，为空时不发布
+	JSONLPath string   `json:"jsonl_path"` // jsonl输出的文件路径
+}
+
+// DecoderConfig 事件解码配置
+type DecoderConfig struct {
+	ABIPaths []string `json:"abi_paths"` // ABI文件或目录（支持Hardhat、Foundry编译产物），在内置ABI之后加载
+}
+
+// AuctionConfig NFT拍卖索引配置
+type AuctionConfig struct {
+	// 平台手续费率（基数10000），用于估算成交时的平台费和卖家所得
+	// 合约修改费率时不发出事件，费率变化后需同步修改此配置
+	PlatformFeeRate int64 `json:"platform_fee_rate"`
+}
+
 // LoadConfig 加载配置
 func LoadConfig() (*Config, error) {
 	// 加载.env文件
@@ -93,6 +210,20 @@ func LoadConfig() (*Config, error) {
 				ContractAddress: getEnv("SEPOLIA_CONTRACT_ADDRESS", ""),
 				StartBlock:      getEnvAsUint64("SEPOLIA_START_BLOCK", 0),
 				Enabled:         getEnv("SEPOLIA_CONTRACT_ADDRESS", "") != "",
+
+				TrackedContracts: getEnvAsList("SEPOLIA_TRACKED_CONTRACTS"),
+				StakeContract:    getEnv("SEPOLIA_STAKE_CONTRACT_ADDRESS", ""),
+				AuctionFactory:   getEnv("SEPOLIA_AUCTION_FACTORY_ADDRESS", ""),
+				AuctionContracts: getEnvAsList("SEPOLIA_AUCTION_CONTRACTS"),
+				BeggingContract:  getEnv("SEPOLIA_BEGGING_CONTRACT_ADDRESS", ""),
+				TaxToken:         getEnvAsBool("SEPOLIA_TAX_TOKEN", false),
+
+				UniswapV2Factory:       getEnv("SEPOLIA_UNISWAP_V2_FACTORY", ""),
+				UniswapV2Pairs:         getEnvAsList("SEPOLIA_UNISWAP_V2_PAIRS"),
+				UniswapV2QuoteToken:    getEnv("SEPOLIA_UNISWAP_V2_QUOTE_TOKEN", ""),
+				UniswapV2QuoteDecimals: getEnvAsInt("SEPOLIA_UNISWAP_V2_QUOTE_DECIMALS", 18),
+
+				PointsVaults: getEnvAsList("SEPOLIA_POINTS_VAULTS"),
 			},
 			{
 				Name:            "Base Sepolia",
@@ -101,6 +232,20 @@ func LoadConfig() (*Config, error) {
 				ContractAddress: getEnv("BASE_SEPOLIA_CONTRACT_ADDRESS", ""),
 				StartBlock:      getEnvAsUint64("BASE_SEPOLIA_START_BLOCK", 0),
 				Enabled:         getEnv("BASE_SEPOLIA_CONTRACT_ADDRESS", "") != "",
+
+				TrackedContracts: getEnvAsList("BASE_SEPOLIA_TRACKED_CONTRACTS"),
+				StakeContract:    getEnv("BASE_SEPOLIA_STAKE_CONTRACT_ADDRESS", ""),
+				AuctionFactory:   getEnv("BASE_SEPOLIA_AUCTION_FACTORY_ADDRESS", ""),
+				AuctionContracts: getEnvAsList("BASE_SEPOLIA_AUCTION_CONTRACTS"),
+				BeggingContract:  getEnv("BASE_SEPOLIA_BEGGING_CONTRACT_ADDRESS", ""),
+				TaxToken:         getEnvAsBool("BASE_SEPOLIA_TAX_TOKEN", false),
+
+				UniswapV2Factory:       getEnv("BASE_SEPOLIA_UNISWAP_V2_FACTORY", ""),
+				UniswapV2Pairs:         getEnvAsList("BASE_SEPOLIA_UNISWAP_V2_PAIRS"),
+				UniswapV2QuoteToken:    getEnv("BASE_SEPOLIA_UNISWAP_V2_QUOTE_TOKEN", ""),
+				UniswapV2QuoteDecimals: getEnvAsInt("BASE_SEPOLIA_UNISWAP_V2_QUOTE_DECIMALS", 18),
+
+				PointsVaults: getEnvAsList("BASE_SEPOLIA_POINTS_VAULTS"),
 			},
 		},
 		System: SystemConfig{
@@ -118,6 +263,47 @@ func LoadConfig() (*Config, error) {
 			MaxAge:   getEnvAsInt("LOG_MAX_AGE", 30),
 			Compress: getEnvAsBool("LOG_COMPRESS", true),
 		},
+		Reconcile: ReconcileConfig{
+			Enabled:    getEnvAsBool("RECONCILE_ENABLED", false),
+			Interval:   getEnvAsDuration("RECONCILE_INTERVAL", "1h"),
+			SampleSize: getEnvAsInt("RECONCILE_SAMPLE_SIZE", 100),
+			AutoHeal:   getEnvAsBool("RECONCILE_AUTO_HEAL", false),
+		},
+		Alert: AlertConfig{
+			WebhookURL: getEnv("ALERT_WEBHOOK_URL", ""),
+			Timeout:    getEnvAsDuration("ALERT_TIMEOUT", "5s"),
+		},
+		Snapshot: SnapshotConfig{
+			Enabled:  getEnvAsBool("SNAPSHOT_ENABLED", false),
+			Interval: getEnvAsDuration("SNAPSHOT_INTERVAL", "24h"),
+		},
+		API: APIConfig{
+			Enabled: getEnvAsBool("API_ENABLED", false),
+			Addr:    getEnv("API_ADDR", ":8080"),
+		},
+		Webhook: WebhookConfig{
+			Enabled:        getEnvAsBool("WEBHOOK_ENABLED", false),
+			PollInterval:   getEnvAsDuration("WEBHOOK_POLL_INTERVAL", "5s"),
+			BatchSize:      getEnvAsInt("WEBHOOK_BATCH_SIZE", 100),
+			Timeout:        getEnvAsDuration("WEBHOOK_TIMEOUT", "10s"),
+			MaxAttempts:    getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			RetryDelay:     getEnvAsDuration("WEBHOOK_RETRY_DELAY", "30s"),
+			StallThreshold: getEnvAsDuration("WEBHOOK_STALL_THRESHOLD", "15m"),
+		},
+		Stream: StreamConfig{
+			Sinks:     getEnvAsList("STREAM_SINKS"),
+			JSONLPath: getEnv("STREAM_JSONL_PATH", "./data/stream.jsonl"),
+		},
+		Decoder: DecoderConfig{
+			ABIPaths: getEnvAsList("ABI_PATHS"),
+		},
+		Auction: AuctionConfig{
+			PlatformFeeRate: int64(getEnvAsInt("AUCTION_PLATFORM_FEE_RATE", 250)),
+		},
+		Analytics: AnalyticsConfig{
+			RollupEnabled:  getEnvAsBool("ANALYTICS_ROLLUP_ENABLED", false),
+			RollupInterval: getEnvAsDuration("ANALYTICS_ROLLUP_INTERVAL", "1h"),
+		},
 		Timezone: getEnv("TIMEZONE", "Asia/Shanghai"),
 	}
 
@@ -152,6 +338,17 @@ func (c *Config) Validate() error {
 			if chain.ContractAddress == "" {
 				return fmt.Errorf("链 %s 的合约地址不能为空", chain.Name)
 			}
+			if (chain.UniswapV2Factory != "" || len(chain.UniswapV2Pairs) > 0) && chain.UniswapV2QuoteToken == "" {
+				return fmt.Errorf("链 %s 配置了Uniswap V2交易对时必须配置计价代币地址", chain.Name)
+			}
+			if chain.UniswapV2QuoteDecimals < 0 || chain.UniswapV2QuoteDecimals > 36 {
+				return fmt.Errorf("链 %s 的计价代币精度必须在0到36之间", chain.Name)
+			}
+			for _, vault := range chain.PointsVaults {
+				if !strings.EqualFold(vault, chain.StakeContract) && chain.UniswapV2QuoteToken == "" {
+					return fmt.Errorf("链 %s 的积分资金池 %s 既不是质押合约，也没有配置Uniswap V2交易对索引", chain.Name, vault)
+				}
+			}
 			enabledChains++
 		}
 	}
@@ -167,6 +364,39 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("最大重试次数必须大于0")
 	}
 
+	// 验证对账配置
+	if c.Reconcile.Enabled && c.Reconcile.Interval <= 0 {
+		return fmt.Errorf("对账间隔必须大于0")
+	}
+	if c.Reconcile.SampleSize < 0 {
+		return fmt.Errorf("对账抽样数量不能为负数")
+	}
+
+	// 验证快照配置
+	if c.Snapshot.Enabled && c.Snapshot.Interval <= 0 {
+		return fmt.Errorf("快照间隔必须大于0")
+	}
+
+	// 验证分析配置
+	if c.Analytics.RollupEnabled && c.Analytics.RollupInterval <= 0 {
+		return fmt.Errorf("每日汇总间隔必须大于0")
+	}
+
+	// 验证Webhook配置
+	if c.Webhook.Enabled {
+		if c.Webhook.PollInterval <= 0 || c.Webhook.BatchSize < 1 {
+			return fmt.Errorf("Webhook轮询间隔和批量大小必须大于0")
+		}
+		if c.Webhook.MaxAttempts < 1 {
+			return fmt.Errorf("Webhook最大投递次数必须大于0")
+		}
+	}
+
+	// 验证拍卖配置
+	if c.Auction.PlatformFeeRate < 0 || c.Auction.PlatformFeeRate > 10000 {
+		return fmt.Errorf("拍卖平台手续费率必须在0到10000之间")
+	}
+
 	return nil
 }
 
@@ -197,6 +427,17 @@ func (c *Config) GetEnabledChains() []ChainConfig {
 	return enabled
 }
 
+// FindChain 按链名称（忽略大小写）或链ID查找已启用的链配置
+func (c *Config) FindChain(key string) (*ChainConfig, error) {
+	key = strings.TrimSpace(key)
+	for _, chain := range c.GetEnabledChains() {
+		if strings.EqualFold(chain.Name, key) || strconv.FormatInt(chain.ChainID, 10) == key {
+			return &chain, nil
+		}
+	}
+	return nil, fmt.Errorf("未找到已启用的链: %s", key)
+}
+
 // 辅助函数
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -244,3 +485,13 @@ func getEnvAsDuration(key, defaultValue string) time.Duration {
 	// 最后的备用值
 	return time.Hour
 }
+
+func getEnvAsList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,290 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 拍卖状态
+const (
+	AuctionStatusActive    = "active"
+	AuctionStatusEnded     = "ended"
+	AuctionStatusCancelled = "cancelled"
+)
+
+// 出价状态
+const (
+	BidStatusActive   = "active"   // 当前最高出价
+	BidStatusOutbid   = "outbid"   // 被更高出价超过，资金已退还
+	BidStatusWon      = "won"      // 拍卖成交
+	BidStatusRefunded = "refunded" // 拍卖结束但未达保留价，资金已退还
+)
+
+// AuctionContract 拍卖合约（工厂部署的或单独配置的NFTAuction）
+type AuctionContract struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_auction_contract,unique" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_auction_contract,unique" json:"contract_address"`
+	FactoryAddress  string    `gorm:"type:varchar(42);not null;default:''" json:"factory_address,omitempty"` // 单独配置的合约为空
+	FactoryID       uint64    `gorm:"not null;default:0" json:"factory_id"`                                  // 工厂中的合约ID
+	Creator         string    `gorm:"type:varchar(42);not null;default:''" json:"creator,omitempty"`
+	Name            string    `gorm:"type:varchar(255);not null;default:''" json:"name"`
+	Description     string    `gorm:"type:text" json:"description"`
+	Active          bool      `gorm:"not null" json:"active"`
+	DeployedBlock   uint64    `gorm:"not null;default:0" json:"deployed_block"`
+	DeployedTxHash  string    `gorm:"type:varchar(66);not null;default:''" json:"deployed_tx_hash,omitempty"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (AuctionContract) TableName() string {
+	return "auction_contracts"
+}
+
+// Auction 拍卖，价格以美元计（18位精度），出价数量为出价代币的原始精度
+type Auction struct {
+	ID              uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64      `gorm:"not null;index:idx_auction,unique;index:idx_auction_nft" json:"chain_id"`
+	ContractAddress string     `gorm:"type:varchar(42);not null;index:idx_auction,unique" json:"contract_address"`
+	AuctionID       uint64     `gorm:"not null;index:idx_auction,unique" json:"auction_id"`
+	Seller          string     `gorm:"type:varchar(42);not null;index" json:"seller"`
+	NFTContract     string     `gorm:"column:nft_contract;type:varchar(42);not null;index:idx_auction_nft" json:"nft_contract"`
+	TokenID         string     `gorm:"type:varchar(78);not null;index:idx_auction_nft" json:"token_id"`
+	StartPriceUSD   string     `gorm:"column:start_price_usd;type:decimal(65,0);not null;default:0" json:"start_price_usd"`
+	ReservePriceUSD string     `gorm:"column:reserve_price_usd;type:decimal(65,0);not null;default:0" json:"reserve_price_usd"`
+	StartTime       time.Time  `gorm:"not null" json:"start_time"`
+	EndTime         time.Time  `gorm:"not null" json:"end_time"`
+	Status          string     `gorm:"type:varchar(16);not null;index" json:"status"`
+	BidCount        int64      `gorm:"not null;default:0" json:"bid_count"`
+	HighestBidder   string     `gorm:"type:varchar(42);not null;default:''" json:"highest_bidder,omitempty"`
+	HighestBidUSD   string     `gorm:"column:highest_bid_usd;type:decimal(65,0);not null;default:0" json:"highest_bid_usd"`
+	BidToken        string     `gorm:"type:varchar(42);not null;default:''" json:"bid_token,omitempty"` // 零地址表示ETH
+	BidAmount       string     `gorm:"type:decimal(65,0);not null;default:0" json:"bid_amount"`
+	Sold            bool       `gorm:"not null;default:false" json:"sold"`
+	Winner          string     `gorm:"type:varchar(42);not null;default:'';index" json:"winner,omitempty"`
+	PlatformFee     string     `gorm:"type:decimal(65,0);not null;default:0" json:"platform_fee"`    // 按配置的费率估算，出价代币精度
+	SellerProceeds  string     `gorm:"type:decimal(65,0);not null;default:0" json:"seller_proceeds"` // 卖家所得，出价代币精度
+	CreatedBlock    uint64     `gorm:"not null" json:"created_block"`
+	CreatedTxHash   string     `gorm:"type:varchar(66);not null;default:''" json:"created_tx_hash"`
+	EndedBlock      uint64     `json:"ended_block,omitempty"`
+	EndedTxHash     string     `gorm:"type:varchar(66)" json:"ended_tx_hash,omitempty"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Auction) TableName() string {
+	return "auctions"
+}
+
+// AuctionBid 出价记录
+type AuctionBid struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_auction_bid_auction" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_auction_bid_auction" json:"contract_address"`
+	AuctionID       uint64    `gorm:"not null;index:idx_auction_bid_auction" json:"auction_id"`
+	Bidder          string    `gorm:"type:varchar(42);not null;index" json:"bidder"`
+	BidUSD          string    `gorm:"column:bid_usd;type:decimal(65,0);not null" json:"bid_usd"`
+	BidToken        string    `gorm:"type:varchar(42);not null" json:"bid_token"` // 零地址表示ETH
+	BidAmount       string    `gorm:"type:decimal(65,0);not null" json:"bid_amount"`
+	Status          string    `gorm:"type:varchar(16);not null" json:"status"`
+	TxHash          string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null" json:"timestamp"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (AuctionBid) TableName() string {
+	return "auction_bids"
+}
+
+// AuctionFilter 拍卖查询条件，零值字段不参与过滤
+type AuctionFilter struct {
+	ChainID         int64
+	ContractAddress string
+	Seller          string
+	Winner          string
+	NFTContract     string
+	TokenID         string
+	Status          string
+	Limit           int
+}
+
+// AuctionBidFilter 出价查询条件，零值字段不参与过滤
+type AuctionBidFilter struct {
+	ChainID         int64
+	ContractAddress string
+	AuctionIDs      []uint64 // 需要同时指定ContractAddress
+	Bidder          string
+	Limit           int
+}
+
+// AuctionRepository 拍卖数据仓库
+type AuctionRepository struct {
+	db *DB
+}
+
+// NewAuctionRepository 创建拍卖数据仓库
+func NewAuctionRepository(db *DB) *AuctionRepository {
+	return &AuctionRepository{db: db}
+}
+
+// Apply 在一个事务中登记日志并执行状态更新，日志已应用过时不执行fn并返回false
+func (r *AuctionRepository) Apply(entry *IndexedLog, fn func(tx *AuctionTx) error) (bool, error) {
+	return applyIndexedLog(r.db, entry, func(tx *gorm.DB) error {
+		return fn(&AuctionTx{tx: tx, chainID: entry.ChainID})
+	})
+}
+
+// AuctionTx 事务内的拍卖数据操作，限定在一条链上
+type AuctionTx struct {
+	tx      *gorm.DB
+	chainID int64
+}
+
+// SaveContract 保存拍卖合约，按链和地址覆盖
+func (t *AuctionTx) SaveContract(contract *AuctionContract) error {
+	contract.ChainID = t.chainID
+	err := t.tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{
+			"factory_address", "factory_id", "creator", "name", "description", "active",
+			"deployed_block", "deployed_tx_hash", "updated_at",
+		}),
+	}).Create(contract).Error
+	if err != nil {
+		return fmt.Errorf("保存拍卖合约失败: %w", err)
+	}
+	return nil
+}
+
+// DeactivateContract 停用工厂中的拍卖合约，返回受影响的行数
+func (t *AuctionTx) DeactivateContract(factory string, factoryID uint64) (int64, error) {
+	result := t.tx.Model(&AuctionContract{}).
+		Where("chain_id = ? AND factory_address = ? AND factory_id = ?", t.chainID, factory, factoryID).
+		Update("active", false)
+	if result.Error != nil {
+		return 0, fmt.Errorf("停用拍卖合约失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetAuction 获取拍卖，不存在时返回nil
+func (t *AuctionTx) GetAuction(contract string, auctionID uint64) (*Auction, error) {
+	var auction Auction
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ? AND auction_id = ?", t.chainID, contract, auctionID).
+		First(&auction).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取拍卖失败: %w", err)
+	}
+	return &auction, nil
+}
+
+// SaveAuction 保存拍卖
+func (t *AuctionTx) SaveAuction(auction *Auction) error {
+	auction.ChainID = t.chainID
+	if err := t.tx.Save(auction).Error; err != nil {
+		return fmt.Errorf("保存拍卖失败: %w", err)
+	}
+	return nil
+}
+
+// CreateBid 记录出价
+func (t *AuctionTx) CreateBid(bid *AuctionBid) error {
+	bid.ChainID = t.chainID
+	if err := t.tx.Create(bid).Error; err != nil {
+		return fmt.Errorf("记录出价失败: %w", err)
+	}
+	return nil
+}
+
+// SetBidStatus 把拍卖中处于from状态的出价改为to状态
+func (t *AuctionTx) SetBidStatus(contract string, auctionID uint64, from, to string) error {
+	err := t.tx.Model(&AuctionBid{}).
+		Where("chain_id = ? AND contract_address = ? AND auction_id = ? AND status = ?", t.chainID, contract, auctionID, from).
+		Update("status", to).Error
+	if err != nil {
+		return fmt.Errorf("更新出价状态失败: %w", err)
+	}
+	return nil
+}
+
+// ListContracts 获取链上的拍卖合约
+func (r *AuctionRepository) ListContracts(chainID int64) ([]AuctionContract, error) {
+	var contracts []AuctionContract
+	err := r.db.Where("chain_id = ?", chainID).Order("id ASC").Find(&contracts).Error
+	return contracts, err
+}
+
+// GetAuction 获取拍卖
+func (r *AuctionRepository) GetAuction(chainID int64, contract string, auctionID uint64) (*Auction, error) {
+	var auction Auction
+	err := r.db.Where("chain_id = ? AND contract_address = ? AND auction_id = ?", chainID, contract, auctionID).
+		First(&auction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+// ListAuctions 按条件查询拍卖，按创建区块倒序
+func (r *AuctionRepository) ListAuctions(filter AuctionFilter) ([]Auction, error) {
+	query := r.db.Model(&Auction{}).Where("chain_id = ?", filter.ChainID)
+	if filter.ContractAddress != "" {
+		query = query.Where("contract_address = ?", filter.ContractAddress)
+	}
+	if filter.Seller != "" {
+		query = query.Where("seller = ?", filter.Seller)
+	}
+	if filter.Winner != "" {
+		query = query.Where("winner = ?", filter.Winner)
+	}
+	if filter.NFTContract != "" {
+		query = query.Where("nft_contract = ?", filter.NFTContract)
+	}
+	if filter.TokenID != "" {
+		query = query.Where("token_id = ?", filter.TokenID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var auctions []Auction
+	err := query.Order("created_block DESC, id DESC").Find(&auctions).Error
+	return auctions, err
+}
+
+// ListBids 按条件查询出价，按区块和日志索引倒序
+func (r *AuctionRepository) ListBids(filter AuctionBidFilter) ([]AuctionBid, error) {
+	query := r.db.Model(&AuctionBid{}).Where("chain_id = ?", filter.ChainID)
+	if filter.ContractAddress != "" {
+		query = query.Where("contract_address = ?", filter.ContractAddress)
+	}
+	if len(filter.AuctionIDs) > 0 {
+		query = query.Where("auction_id IN ?", filter.AuctionIDs)
+	}
+	if filter.Bidder != "" {
+		query = query.Where("bidder = ?", filter.Bidder)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var bids []AuctionBid
+	err := query.Order("block_number DESC, log_index DESC").Find(&bids).Error
+	return bids, err
+}
@@ -1,15 +1,18 @@
 package database
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 
 	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/stream"
 )
 
 // DB 数据库实例
@@ -70,6 +73,76 @@ func NewDB(cfg *config.Config) (*DB, error) {
 	return &DB{DB: db}, nil
 }
 
+// EnsureDatabase 确保指定名称的数据库存在（用于重放使用的影子库）
+func EnsureDatabase(cfg *config.Config, dbName string) error {
+	serverCfg := *cfg
+	serverCfg.Database.DBName = ""
+
+	db, err := gorm.Open(mysql.Open(serverCfg.GetDSN()), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return fmt.Errorf("连接数据库服务器失败: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("获取SQL DB失败: %w", err)
+	}
+	defer sqlDB.Close()
+
+	sql := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s` CHARACTER SET %s", dbName, cfg.Database.Charset)
+	if err := db.Exec(sql).Error; err != nil {
+		return fmt.Errorf("创建数据库 %s 失败: %w", dbName, err)
+	}
+	return nil
+}
+
+// TruncateDerivedTables 清空由事件派生的数据表（余额、变动、积分、同步状态、隔离事件、快照、每日汇总、通用合约事件、质押、拍卖、捐赠、代币税费、交易对、资金池份额）
+// 只应在重放使用的影子库上调用
+func (db *DB) TruncateDerivedTables() error {
+	tables := []string{
+		UserBalance{}.TableName(),
+		BalanceChange{}.TableName(),
+		UserPoints{}.TableName(),
+		PointsCalculationLog{}.TableName(),
+		BlockSyncStatus{}.TableName(),
+		QuarantinedEvent{}.TableName(),
+		BalanceSnapshot{}.TableName(),
+		BalanceSnapshotEntry{}.TableName(),
+		DailyTokenStat{}.TableName(),
+		DailyUserFlow{}.TableName(),
+		ContractEvent{}.TableName(),
+		IndexedLog{}.TableName(),
+		StakePool{}.TableName(),
+		StakePosition{}.TableName(),
+		StakeUnstakeRequest{}.TableName(),
+		StakeRewardClaim{}.TableName(),
+		AuctionContract{}.TableName(),
+		Auction{}.TableName(),
+		AuctionBid{}.TableName(),
+		DonationCampaign{}.TableName(),
+		DonationDonor{}.TableName(),
+		Donation{}.TableName(),
+		DonationWithdrawal{}.TableName(),
+		TokenTaxState{}.TableName(),
+		TokenTax{}.TableName(),
+		TokenTaxDistribution{}.TableName(),
+		DexPair{}.TableName(),
+		DexCandle{}.TableName(),
+		DexSwap{}.TableName(),
+		DexLiquidityEvent{}.TableName(),
+		DexLPPosition{}.TableName(),
+		VaultShareChange{}.TableName(),
+		VaultSnapshot{}.TableName(),
+	}
+	for _, table := range tables {
+		if err := db.Exec(fmt.Sprintf("TRUNCATE TABLE `%s`", table)).Error; err != nil {
+			return fmt.Errorf("清空表 %s 失败: %w", table, err)
+		}
+	}
+	return nil
+}
+
 // UserBalanceRepository 用户余额仓库
 type UserBalanceRepository struct {
 	db *DB
@@ -102,15 +175,48 @@ func (r *UserBalanceRepository) GetOrCreate(userAddress string, chainID int64) (
 	return &balance, nil
 }
 
-// UpdateBalance 更新用户余额
-func (r *UserBalanceRepository) UpdateBalance(userAddress string, chainID int64, newBalance *big.Int) error {
-	balance, err := r.GetOrCreate(userAddress, chainID)
+// ErrNegativeBalance 余额变动会使余额变为负数
+var ErrNegativeBalance = errors.New("余额将变为负数")
+
+// AddBalance 把用户余额加上delta（可以为负），返回变动前后的余额
+// 在一条UPDATE语句中基于数据库中的当前值计算并持有行锁到事务结束，并发的对账修正不会被覆盖
+// 余额将变为负数时不修改，返回当前余额和ErrNegativeBalance
+func (r *UserBalanceRepository) AddBalance(userAddress string, chainID int64, delta *big.Int) (before, after *big.Int, err error) {
+	if _, err := r.GetOrCreate(userAddress, chainID); err != nil {
+		return nil, nil, err
+	}
+	if delta.Sign() != 0 {
+		// 字符串与DECIMAL运算时MySQL按浮点数计算，必须转换为DECIMAL
+		result := r.db.Model(&UserBalance{}).
+			Where("user_address = ? AND chain_id = ? AND balance + CAST(? AS DECIMAL(65,0)) >= 0", userAddress, chainID, delta.String()).
+			Update("balance", gorm.Expr("balance + CAST(? AS DECIMAL(65,0))", delta.String()))
+		if result.Error != nil {
+			return nil, nil, fmt.Errorf("更新用户余额失败: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			current, err := r.GetBalance(userAddress, chainID)
+			if err != nil {
+				return nil, nil, err
+			}
+			return current, nil, ErrNegativeBalance
+		}
+	}
+
+	after, err = r.GetBalance(userAddress, chainID)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	return new(big.Int).Sub(after, delta), after, nil
+}
 
-	balance.SetBalanceFromBigInt(newBalance)
-	return r.db.Save(balance).Error
+// CompareAndSetBalance 仅当数据库中的余额仍为expected时更新为newBalance，返回是否更新
+// 用于对账修正：读取余额之后监听器又写入了变动时不覆盖
+func (r *UserBalanceRepository) CompareAndSetBalance(userAddress string, chainID int64, expected, newBalance *big.Int) (bool, error) {
+	// 字符串与DECIMAL比较时MySQL按浮点数比较，必须转换为DECIMAL后精确比较
+	result := r.db.Model(&UserBalance{}).
+		Where("user_address = ? AND chain_id = ? AND balance = CAST(? AS DECIMAL(65,0))", userAddress, chainID, expected.String()).
+		Update("balance", newBalance.String())
+	return result.RowsAffected > 0, result.Error
 }
 
 // GetBalance 获取用户余额
@@ -122,6 +228,56 @@ func (r *UserBalanceRepository) GetBalance(userAddress string, chainID int64) (*
 	return balance.GetBalanceBigInt(), nil
 }
 
+// ListByUser 获取用户在所有链上的余额记录
+func (r *UserBalanceRepository) ListByUser(userAddress string) ([]UserBalance, error) {
+	var balances []UserBalance
+	err := r.db.Where("user_address = ?", userAddress).Order("chain_id ASC").Find(&balances).Error
+	return balances, err
+}
+
+// ListByChain 获取链上的余额记录，limit大于0时随机抽样limit条
+func (r *UserBalanceRepository) ListByChain(chainID int64, limit int) ([]UserBalance, error) {
+	var balances []UserBalance
+	query := r.db.Where("chain_id = ?", chainID)
+	if limit > 0 {
+		query = query.Order("RAND()").Limit(limit)
+	} else {
+		query = query.Order("id ASC")
+	}
+	err := query.Find(&balances).Error
+	return balances, err
+}
+
+// CountHolders 统计链上余额大于0的持有人数量
+func (r *UserBalanceRepository) CountHolders(chainID int64) (int64, error) {
+	var count int64
+	err := r.db.Model(&UserBalance{}).Where("chain_id = ? AND balance > 0", chainID).Count(&count).Error
+	return count, err
+}
+
+// TopByBalance 获取链上余额最高的持有人
+func (r *UserBalanceRepository) TopByBalance(chainID int64, limit int) ([]UserBalance, error) {
+	var balances []UserBalance
+	err := r.db.Where("chain_id = ? AND balance > 0", chainID).
+		Order("balance DESC, user_address ASC").Limit(limit).Find(&balances).Error
+	return balances, err
+}
+
+// SumBalances 统计链上所有持有人的余额之和
+func (r *UserBalanceRepository) SumBalances(chainID int64) (*big.Int, error) {
+	var total string
+	err := r.db.Model(&UserBalance{}).Select("COALESCE(SUM(balance), 0)").
+		Where("chain_id = ? AND balance > 0", chainID).Scan(&total).Error
+	if err != nil {
+		return nil, err
+	}
+	sum, ok := new(big.Int).SetString(total, 10)
+	if !ok {
+		return nil, fmt.Errorf("无效的余额合计: %s", total)
+	}
+	return sum, nil
+}
+
 // BalanceChangeRepository 余额变动仓库
 type BalanceChangeRepository struct {
 	db *DB
@@ -164,13 +320,151 @@ func (r *BalanceChangeRepository) GetChangesByTimeRange(userAddress string, chai
 	return changes, err
 }
 
-// ExistsByTxHash 检查交易哈希是否已存在
-func (r *BalanceChangeRepository) ExistsByTxHash(txHash string) (bool, error) {
+// ExistsByLog 检查日志是否已写入过余额变动
+func (r *BalanceChangeRepository) ExistsByLog(chainID int64, txHash string, logIndex uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&BalanceChange{}).
+		Where("chain_id = ? AND tx_hash = ? AND log_index = ?", chainID, txHash, logIndex).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// HasChangesAfterBlock 检查用户在区块之后是否已有余额变动
+func (r *BalanceChangeRepository) HasChangesAfterBlock(userAddress string, chainID int64, blockNumber uint64) (bool, error) {
 	var count int64
-	err := r.db.Model(&BalanceChange{}).Where("tx_hash = ?", txHash).Count(&count).Error
+	err := r.db.Model(&BalanceChange{}).
+		Where("user_address = ? AND chain_id = ? AND block_number > ?", userAddress, chainID, blockNumber).
+		Count(&count).Error
 	return count > 0, err
 }
 
+// GetFirstChangeTime 获取用户在链上第一条余额变动的时间
+func (r *BalanceChangeRepository) GetFirstChangeTime(userAddress string, chainID int64) (time.Time, error) {
+	var change BalanceChange
+	err := r.db.Where("user_address = ? AND chain_id = ?", userAddress, chainID).
+		Order("timestamp ASC").First(&change).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return time.Time{}, nil // 返回零值时间
+		}
+		return time.Time{}, err
+	}
+	return change.Timestamp, nil
+}
+
+// CountByChain 统计链上的余额变动记录数
+func (r *BalanceChangeRepository) CountByChain(chainID int64) (int64, error) {
+	var count int64
+	err := r.db.Model(&BalanceChange{}).Where("chain_id = ?", chainID).Count(&count).Error
+	return count, err
+}
+
+// CountGroupByUser 按用户统计链上的余额变动记录数
+func (r *BalanceChangeRepository) CountGroupByUser(chainID int64) (map[string]int64, error) {
+	var rows []struct {
+		UserAddress string
+		Count       int64
+	}
+	err := r.db.Model(&BalanceChange{}).
+		Select("user_address, COUNT(*) AS count").
+		Where("chain_id = ?", chainID).
+		Group("user_address").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserAddress] = row.Count
+	}
+	return counts, nil
+}
+
+// changeOrder 余额变动的链上顺序：按区块、日志序号排列，写入顺序只用于区分同一日志的两条变动
+// 隔离重放和实时订阅写入的变动不一定按日志顺序写入，不能按id排序
+// 对账修正记录没有日志序号，表示区块结束时的余额，排在所在区块的最后
+var changeOrder = clause.OrderBy{
+	Expression: clause.Expr{
+		SQL:                "block_number ASC, change_type = ? ASC, log_index ASC, id ASC",
+		Vars:               []interface{}{ChangeTypeCorrection},
+		WithoutParentheses: true,
+	},
+}
+
+// ForEachChangeInRange 按链上顺序遍历 (afterBlock, toBlock] 范围内的余额变动，顺序见changeOrder
+func (r *BalanceChangeRepository) ForEachChangeInRange(chainID int64, afterBlock, toBlock uint64, fn func(change BalanceChange) error) error {
+	rows, err := r.db.Model(&BalanceChange{}).
+		Where("chain_id = ? AND block_number > ? AND block_number <= ?", chainID, afterBlock, toBlock).
+		Order(changeOrder).
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var change BalanceChange
+		if err := r.db.ScanRows(rows, &change); err != nil {
+			return err
+		}
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ForEachChangeInTimeRange 按链上顺序遍历 [start, end) 时间范围内的余额变动，顺序见changeOrder
+func (r *BalanceChangeRepository) ForEachChangeInTimeRange(chainID int64, start, end time.Time, fn func(change BalanceChange) error) error {
+	rows, err := r.db.Model(&BalanceChange{}).
+		Where("chain_id = ? AND timestamp >= ? AND timestamp < ?", chainID, start, end).
+		Order(changeOrder).
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var change BalanceChange
+		if err := r.db.ScanRows(rows, &change); err != nil {
+			return err
+		}
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// GetFirstChainChangeTime 获取链上第一条余额变动的时间
+func (r *BalanceChangeRepository) GetFirstChainChangeTime(chainID int64) (time.Time, bool, error) {
+	var change BalanceChange
+	err := r.db.Where("chain_id = ?", chainID).Order("timestamp ASC").First(&change).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, err
+	}
+	return change.Timestamp, true, nil
+}
+
+// LatestBlockAtOrBefore 获取时间点之前（含）最后一条余额变动所在的区块
+func (r *BalanceChangeRepository) LatestBlockAtOrBefore(chainID int64, t time.Time) (uint64, bool, error) {
+	var change BalanceChange
+	err := r.db.Where("chain_id = ? AND timestamp <= ?", chainID, t).
+		Order("block_number DESC").First(&change).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return change.BlockNumber, true, nil
+}
+
 // UserPointsRepository 用户积分仓库
 type UserPointsRepository struct {
 	db *DB
@@ -225,6 +519,32 @@ func (r *UserPointsRepository) GetPoints(userAddress string, chainID int64) (flo
 	return points.TotalPoints, nil
 }
 
+// ListByUser 获取用户在所有链上的积分记录
+func (r *UserPointsRepository) ListByUser(userAddress string) ([]UserPoints, error) {
+	var points []UserPoints
+	err := r.db.Where("user_address = ?", userAddress).Order("chain_id ASC").Find(&points).Error
+	return points, err
+}
+
+// ListByChain 获取链上所有用户的积分记录
+func (r *UserPointsRepository) ListByChain(chainID int64) ([]UserPoints, error) {
+	var points []UserPoints
+	err := r.db.Where("chain_id = ?", chainID).Order("id ASC").Find(&points).Error
+	return points, err
+}
+
+// ResetPoints 将用户积分清零，并把最后计算时间回拨到指定时间
+func (r *UserPointsRepository) ResetPoints(userAddress string, chainID int64, calculatedAt time.Time) error {
+	userPoints, err := r.GetOrCreate(userAddress, chainID)
+	if err != nil {
+		return err
+	}
+
+	userPoints.TotalPoints = 0
+	userPoints.LastCalculatedAt = calculatedAt
+	return r.db.Save(userPoints).Error
+}
+
 // GetUsersNeedingCalculation 获取需要计算积分的用户
 func (r *UserPointsRepository) GetUsersNeedingCalculation(chainID int64, beforeTime time.Time) ([]UserPoints, error) {
 	var users []UserPoints
@@ -314,22 +634,563 @@ func (r *PointsCalculationLogRepository) GetLastCalculationTime(userAddress stri
 	return log.CalculationTime, nil
 }
 
+// DeleteByUser 删除用户在链上的积分计算日志
+func (r *PointsCalculationLogRepository) DeleteByUser(userAddress string, chainID int64) (int64, error) {
+	result := r.db.Where("user_address = ? AND chain_id = ?", userAddress, chainID).Delete(&PointsCalculationLog{})
+	return result.RowsAffected, result.Error
+}
+
+// QuarantinedEventRepository 隔离事件仓库
+type QuarantinedEventRepository struct {
+	db *DB
+}
+
+// NewQuarantinedEventRepository 创建隔离事件仓库
+func NewQuarantinedEventRepository(db *DB) *QuarantinedEventRepository {
+	return &QuarantinedEventRepository{db: db}
+}
+
+// Create 创建隔离事件记录
+func (r *QuarantinedEventRepository) Create(event *QuarantinedEvent) error {
+	return r.db.Create(event).Error
+}
+
+// ExistsForLog 检查日志是否已被隔离（不论状态）
+func (r *QuarantinedEventRepository) ExistsForLog(chainID int64, txHash string, logIndex uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&QuarantinedEvent{}).
+		Where("chain_id = ? AND tx_hash = ? AND log_index = ?", chainID, txHash, logIndex).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// IsFrozen 检查地址在链上是否存在待处理的隔离事件
+func (r *QuarantinedEventRepository) IsFrozen(userAddress string, chainID int64) (bool, error) {
+	var count int64
+	err := r.db.Model(&QuarantinedEvent{}).
+		Where("user_address = ? AND chain_id = ? AND status = ?", userAddress, chainID, QuarantineStatusPending).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetByID 根据ID获取隔离事件
+func (r *QuarantinedEventRepository) GetByID(id uint64) (*QuarantinedEvent, error) {
+	var event QuarantinedEvent
+	if err := r.db.First(&event, id).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// ListPending 获取待处理的隔离事件，按区块和日志顺序排列
+// chainID为0或userAddress为空时不按该条件过滤
+func (r *QuarantinedEventRepository) ListPending(chainID int64, userAddress string) ([]QuarantinedEvent, error) {
+	var events []QuarantinedEvent
+	query := r.db.Where("status = ?", QuarantineStatusPending)
+	if chainID != 0 {
+		query = query.Where("chain_id = ?", chainID)
+	}
+	if userAddress != "" {
+		query = query.Where("user_address = ?", userAddress)
+	}
+	err := query.Order("chain_id ASC, block_number ASC, log_index ASC").Find(&events).Error
+	return events, err
+}
+
+// UpdateReason 更新隔离原因（重放再次失败时使用）
+func (r *QuarantinedEventRepository) UpdateReason(id uint64, reason string) error {
+	return r.db.Model(&QuarantinedEvent{}).Where("id = ?", id).Update("reason", reason).Error
+}
+
+// MarkStatus 更新隔离事件状态并记录处理说明
+func (r *QuarantinedEventRepository) MarkStatus(id uint64, status, note string) error {
+	now := time.Now()
+	return r.db.Model(&QuarantinedEvent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":          status,
+		"resolution_note": note,
+		"resolved_at":     &now,
+	}).Error
+}
+
+// RawEventLogRepository 原始事件日志仓库
+type RawEventLogRepository struct {
+	db *DB
+}
+
+// NewRawEventLogRepository 创建原始事件日志仓库
+func NewRawEventLogRepository(db *DB) *RawEventLogRepository {
+	return &RawEventLogRepository{db: db}
+}
+
+// Save 归档日志，已存在时忽略
+func (r *RawEventLogRepository) Save(log *RawEventLog) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(log).Error
+}
+
+// GetByLog 按(链, 交易, 日志序号)获取归档日志
+func (r *RawEventLogRepository) GetByLog(chainID int64, txHash string, logIndex uint) (*RawEventLog, error) {
+	var log RawEventLog
+	err := r.db.Where("chain_id = ? AND tx_hash = ? AND log_index = ?", chainID, txHash, logIndex).First(&log).Error
+	if err != nil {
+		return nil, err
+	}
+	return &log, nil
+}
+
+// CountByRange 统计区块范围内的归档日志数，toBlock为0表示不限制
+func (r *RawEventLogRepository) CountByRange(chainID int64, fromBlock, toBlock uint64) (int64, error) {
+	var count int64
+	err := r.rangeQuery(chainID, fromBlock, toBlock).Model(&RawEventLog{}).Count(&count).Error
+	return count, err
+}
+
+// FirstBlock 获取链上第一条归档日志所在的区块，没有归档日志时ok为false
+func (r *RawEventLogRepository) FirstBlock(chainID int64) (blockNumber uint64, ok bool, err error) {
+	var log RawEventLog
+	err = r.db.Where("chain_id = ?", chainID).Order("block_number ASC").First(&log).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return log.BlockNumber, true, nil
+}
+
+// ListByRange 按区块和日志顺序分页获取归档日志，toBlock为0表示不限制
+func (r *RawEventLogRepository) ListByRange(chainID int64, fromBlock, toBlock uint64, offset, limit int) ([]RawEventLog, error) {
+	var logs []RawEventLog
+	err := r.rangeQuery(chainID, fromBlock, toBlock).
+		Order("block_number ASC, log_index ASC").
+		Offset(offset).Limit(limit).
+		Find(&logs).Error
+	return logs, err
+}
+
+// rangeQuery 构造区块范围查询
+func (r *RawEventLogRepository) rangeQuery(chainID int64, fromBlock, toBlock uint64) *gorm.DB {
+	query := r.db.Where("chain_id = ? AND block_number >= ?", chainID, fromBlock)
+	if toBlock > 0 {
+		query = query.Where("block_number <= ?", toBlock)
+	}
+	return query
+}
+
+// BalanceSnapshotRepository 物化余额快照仓库
+type BalanceSnapshotRepository struct {
+	db *DB
+}
+
+// NewBalanceSnapshotRepository 创建物化余额快照仓库
+func NewBalanceSnapshotRepository(db *DB) *BalanceSnapshotRepository {
+	return &BalanceSnapshotRepository{db: db}
+}
+
+// Create 在同一事务中创建快照及其明细
+func (r *BalanceSnapshotRepository) Create(snapshot *BalanceSnapshot, entries []BalanceSnapshotEntry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(snapshot).Error; err != nil {
+			return err
+		}
+		for i := range entries {
+			entries[i].SnapshotID = snapshot.ID
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(entries, 500).Error
+	})
+}
+
+// ExistsAtBlock 检查区块上是否已有物化快照
+func (r *BalanceSnapshotRepository) ExistsAtBlock(chainID int64, blockNumber uint64) (bool, error) {
+	var count int64
+	err := r.db.Model(&BalanceSnapshot{}).Where("chain_id = ? AND block_number = ?", chainID, blockNumber).Count(&count).Error
+	return count > 0, err
+}
+
+// FindLatestAtOrBefore 获取区块之前（含）最近的物化快照，不存在时返回nil
+func (r *BalanceSnapshotRepository) FindLatestAtOrBefore(chainID int64, blockNumber uint64) (*BalanceSnapshot, error) {
+	var snapshot BalanceSnapshot
+	err := r.db.Where("chain_id = ? AND block_number <= ?", chainID, blockNumber).
+		Order("block_number DESC").First(&snapshot).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// ListEntries 获取快照明细
+func (r *BalanceSnapshotRepository) ListEntries(snapshotID uint64) ([]BalanceSnapshotEntry, error) {
+	var entries []BalanceSnapshotEntry
+	err := r.db.Where("snapshot_id = ?", snapshotID).Find(&entries).Error
+	return entries, err
+}
+
+// List 获取链上的物化快照列表（按区块倒序）
+func (r *BalanceSnapshotRepository) List(chainID int64, limit int) ([]BalanceSnapshot, error) {
+	var snapshots []BalanceSnapshot
+	err := r.db.Where("chain_id = ?", chainID).Order("block_number DESC").Limit(limit).Find(&snapshots).Error
+	return snapshots, err
+}
+
+// DailyRollupRepository 每日汇总仓库
+type DailyRollupRepository struct {
+	db *DB
+}
+
+// NewDailyRollupRepository 创建每日汇总仓库
+func NewDailyRollupRepository(db *DB) *DailyRollupRepository {
+	return &DailyRollupRepository{db: db}
+}
+
+// SaveDay 在同一事务中替换某一天的代币统计和地址资金流
+func (r *DailyRollupRepository) SaveDay(stat *DailyTokenStat, flows []DailyUserFlow) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("chain_id = ? AND day = ?", stat.ChainID, stat.Day).Delete(&DailyTokenStat{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("chain_id = ? AND day = ?", stat.ChainID, stat.Day).Delete(&DailyUserFlow{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(stat).Error; err != nil {
+			return err
+		}
+		if len(flows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(flows, 500).Error
+	})
+}
+
+// GetStat 获取某一天的代币统计，不存在时返回nil
+func (r *DailyRollupRepository) GetStat(chainID int64, day time.Time) (*DailyTokenStat, error) {
+	var stat DailyTokenStat
+	err := r.db.Where("chain_id = ? AND day = ?", chainID, day).First(&stat).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &stat, nil
+}
+
+// GetLatestStat 获取最后汇总的一天的代币统计，不存在时返回nil
+func (r *DailyRollupRepository) GetLatestStat(chainID int64) (*DailyTokenStat, error) {
+	var stat DailyTokenStat
+	err := r.db.Where("chain_id = ?", chainID).Order("day DESC").First(&stat).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &stat, nil
+}
+
+// ListStats 获取 [from, to] 日期范围内的代币统计（按日期升序）
+func (r *DailyRollupRepository) ListStats(chainID int64, from, to time.Time) ([]DailyTokenStat, error) {
+	var stats []DailyTokenStat
+	err := r.db.Where("chain_id = ? AND day >= ? AND day <= ?", chainID, from, to).
+		Order("day ASC").Find(&stats).Error
+	return stats, err
+}
+
+// ListUserFlows 获取地址在 [from, to] 日期范围内的资金流（按日期升序）
+func (r *DailyRollupRepository) ListUserFlows(chainID int64, userAddress string, from, to time.Time) ([]DailyUserFlow, error) {
+	var flows []DailyUserFlow
+	err := r.db.Where("chain_id = ? AND user_address = ? AND day >= ? AND day <= ?", chainID, userAddress, from, to).
+		Order("day ASC").Find(&flows).Error
+	return flows, err
+}
+
+// WebhookRepository Webhook订阅与发件箱仓库
+type WebhookRepository struct {
+	db *DB
+}
+
+// NewWebhookRepository 创建Webhook仓库
+func NewWebhookRepository(db *DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// CreateSubscription 创建订阅
+func (r *WebhookRepository) CreateSubscription(sub *WebhookSubscription) error {
+	return r.db.Create(sub).Error
+}
+
+// GetSubscription 根据ID获取订阅
+func (r *WebhookRepository) GetSubscription(id uint64) (*WebhookSubscription, error) {
+	var sub WebhookSubscription
+	if err := r.db.First(&sub, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("订阅 %d 不存在", id)
+		}
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// ListSubscriptions 获取订阅列表
+func (r *WebhookRepository) ListSubscriptions(activeOnly bool) ([]WebhookSubscription, error) {
+	var subs []WebhookSubscription
+	query := r.db.Order("id ASC")
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+	err := query.Find(&subs).Error
+	return subs, err
+}
+
+// SetSubscriptionActive 启用或停用订阅
+func (r *WebhookRepository) SetSubscriptionActive(id uint64, active bool) error {
+	return r.db.Model(&WebhookSubscription{}).Where("id = ?", id).Update("active", active).Error
+}
+
+// Enqueue 写入发件箱，同一订阅的同一事件只保留一条
+func (r *WebhookRepository) Enqueue(deliveries []WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+// ListDue 获取到期待投递的记录
+func (r *WebhookRepository) ListDue(now time.Time, limit int) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	err := r.db.Where("status = ? AND next_attempt_at <= ?", DeliveryStatusPending, now).
+		Order("next_attempt_at ASC, id ASC").Limit(limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// ListDeliveries 按状态获取投递记录（按ID倒序），status为空时返回全部
+func (r *WebhookRepository) ListDeliveries(status string, limit int) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	query := r.db.Order("id DESC").Limit(limit)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Find(&deliveries).Error
+	return deliveries, err
+}
+
+// MarkDelivered 标记投递成功
+func (r *WebhookRepository) MarkDelivered(id uint64, attempts, statusCode int, deliveredAt time.Time) error {
+	return r.db.Model(&WebhookDelivery{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           DeliveryStatusDelivered,
+		"attempts":         attempts,
+		"last_status_code": statusCode,
+		"last_error":       "",
+		"delivered_at":     deliveredAt,
+	}).Error
+}
+
+// MarkFailed 记录投递失败，dead为true时转入死信，否则在nextAttemptAt重试
+func (r *WebhookRepository) MarkFailed(id uint64, attempts, statusCode int, lastError string, nextAttemptAt time.Time, dead bool) error {
+	status := DeliveryStatusPending
+	if dead {
+		status = DeliveryStatusDead
+	}
+	return r.db.Model(&WebhookDelivery{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           status,
+		"attempts":         attempts,
+		"last_status_code": statusCode,
+		"last_error":       lastError,
+		"next_attempt_at":  nextAttemptAt,
+	}).Error
+}
+
+// Redeliver 把死信重新放回发件箱，id为0时处理订阅（subscriptionID为0时为全部订阅）的所有死信
+func (r *WebhookRepository) Redeliver(id, subscriptionID uint64, now time.Time) (int64, error) {
+	query := r.db.Model(&WebhookDelivery{}).Where("status = ?", DeliveryStatusDead)
+	if id > 0 {
+		query = query.Where("id = ?", id)
+	}
+	if subscriptionID > 0 {
+		query = query.Where("subscription_id = ?", subscriptionID)
+	}
+	result := query.Updates(map[string]interface{}{
+		"status":          DeliveryStatusPending,
+		"attempts":        0,
+		"next_attempt_at": now,
+	})
+	return result.RowsAffected, result.Error
+}
+
+// StreamCursorRepository 事件流发布位置仓库，实现stream.CursorStore
+type StreamCursorRepository struct {
+	db *DB
+}
+
+// NewStreamCursorRepository 创建事件流发布位置仓库
+func NewStreamCursorRepository(db *DB) *StreamCursorRepository {
+	return &StreamCursorRepository{db: db}
+}
+
+// GetCursor 获取输出在链上最后发布的位置
+func (r *StreamCursorRepository) GetCursor(sink string, chainID int64) (stream.Position, bool, error) {
+	var cursor StreamCursor
+	err := r.db.Where("sink = ? AND chain_id = ?", sink, chainID).First(&cursor).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return stream.Position{}, false, nil
+		}
+		return stream.Position{}, false, err
+	}
+	return stream.Position{BlockNumber: cursor.BlockNumber, LogIndex: cursor.LogIndex}, true, nil
+}
+
+// SaveCursor 保存输出在链上最后发布的位置
+func (r *StreamCursorRepository) SaveCursor(sink string, chainID int64, position stream.Position) error {
+	cursor := StreamCursor{
+		Sink:        sink,
+		ChainID:     chainID,
+		BlockNumber: position.BlockNumber,
+		LogIndex:    position.LogIndex,
+	}
+	return r.db.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"block_number", "log_index", "updated_at"}),
+	}).Create(&cursor).Error
+}
+
+// ListCursors 获取所有发布位置
+func (r *StreamCursorRepository) ListCursors() ([]StreamCursor, error) {
+	var cursors []StreamCursor
+	err := r.db.Order("sink ASC, chain_id ASC").Find(&cursors).Error
+	return cursors, err
+}
+
+// ContractEventFilter 通用合约事件查询条件，零值字段不参与过滤
+type ContractEventFilter struct {
+	ChainID         int64
+	ContractAddress string
+	EventName       string
+	UndecodedOnly   bool
+	AfterID         uint64
+	Limit           int
+}
+
+// ContractEventCount 按合约和事件分组的数量
+type ContractEventCount struct {
+	ContractAddress string `json:"contract_address"`
+	EventName       string `json:"event_name"`
+	Topic0          string `json:"topic0"`
+	Count           int64  `json:"count"`
+}
+
+// ContractEventRepository 通用合约事件仓库
+type ContractEventRepository struct {
+	db *DB
+}
+
+// NewContractEventRepository 创建通用合约事件仓库
+func NewContractEventRepository(db *DB) *ContractEventRepository {
+	return &ContractEventRepository{db: db}
+}
+
+// Save 保存事件，同一日志重复保存时更新解码结果
+func (r *ContractEventRepository) Save(event *ContractEvent) error {
+	return r.db.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"event_name", "signature", "source", "args", "decoded", "updated_at"}),
+	}).Create(event).Error
+}
+
+// List 按条件查询事件，按ID升序
+func (r *ContractEventRepository) List(filter ContractEventFilter) ([]ContractEvent, error) {
+	query := r.db.Model(&ContractEvent{})
+	if filter.ChainID != 0 {
+		query = query.Where("chain_id = ?", filter.ChainID)
+	}
+	if filter.ContractAddress != "" {
+		query = query.Where("contract_address = ?", filter.ContractAddress)
+	}
+	if filter.EventName != "" {
+		query = query.Where("event_name = ?", filter.EventName)
+	}
+	if filter.UndecodedOnly {
+		query = query.Where("decoded = ?", false)
+	}
+	if filter.AfterID > 0 {
+		query = query.Where("id > ?", filter.AfterID)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var events []ContractEvent
+	err := query.Order("id ASC").Find(&events).Error
+	return events, err
+}
+
+// CountByEvent 按合约和事件统计数量
+func (r *ContractEventRepository) CountByEvent(chainID int64) ([]ContractEventCount, error) {
+	var counts []ContractEventCount
+	err := r.db.Model(&ContractEvent{}).
+		Select("contract_address, event_name, topic0, COUNT(*) AS count").
+		Where("chain_id = ?", chainID).
+		Group("contract_address, event_name, topic0").
+		Order("count DESC").
+		Scan(&counts).Error
+	return counts, err
+}
+
 // Repositories 仓库集合
 type Repositories struct {
+	db *DB
+
 	UserBalance          *UserBalanceRepository
 	BalanceChange        *BalanceChangeRepository
 	UserPoints           *UserPointsRepository
 	BlockSyncStatus      *BlockSyncStatusRepository
 	PointsCalculationLog *PointsCalculationLogRepository
+	QuarantinedEvent     *QuarantinedEventRepository
+	RawEventLog          *RawEventLogRepository
+	BalanceSnapshot      *BalanceSnapshotRepository
+	DailyRollup          *DailyRollupRepository
+	Webhook              *WebhookRepository
+	StreamCursor         *StreamCursorRepository
+	ContractEvent        *ContractEventRepository
+	Stake                *StakeRepository
+	Auction              *AuctionRepository
+	Donation             *DonationRepository
+	Tax                  *TaxRepository
+	Dex                  *DexRepository
+	Vault                *VaultRepository
 }
 
 // NewRepositories 创建仓库集合
 func NewRepositories(db *DB) *Repositories {
 	return &Repositories{
+		db:                   db,
 		UserBalance:          NewUserBalanceRepository(db),
 		BalanceChange:        NewBalanceChangeRepository(db),
 		UserPoints:           NewUserPointsRepository(db),
 		BlockSyncStatus:      NewBlockSyncStatusRepository(db),
 		PointsCalculationLog: NewPointsCalculationLogRepository(db),
+		QuarantinedEvent:     NewQuarantinedEventRepository(db),
+		RawEventLog:          NewRawEventLogRepository(db),
+		BalanceSnapshot:      NewBalanceSnapshotRepository(db),
+		DailyRollup:          NewDailyRollupRepository(db),
+		Webhook:              NewWebhookRepository(db),
+		StreamCursor:         NewStreamCursorRepository(db),
+		ContractEvent:        NewContractEventRepository(db),
+		Stake:                NewStakeRepository(db),
+		Auction:              NewAuctionRepository(db),
+		Donation:             NewDonationRepository(db),
+		Tax:                  NewTaxRepository(db),
+		Dex:                  NewDexRepository(db),
+		Vault:                NewVaultRepository(db),
 	}
 }
+
+// Transaction 在一个数据库事务中执行fn，fn收到的仓库集合中所有仓库都绑定到该事务
+// fn返回错误时回滚全部写入
+func (r *Repositories) Transaction(fn func(tx *Repositories) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepositories(&DB{DB: tx}))
+	})
+}
@@ -0,0 +1,77 @@
+// Package dbtest 为需要MySQL的测试创建独立的临时数据库
+package dbtest
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+)
+
+// Open 连接 TEST_DB_HOST 指定的MySQL，创建临时数据库并迁移表结构，测试结束后删除
+// 未设置 TEST_DB_HOST 时跳过测试；端口、用户和密码分别由 TEST_DB_PORT、TEST_DB_USER、TEST_DB_PASSWORD 指定
+func Open(t testing.TB) *database.DB {
+	t.Helper()
+
+	host := os.Getenv("TEST_DB_HOST")
+	if host == "" {
+		t.Skip("未设置 TEST_DB_HOST，跳过需要数据库的测试")
+	}
+	port := 3306
+	if value := os.Getenv("TEST_DB_PORT"); value != "" {
+		var err error
+		if port, err = strconv.Atoi(value); err != nil {
+			t.Fatalf("无效的 TEST_DB_PORT: %v", err)
+		}
+	}
+	user := os.Getenv("TEST_DB_USER")
+	if user == "" {
+		user = "root"
+	}
+
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{
+			Host:     host,
+			Port:     port,
+			User:     user,
+			Password: os.Getenv("TEST_DB_PASSWORD"),
+			DBName:   fmt.Sprintf("erc20_tracker_test_%d", time.Now().UnixNano()),
+			Charset:  "utf8mb4",
+		},
+		Timezone: "UTC",
+	}
+	if err := database.EnsureDatabase(cfg, cfg.Database.DBName); err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.NewDB(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := db.Exec(fmt.Sprintf("DROP DATABASE `%s`", cfg.Database.DBName)).Error; err != nil {
+			t.Errorf("删除测试数据库失败: %v", err)
+		}
+		if sqlDB, err := db.DB.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+// RequireRowLocks 跳过依赖行锁和当前读的并发测试
+// go-mysql-server 的内存表在事务提交时整表替换，并发事务会互相覆盖，无法验证MySQL的行级并发语义
+func RequireRowLocks(t testing.TB, db *database.DB) {
+	t.Helper()
+	var comment string
+	if err := db.Raw("SELECT @@version_comment").Scan(&comment).Error; err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(comment, "Dolt") {
+		t.Skipf("测试数据库 %s 不支持行级锁，跳过并发测试", comment)
+	}
+}
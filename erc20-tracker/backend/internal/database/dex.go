@@ -0,0 +1,338 @@
+package database
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 交易对的发现方式
+const (
+	DexPairSourceFactory = "factory" // 工厂的PairCreated事件
+	DexPairSourceConfig  = "config"  // 配置的交易对地址
+	DexPairSourceGetPair = "getpair" // 启动时通过工厂的getPair查到（早于起始区块创建）
+)
+
+// 交易方向，相对于追踪的代币
+const (
+	DexSideBuy  = "buy"  // 从交易对买入代币
+	DexSideSell = "sell" // 卖出代币到交易对
+)
+
+// 流动性变动类型
+const (
+	DexLiquidityAdd    = "add"
+	DexLiquidityRemove = "remove"
+)
+
+// DexPair Uniswap V2交易对，金额为最小单位
+// 代币一侧是链上追踪的代币，另一侧是计价代币
+type DexPair struct {
+	ID              uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64      `gorm:"not null;index:idx_dex_pair,unique" json:"chain_id"`
+	PairAddress     string     `gorm:"type:varchar(42);not null;index:idx_dex_pair,unique" json:"pair_address"`
+	FactoryAddress  string     `gorm:"type:varchar(42);not null;default:''" json:"factory_address"`
+	TokenAddress    string     `gorm:"type:varchar(42);not null" json:"token_address"`
+	QuoteAddress    string     `gorm:"type:varchar(42);not null" json:"quote_address"`
+	TokenIsToken0   bool       `gorm:"not null" json:"token_is_token0"`
+	Source          string     `gorm:"type:varchar(16);not null" json:"source"`
+	ReserveToken    string     `gorm:"type:decimal(65,0);not null;default:0" json:"reserve_token"`
+	ReserveQuote    string     `gorm:"type:decimal(65,0);not null;default:0" json:"reserve_quote"`
+	LPSupply        string     `gorm:"type:decimal(65,0);not null;default:0" json:"lp_supply"`
+	Price           float64    `gorm:"type:double;not null;default:0" json:"price"` // 最近一次Sync的价格（计价代币/代币）
+	VolumeToken     string     `gorm:"type:decimal(65,0);not null;default:0" json:"volume_token"`
+	VolumeQuote     string     `gorm:"type:decimal(65,0);not null;default:0" json:"volume_quote"`
+	SwapCount       int64      `gorm:"not null;default:0" json:"swap_count"`
+	LiquidityEvents int64      `gorm:"not null;default:0" json:"liquidity_events"`
+	DiscoveredBlock uint64     `gorm:"not null" json:"discovered_block"`
+	LastSyncBlock   uint64     `gorm:"not null;default:0" json:"last_sync_block"`
+	LastSyncAt      *time.Time `json:"last_sync_at"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (DexPair) TableName() string {
+	return "dex_pairs"
+}
+
+// Reserves 按代币在交易对中的位置返回 (代币储备, 计价代币储备)
+func (p *DexPair) Reserves(reserve0, reserve1 *big.Int) (*big.Int, *big.Int) {
+	if p.TokenIsToken0 {
+		return reserve0, reserve1
+	}
+	return reserve1, reserve0
+}
+
+// DexCandle 交易对的小时K线，开盘价为上一根K线的收盘价，价格来自Sync，成交量来自Swap
+type DexCandle struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID     int64     `gorm:"not null;index:idx_dex_candle,unique" json:"chain_id"`
+	PairAddress string    `gorm:"type:varchar(42);not null;index:idx_dex_candle,unique" json:"pair_address"`
+	BucketStart time.Time `gorm:"not null;index:idx_dex_candle,unique" json:"bucket_start"`
+	Open        float64   `gorm:"type:double;not null" json:"open"`
+	High        float64   `gorm:"type:double;not null" json:"high"`
+	Low         float64   `gorm:"type:double;not null" json:"low"`
+	Close       float64   `gorm:"type:double;not null" json:"close"`
+	VolumeToken string    `gorm:"type:decimal(65,0);not null;default:0" json:"volume_token"`
+	VolumeQuote string    `gorm:"type:decimal(65,0);not null;default:0" json:"volume_quote"`
+	SwapCount   int64     `gorm:"not null;default:0" json:"swap_count"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (DexCandle) TableName() string {
+	return "dex_candles"
+}
+
+// DexSwap 一次Swap，金额为交易对实际收到和付出的数量
+type DexSwap struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID     int64     `gorm:"not null;index:idx_dex_swap_pair" json:"chain_id"`
+	PairAddress string    `gorm:"type:varchar(42);not null;index:idx_dex_swap_pair" json:"pair_address"`
+	Sender      string    `gorm:"type:varchar(42);not null" json:"sender"`                          // 调用交易对的地址，通常是路由合约
+	Recipient   string    `gorm:"type:varchar(42);not null;index:idx_dex_swap_to" json:"recipient"` // 收到输出代币的地址
+	Side        string    `gorm:"type:varchar(8);not null" json:"side"`
+	AmountToken string    `gorm:"type:decimal(65,0);not null" json:"amount_token"`
+	AmountQuote string    `gorm:"type:decimal(65,0);not null" json:"amount_quote"`
+	Price       float64   `gorm:"type:double;not null" json:"price"` // 成交均价（计价代币/代币）
+	TxHash      string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex    uint      `gorm:"not null" json:"log_index"`
+	BlockNumber uint64    `gorm:"not null;index:idx_dex_swap_pair" json:"block_number"`
+	Timestamp   time.Time `gorm:"not null" json:"timestamp"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (DexSwap) TableName() string {
+	return "dex_swaps"
+}
+
+// DexLiquidityEvent 添加或移除流动性（Mint/Burn）
+type DexLiquidityEvent struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID     int64     `gorm:"not null;index:idx_dex_liquidity_pair;index:idx_dex_liquidity_provider" json:"chain_id"`
+	PairAddress string    `gorm:"type:varchar(42);not null;index:idx_dex_liquidity_pair" json:"pair_address"`
+	Kind        string    `gorm:"type:varchar(8);not null" json:"kind"`
+	Provider    string    `gorm:"type:varchar(42);not null;index:idx_dex_liquidity_provider" json:"provider"` // 收到或交回LP代币的地址
+	Sender      string    `gorm:"type:varchar(42);not null" json:"sender"`
+	AmountToken string    `gorm:"type:decimal(65,0);not null" json:"amount_token"`
+	AmountQuote string    `gorm:"type:decimal(65,0);not null" json:"amount_quote"`
+	Liquidity   string    `gorm:"type:decimal(65,0);not null" json:"liquidity"` // 铸造或销毁的LP数量
+	TxHash      string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex    uint      `gorm:"not null" json:"log_index"`
+	BlockNumber uint64    `gorm:"not null;index:idx_dex_liquidity_pair" json:"block_number"`
+	Timestamp   time.Time `gorm:"not null" json:"timestamp"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (DexLiquidityEvent) TableName() string {
+	return "dex_liquidity_events"
+}
+
+// DexLPPosition 地址持有的LP代币，按交易对的LP Transfer维护
+type DexLPPosition struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID      int64     `gorm:"not null;index:idx_dex_position,unique;index:idx_dex_position_owner" json:"chain_id"`
+	PairAddress  string    `gorm:"type:varchar(42);not null;index:idx_dex_position,unique" json:"pair_address"`
+	Owner        string    `gorm:"type:varchar(42);not null;index:idx_dex_position,unique;index:idx_dex_position_owner" json:"owner"`
+	LPBalance    string    `gorm:"type:decimal(65,0);not null;default:0" json:"lp_balance"`
+	FirstBlock   uint64    `gorm:"not null" json:"first_block"`
+	UpdatedBlock uint64    `gorm:"not null" json:"updated_block"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (DexLPPosition) TableName() string {
+	return "dex_lp_positions"
+}
+
+// DexRepository 交易对数据仓库
+type DexRepository struct {
+	db *DB
+}
+
+// NewDexRepository 创建交易对数据仓库
+func NewDexRepository(db *DB) *DexRepository {
+	return &DexRepository{db: db}
+}
+
+// Apply 在一个事务中登记日志并执行状态更新，日志已应用过时不执行fn并返回false
+func (r *DexRepository) Apply(entry *IndexedLog, fn func(tx *DexTx) error) (bool, error) {
+	return applyIndexedLog(r.db, entry, func(tx *gorm.DB) error {
+		return fn(&DexTx{tx: tx, chainID: entry.ChainID})
+	})
+}
+
+// DexTx 事务内的交易对数据操作，限定在一条链上
+type DexTx struct {
+	tx      *gorm.DB
+	chainID int64
+}
+
+// GetPair 获取交易对，不存在时返回nil
+func (t *DexTx) GetPair(pair string) (*DexPair, error) {
+	var p DexPair
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND pair_address = ?", t.chainID, pair).
+		First(&p).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取交易对失败: %w", err)
+	}
+	return &p, nil
+}
+
+// SavePair 保存交易对
+func (t *DexTx) SavePair(pair *DexPair) error {
+	pair.ChainID = t.chainID
+	if err := t.tx.Save(pair).Error; err != nil {
+		return fmt.Errorf("保存交易对失败: %w", err)
+	}
+	return nil
+}
+
+// GetCandle 获取交易对某个小时的K线，不存在时返回nil
+func (t *DexTx) GetCandle(pair string, bucketStart time.Time) (*DexCandle, error) {
+	var candle DexCandle
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND pair_address = ? AND bucket_start = ?", t.chainID, pair, bucketStart).
+		First(&candle).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取K线失败: %w", err)
+	}
+	return &candle, nil
+}
+
+// SaveCandle 保存K线
+func (t *DexTx) SaveCandle(candle *DexCandle) error {
+	candle.ChainID = t.chainID
+	if err := t.tx.Save(candle).Error; err != nil {
+		return fmt.Errorf("保存K线失败: %w", err)
+	}
+	return nil
+}
+
+// CreateSwap 记录Swap
+func (t *DexTx) CreateSwap(swap *DexSwap) error {
+	swap.ChainID = t.chainID
+	if err := t.tx.Create(swap).Error; err != nil {
+		return fmt.Errorf("记录Swap失败: %w", err)
+	}
+	return nil
+}
+
+// CreateLiquidityEvent 记录流动性变动
+func (t *DexTx) CreateLiquidityEvent(event *DexLiquidityEvent) error {
+	event.ChainID = t.chainID
+	if err := t.tx.Create(event).Error; err != nil {
+		return fmt.Errorf("记录流动性变动失败: %w", err)
+	}
+	return nil
+}
+
+// AddLPBalance 调整地址的LP余额，delta可以为负，返回调整后的持仓
+func (t *DexTx) AddLPBalance(pair, owner string, delta *big.Int, block uint64) (*DexLPPosition, error) {
+	var position DexLPPosition
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND pair_address = ? AND owner = ?", t.chainID, pair, owner).
+		First(&position).Error
+	if err == gorm.ErrRecordNotFound {
+		position = DexLPPosition{
+			ChainID:     t.chainID,
+			PairAddress: pair,
+			Owner:       owner,
+			LPBalance:   "0",
+			FirstBlock:  block,
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("获取LP持仓失败: %w", err)
+	}
+
+	position.LPBalance = AddDecimal(position.LPBalance, delta)
+	position.UpdatedBlock = block
+	if err := t.tx.Save(&position).Error; err != nil {
+		return nil, fmt.Errorf("保存LP持仓失败: %w", err)
+	}
+	return &position, nil
+}
+
+// ListPairs 获取链上的交易对
+func (r *DexRepository) ListPairs(chainID int64) ([]DexPair, error) {
+	var pairs []DexPair
+	err := r.db.Where("chain_id = ?", chainID).Order("id ASC").Find(&pairs).Error
+	return pairs, err
+}
+
+// GetPair 获取交易对
+func (r *DexRepository) GetPair(chainID int64, pair string) (*DexPair, error) {
+	var p DexPair
+	err := r.db.Where("chain_id = ? AND pair_address = ?", chainID, pair).First(&p).Error
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListCandles 获取 [start, end) 时间范围内的小时K线，按时间顺序
+func (r *DexRepository) ListCandles(chainID int64, pair string, start, end time.Time) ([]DexCandle, error) {
+	var candles []DexCandle
+	err := r.db.Where("chain_id = ? AND pair_address = ? AND bucket_start >= ? AND bucket_start < ?", chainID, pair, start, end).
+		Order("bucket_start ASC").
+		Find(&candles).Error
+	return candles, err
+}
+
+// ListSwaps 获取交易对最近的Swap
+func (r *DexRepository) ListSwaps(chainID int64, pair string, limit int) ([]DexSwap, error) {
+	var swaps []DexSwap
+	err := r.db.Where("chain_id = ? AND pair_address = ?", chainID, pair).
+		Order("block_number DESC, log_index DESC").
+		Limit(limit).
+		Find(&swaps).Error
+	return swaps, err
+}
+
+// ListLiquidityEvents 获取流动性变动，pair或provider为空时不按其过滤
+func (r *DexRepository) ListLiquidityEvents(chainID int64, pair, provider string, limit int) ([]DexLiquidityEvent, error) {
+	query := r.db.Where("chain_id = ?", chainID)
+	if pair != "" {
+		query = query.Where("pair_address = ?", pair)
+	}
+	if provider != "" {
+		query = query.Where("provider = ?", provider)
+	}
+	var events []DexLiquidityEvent
+	err := query.Order("block_number DESC, log_index DESC").Limit(limit).Find(&events).Error
+	return events, err
+}
+
+// ListPositionsByOwner 获取地址在各交易对的LP持仓（不含已清零的）
+func (r *DexRepository) ListPositionsByOwner(chainID int64, owner string) ([]DexLPPosition, error) {
+	var positions []DexLPPosition
+	err := r.db.Where("chain_id = ? AND owner = ? AND lp_balance > 0", chainID, owner).
+		Order("pair_address ASC").
+		Find(&positions).Error
+	return positions, err
+}
+
+// TopPositions 获取交易对LP持仓最多的地址
+func (r *DexRepository) TopPositions(chainID int64, pair string, limit int) ([]DexLPPosition, error) {
+	var positions []DexLPPosition
+	err := r.db.Where("chain_id = ? AND pair_address = ? AND lp_balance > 0", chainID, pair).
+		Order("lp_balance DESC").
+		Limit(limit).
+		Find(&positions).Error
+	return positions, err
+}
@@ -0,0 +1,255 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DonationCampaign 捐赠合约的汇总状态，金额单位为wei
+type DonationCampaign struct {
+	ID              uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64      `gorm:"not null;index:idx_donation_campaign,unique" json:"chain_id"`
+	ContractAddress string     `gorm:"type:varchar(42);not null;index:idx_donation_campaign,unique" json:"contract_address"`
+	Owner           string     `gorm:"type:varchar(42);not null;default:''" json:"owner,omitempty"` // 最近一次提款的所有者
+	TotalDonated    string     `gorm:"type:decimal(65,0);not null;default:0" json:"total_donated"`
+	TotalWithdrawn  string     `gorm:"type:decimal(65,0);not null;default:0" json:"total_withdrawn"`
+	Balance         string     `gorm:"type:decimal(65,0);not null;default:0" json:"balance"` // 上次提款后的捐赠合计，提款会取出合约全部余额
+	DonorCount      int64      `gorm:"not null;default:0" json:"donor_count"`
+	DonationCount   int64      `gorm:"not null;default:0" json:"donation_count"`
+	WithdrawalCount int64      `gorm:"not null;default:0" json:"withdrawal_count"`
+	WindowStart     *time.Time `json:"window_start,omitempty"` // 最近一次DonationTimeSet设置的时间窗口
+	WindowEnd       *time.Time `json:"window_end,omitempty"`
+	WindowSetBlock  uint64     `gorm:"not null;default:0" json:"window_set_block,omitempty"`
+	WindowSetTxHash string     `gorm:"type:varchar(66);not null;default:''" json:"window_set_tx_hash,omitempty"`
+	UpdatedBlock    uint64     `gorm:"not null" json:"updated_block"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (DonationCampaign) TableName() string {
+	return "donation_campaigns"
+}
+
+// DonationDonor 捐赠者的累计捐赠
+type DonationDonor struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_donation_donor,unique;index:idx_donation_donor_total" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_donation_donor,unique;index:idx_donation_donor_total" json:"contract_address"`
+	Donor           string    `gorm:"type:varchar(42);not null;index:idx_donation_donor,unique" json:"donor"`
+	TotalAmount     string    `gorm:"type:decimal(65,0);not null;default:0;index:idx_donation_donor_total" json:"total_amount"` // 与合约中的donations一致
+	DonationCount   int64     `gorm:"not null;default:0" json:"donation_count"`
+	FirstDonatedAt  time.Time `gorm:"not null" json:"first_donated_at"`
+	LastDonatedAt   time.Time `gorm:"not null" json:"last_donated_at"`
+	FirstBlock      uint64    `gorm:"not null" json:"first_block"`
+	LastBlock       uint64    `gorm:"not null" json:"last_block"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (DonationDonor) TableName() string {
+	return "donation_donors"
+}
+
+// Donation 捐赠记录
+type Donation struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_donation_donor_history" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_donation_donor_history" json:"contract_address"`
+	Donor           string    `gorm:"type:varchar(42);not null;index:idx_donation_donor_history" json:"donor"`
+	Amount          string    `gorm:"type:decimal(65,0);not null" json:"amount"`
+	TxHash          string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null;index" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null" json:"timestamp"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (Donation) TableName() string {
+	return "donations"
+}
+
+// DonationWithdrawal 所有者提款记录
+type DonationWithdrawal struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_donation_withdrawal" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_donation_withdrawal" json:"contract_address"`
+	Owner           string    `gorm:"type:varchar(42);not null" json:"owner"`
+	Amount          string    `gorm:"type:decimal(65,0);not null" json:"amount"`
+	TxHash          string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null" json:"timestamp"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (DonationWithdrawal) TableName() string {
+	return "donation_withdrawals"
+}
+
+// DonationRepository 捐赠数据仓库
+type DonationRepository struct {
+	db *DB
+}
+
+// NewDonationRepository 创建捐赠数据仓库
+func NewDonationRepository(db *DB) *DonationRepository {
+	return &DonationRepository{db: db}
+}
+
+// Apply 在一个事务中登记日志并执行状态更新，日志已应用过时不执行fn并返回false
+func (r *DonationRepository) Apply(entry *IndexedLog, fn func(tx *DonationTx) error) (bool, error) {
+	return applyIndexedLog(r.db, entry, func(tx *gorm.DB) error {
+		return fn(&DonationTx{tx: tx, chainID: entry.ChainID, contract: entry.ContractAddress})
+	})
+}
+
+// DonationTx 事务内的捐赠数据操作，限定在一条链的一个捐赠合约上
+type DonationTx struct {
+	tx       *gorm.DB
+	chainID  int64
+	contract string
+}
+
+// GetOrNewCampaign 获取捐赠合约汇总，不存在时返回未保存的零值记录
+func (t *DonationTx) GetOrNewCampaign() (*DonationCampaign, error) {
+	var campaign DonationCampaign
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ?", t.chainID, t.contract).
+		First(&campaign).Error
+	if err == gorm.ErrRecordNotFound {
+		return &DonationCampaign{
+			ChainID:         t.chainID,
+			ContractAddress: t.contract,
+			TotalDonated:    "0",
+			TotalWithdrawn:  "0",
+			Balance:         "0",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取捐赠汇总失败: %w", err)
+	}
+	return &campaign, nil
+}
+
+// SaveCampaign 保存捐赠合约汇总
+func (t *DonationTx) SaveCampaign(campaign *DonationCampaign) error {
+	if err := t.tx.Save(campaign).Error; err != nil {
+		return fmt.Errorf("保存捐赠汇总失败: %w", err)
+	}
+	return nil
+}
+
+// GetDonor 获取捐赠者，不存在时返回nil
+func (t *DonationTx) GetDonor(donor string) (*DonationDonor, error) {
+	var record DonationDonor
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ? AND donor = ?", t.chainID, t.contract, donor).
+		First(&record).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取捐赠者失败: %w", err)
+	}
+	return &record, nil
+}
+
+// SaveDonor 保存捐赠者
+func (t *DonationTx) SaveDonor(donor *DonationDonor) error {
+	donor.ChainID, donor.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Save(donor).Error; err != nil {
+		return fmt.Errorf("保存捐赠者失败: %w", err)
+	}
+	return nil
+}
+
+// CreateDonation 记录捐赠
+func (t *DonationTx) CreateDonation(donation *Donation) error {
+	donation.ChainID, donation.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Create(donation).Error; err != nil {
+		return fmt.Errorf("记录捐赠失败: %w", err)
+	}
+	return nil
+}
+
+// CreateWithdrawal 记录提款
+func (t *DonationTx) CreateWithdrawal(withdrawal *DonationWithdrawal) error {
+	withdrawal.ChainID, withdrawal.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Create(withdrawal).Error; err != nil {
+		return fmt.Errorf("记录提款失败: %w", err)
+	}
+	return nil
+}
+
+// GetCampaign 获取链上的捐赠合约汇总
+func (r *DonationRepository) GetCampaign(chainID int64) (*DonationCampaign, error) {
+	var campaign DonationCampaign
+	err := r.db.Where("chain_id = ?", chainID).Order("id ASC").First(&campaign).Error
+	if err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// Leaderboard 按累计捐赠倒序分页获取捐赠者，金额相同时先捐赠的在前
+func (r *DonationRepository) Leaderboard(chainID int64, offset, limit int) ([]DonationDonor, error) {
+	var donors []DonationDonor
+	err := r.db.Where("chain_id = ?", chainID).
+		Order("total_amount DESC, first_block ASC, id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&donors).Error
+	return donors, err
+}
+
+// GetDonor 获取捐赠者
+func (r *DonationRepository) GetDonor(chainID int64, donor string) (*DonationDonor, error) {
+	var record DonationDonor
+	err := r.db.Where("chain_id = ? AND donor = ?", chainID, donor).First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// DonorRank 捐赠者在排行榜中的名次，与Leaderboard的排序一致
+func (r *DonationRepository) DonorRank(donor *DonationDonor) (int64, error) {
+	var ahead int64
+	err := r.db.Model(&DonationDonor{}).
+		Where("chain_id = ? AND contract_address = ?", donor.ChainID, donor.ContractAddress).
+		Where("total_amount > ? OR (total_amount = ? AND (first_block < ? OR (first_block = ? AND id < ?)))",
+			donor.TotalAmount, donor.TotalAmount, donor.FirstBlock, donor.FirstBlock, donor.ID).
+		Count(&ahead).Error
+	if err != nil {
+		return 0, err
+	}
+	return ahead + 1, nil
+}
+
+// ListDonations 获取捐赠记录，按时间倒序，donor为空表示全部
+func (r *DonationRepository) ListDonations(chainID int64, donor string, limit int) ([]Donation, error) {
+	query := r.db.Where("chain_id = ?", chainID)
+	if donor != "" {
+		query = query.Where("donor = ?", donor)
+	}
+	var donations []Donation
+	err := query.Order("block_number DESC, log_index DESC").Limit(limit).Find(&donations).Error
+	return donations, err
+}
+
+// ListWithdrawals 获取提款记录，按时间倒序
+func (r *DonationRepository) ListWithdrawals(chainID int64, limit int) ([]DonationWithdrawal, error) {
+	var withdrawals []DonationWithdrawal
+	err := r.db.Where("chain_id = ?", chainID).
+		Order("block_number DESC, log_index DESC").
+		Limit(limit).
+		Find(&withdrawals).Error
+	return withdrawals, err
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IndexedLog 已由索引器应用的合约日志，保证同一日志只应用一次
+type IndexedLog struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_indexed_log,unique" json:"chain_id"`
+	Indexer         string    `gorm:"type:varchar(32);not null;index" json:"indexer"`
+	ContractAddress string    `gorm:"type:varchar(42);not null" json:"contract_address"`
+	TxHash          string    `gorm:"type:varchar(66);not null;index:idx_indexed_log,unique" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null;index:idx_indexed_log,unique" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	EventName       string    `gorm:"type:varchar(64);not null" json:"event_name"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (IndexedLog) TableName() string {
+	return "indexed_event_logs"
+}
+
+// applyIndexedLog 在一个事务中登记日志并执行fn，日志已登记过时不执行fn并返回false
+func applyIndexedLog(db *DB, entry *IndexedLog, fn func(tx *gorm.DB) error) (bool, error) {
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry)
+		if result.Error != nil {
+			return fmt.Errorf("登记索引日志失败: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		applied = true
+		return fn(tx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return applied, nil
+}
@@ -1,10 +1,18 @@
 package database
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"erc20-tracker/backend/pkg/logger"
 )
 
 // UserBalance 用户余额表
@@ -53,15 +61,16 @@ func (UserPoints) TableName() string {
 // BalanceChange 余额变动记录表
 type BalanceChange struct {
 	ID            uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
-	UserAddress   string    `gorm:"type:varchar(42);not null;index:idx_user_time" json:"user_address"`
-	ChainID       int64     `gorm:"not null;index:idx_chain" json:"chain_id"`
-	TxHash        string    `gorm:"type:varchar(66);not null;index:idx_tx_hash,unique" json:"tx_hash"`
+	UserAddress   string    `gorm:"type:varchar(42);not null;index:idx_user_time;index:idx_balance_change_log,unique" json:"user_address"`
+	ChainID       int64     `gorm:"not null;index:idx_chain;index:idx_chain_time" json:"chain_id"`
+	TxHash        string    `gorm:"type:varchar(66);not null;index:idx_balance_change_log,unique" json:"tx_hash"`
+	LogIndex      uint      `gorm:"not null;default:0;index:idx_balance_change_log,unique" json:"log_index"` // 一笔交易可能有多条Transfer（如带税转账）
 	BlockNumber   uint64    `gorm:"not null;index:idx_block" json:"block_number"`
 	BalanceBefore string    `gorm:"type:decimal(65,0);not null" json:"balance_before"`
 	BalanceAfter  string    `gorm:"type:decimal(65,0);not null" json:"balance_after"`
 	ChangeAmount  string    `gorm:"type:decimal(65,0);not null" json:"change_amount"`
-	ChangeType    string    `gorm:"type:varchar(20);not null" json:"change_type"` // mint, burn, transfer_in, transfer_out
-	Timestamp     time.Time `gorm:"not null;index:idx_user_time" json:"timestamp"`
+	ChangeType    string    `gorm:"type:varchar(20);not null;index:idx_balance_change_log,unique" json:"change_type"` // mint, burn, transfer_in, transfer_out, tax_paid, tax_collected, correction
+	Timestamp     time.Time `gorm:"not null;index:idx_user_time;index:idx_chain_time" json:"timestamp"`
 	Processed     bool      `gorm:"not null;default:false;index:idx_processed" json:"processed"` // 是否已处理积分计算
 	CreatedAt     time.Time `gorm:"autoCreateTime" json:"created_at"`
 }
@@ -92,6 +101,13 @@ func (bc *BalanceChange) GetChangeAmountBigInt() *big.Int {
 	return amount
 }
 
+// GetDeltaBigInt 获取有符号的余额变动（balance_after - balance_before）
+// 修正记录的change_amount带符号、其他记录不带，统一用差值；
+// 隔离重放的记录写入晚于之后区块的变动，balance_after不是链上顺序下的余额，但差值仍然是这条变动本身
+func (bc *BalanceChange) GetDeltaBigInt() *big.Int {
+	return new(big.Int).Sub(bc.GetBalanceAfterBigInt(), bc.GetBalanceBeforeBigInt())
+}
+
 // SetBalancesFromBigInt 从big.Int设置余额
 func (bc *BalanceChange) SetBalancesFromBigInt(before, after, change *big.Int) {
 	bc.BalanceBefore = before.String()
@@ -145,6 +161,209 @@ func (pcl *PointsCalculationLog) SetAverageBalanceFromBigInt(balance *big.Int) {
 	pcl.AverageBalance = balance.String()
 }
 
+// QuarantinedEvent 隔离事件表
+// 处理后会导致余额为负等可疑事件不写入余额，原始日志保存在此表中，
+// 存在pending记录的地址在该链上被冻结，直到事件被重放或人工处理
+type QuarantinedEvent struct {
+	ID              uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64      `gorm:"not null;index:idx_quarantine_log,unique;index:idx_quarantine_user" json:"chain_id"`
+	UserAddress     string     `gorm:"type:varchar(42);not null;index:idx_quarantine_user" json:"user_address"` // 被冻结的地址
+	ContractAddress string     `gorm:"type:varchar(42);not null" json:"contract_address"`
+	TxHash          string     `gorm:"type:varchar(66);not null;index:idx_quarantine_log,unique" json:"tx_hash"`
+	LogIndex        uint       `gorm:"not null;index:idx_quarantine_log,unique" json:"log_index"`
+	TxIndex         uint       `gorm:"not null" json:"tx_index"`
+	BlockNumber     uint64     `gorm:"not null" json:"block_number"`
+	BlockHash       string     `gorm:"type:varchar(66);not null" json:"block_hash"`
+	Topics          string     `gorm:"type:text;not null" json:"topics"` // JSON数组
+	Data            string     `gorm:"type:text;not null" json:"data"`   // 十六进制
+	Timestamp       time.Time  `gorm:"not null" json:"timestamp"`
+	Reason          string     `gorm:"type:varchar(255);not null" json:"reason"`
+	Status          string     `gorm:"type:varchar(20);not null;default:pending;index:idx_quarantine_user" json:"status"`
+	ResolutionNote  string     `gorm:"type:varchar(255)" json:"resolution_note"`
+	ResolvedAt      *time.Time `json:"resolved_at"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (QuarantinedEvent) TableName() string {
+	return "quarantined_events"
+}
+
+// RawEventLog 原始事件日志归档表
+// 监听器获取到的每条日志都原样保存，用于在修复处理逻辑后离线重放
+type RawEventLog struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_raw_log,unique;index:idx_raw_block" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null" json:"contract_address"`
+	TxHash          string    `gorm:"type:varchar(66);not null;index:idx_raw_log,unique" json:"tx_hash"`
+	TxIndex         uint      `gorm:"not null" json:"tx_index"`
+	LogIndex        uint      `gorm:"not null;index:idx_raw_log,unique;index:idx_raw_block" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null;index:idx_raw_block" json:"block_number"`
+	BlockHash       string    `gorm:"type:varchar(66);not null" json:"block_hash"`
+	BlockTimestamp  time.Time `gorm:"not null" json:"block_timestamp"`
+	Topics          string    `gorm:"type:text;not null" json:"topics"` // JSON数组
+	Data            string    `gorm:"type:text;not null" json:"data"`   // 十六进制
+	Removed         bool      `gorm:"not null;default:false" json:"removed"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (RawEventLog) TableName() string {
+	return "raw_event_logs"
+}
+
+// ContractEvent 没有专门处理逻辑的合约事件
+// 按ABI通用解码后保存，无法解码的事件只记录topic0，补充ABI后可重新解码
+type ContractEvent struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_contract_event_log,unique;index:idx_contract_event_name" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_contract_event_name" json:"contract_address"`
+	TxHash          string    `gorm:"type:varchar(66);not null;index:idx_contract_event_log,unique" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null;index:idx_contract_event_log,unique" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null" json:"timestamp"`
+	Topic0          string    `gorm:"type:varchar(66);not null;default:''" json:"topic0"`
+	EventName       string    `gorm:"type:varchar(128);not null;default:'';index:idx_contract_event_name" json:"event_name"` // 无法解码时为空
+	Signature       string    `gorm:"type:varchar(512);not null;default:''" json:"signature"`
+	Source          string    `gorm:"type:varchar(128);not null;default:''" json:"source"` // 解码所用ABI的来源
+	Args            string    `gorm:"type:text" json:"args"`                               // 参数JSON对象
+	Decoded         bool      `gorm:"not null;default:false" json:"decoded"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ContractEvent) TableName() string {
+	return "contract_events"
+}
+
+// BalanceSnapshot 物化余额快照表
+type BalanceSnapshot struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID     int64     `gorm:"not null;index:idx_snapshot_block,unique" json:"chain_id"`
+	BlockNumber uint64    `gorm:"not null;index:idx_snapshot_block,unique" json:"block_number"`
+	TakenAt     time.Time `gorm:"not null" json:"taken_at"`
+	HolderCount int       `gorm:"not null" json:"holder_count"`
+	TotalSupply string    `gorm:"type:decimal(65,0);not null" json:"total_supply"` // 持有人余额之和
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (BalanceSnapshot) TableName() string {
+	return "balance_snapshots"
+}
+
+// BalanceSnapshotEntry 物化余额快照明细表，只保存余额大于0的持有人
+type BalanceSnapshotEntry struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	SnapshotID  uint64 `gorm:"not null;index:idx_snapshot_user,unique" json:"snapshot_id"`
+	UserAddress string `gorm:"type:varchar(42);not null;index:idx_snapshot_user,unique" json:"user_address"`
+	Balance     string `gorm:"type:decimal(65,0);not null" json:"balance"`
+}
+
+// TableName 指定表名
+func (BalanceSnapshotEntry) TableName() string {
+	return "balance_snapshot_entries"
+}
+
+// DailyTokenStat 每日代币统计汇总表
+type DailyTokenStat struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_stat_day,unique" json:"chain_id"`
+	Day             time.Time `gorm:"type:date;not null;index:idx_stat_day,unique" json:"day"`
+	Minted          string    `gorm:"type:decimal(65,0);not null;default:0" json:"minted"`
+	Burned          string    `gorm:"type:decimal(65,0);not null;default:0" json:"burned"`
+	TransferVolume  string    `gorm:"type:decimal(65,0);not null;default:0" json:"transfer_volume"` // 按转出记录统计
+	ChangeCount     int64     `gorm:"not null;default:0" json:"change_count"`
+	ActiveAddresses int64     `gorm:"not null;default:0" json:"active_addresses"`
+	NewHolders      int64     `gorm:"not null;default:0" json:"new_holders"`     // 当天余额从0变为正数的地址
+	ChurnedHolders  int64     `gorm:"not null;default:0" json:"churned_holders"` // 当天余额从正数变为0的地址
+	HolderCount     int64     `gorm:"not null;default:0" json:"holder_count"`    // 当天结束时的持有人数
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (DailyTokenStat) TableName() string {
+	return "daily_token_stats"
+}
+
+// DailyUserFlow 每日地址资金流汇总表，只包含当天有余额变动的地址
+type DailyUserFlow struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID        int64     `gorm:"not null;index:idx_flow_day_user,unique;index:idx_flow_user_day,priority:1" json:"chain_id"`
+	Day            time.Time `gorm:"type:date;not null;index:idx_flow_day_user,unique;index:idx_flow_user_day,priority:3" json:"day"`
+	UserAddress    string    `gorm:"type:varchar(42);not null;index:idx_flow_day_user,unique;index:idx_flow_user_day,priority:2" json:"user_address"`
+	Inflow         string    `gorm:"type:decimal(65,0);not null;default:0" json:"inflow"`
+	Outflow        string    `gorm:"type:decimal(65,0);not null;default:0" json:"outflow"`
+	ChangeCount    int64     `gorm:"not null;default:0" json:"change_count"`
+	OpeningBalance string    `gorm:"type:decimal(65,0);not null;default:0" json:"opening_balance"`
+	ClosingBalance string    `gorm:"type:decimal(65,0);not null;default:0" json:"closing_balance"`
+}
+
+// TableName 指定表名
+func (DailyUserFlow) TableName() string {
+	return "daily_user_flows"
+}
+
+// WebhookSubscription Webhook订阅表
+type WebhookSubscription struct {
+	ID               uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name             string    `gorm:"type:varchar(100);not null;uniqueIndex" json:"name"`
+	URL              string    `gorm:"type:varchar(500);not null" json:"url"`
+	Secret           string    `gorm:"type:varchar(128);not null" json:"-"`                            // HMAC签名密钥
+	EventTypes       string    `gorm:"type:varchar(255);not null;default:''" json:"event_types"`       // 逗号分隔，为空表示全部
+	ChainID          int64     `gorm:"not null;default:0" json:"chain_id"`                             // 0表示全部链
+	Addresses        string    `gorm:"type:text" json:"addresses"`                                     // 逗号分隔，为空表示全部地址
+	MinAmount        string    `gorm:"type:decimal(65,0);not null;default:0" json:"min_amount"`        // 余额变动的最小金额
+	BalanceThreshold string    `gorm:"type:decimal(65,0);not null;default:0" json:"balance_threshold"` // 大于0时只通知跨越该余额的变动
+	Active           bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery Webhook发件箱表，每个订阅的每个事件一条记录
+type WebhookDelivery struct {
+	ID             uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubscriptionID uint64     `gorm:"not null;index:idx_delivery_event,unique" json:"subscription_id"`
+	EventID        string     `gorm:"type:varchar(191);not null;index:idx_delivery_event,unique" json:"event_id"`
+	EventType      string     `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload        string     `gorm:"type:mediumtext;not null" json:"payload"` // 完整的JSON请求体，重试时保持不变
+	Status         string     `gorm:"type:varchar(20);not null;index:idx_delivery_due" json:"status"`
+	Attempts       int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  time.Time  `gorm:"not null;index:idx_delivery_due" json:"next_attempt_at"`
+	LastStatusCode int        `gorm:"not null;default:0" json:"last_status_code"`
+	LastError      string     `gorm:"type:text" json:"last_error"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// StreamCursor 事件流各输出在每条链上最后发布的位置
+type StreamCursor struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	Sink        string    `gorm:"type:varchar(50);not null;index:idx_stream_sink_chain,unique" json:"sink"`
+	ChainID     int64     `gorm:"not null;index:idx_stream_sink_chain,unique" json:"chain_id"`
+	BlockNumber uint64    `gorm:"not null" json:"block_number"`
+	LogIndex    uint      `gorm:"not null" json:"log_index"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (StreamCursor) TableName() string {
+	return "stream_cursors"
+}
+
 // SystemConfig 系统配置表
 type SystemConfig struct {
 	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
@@ -167,20 +386,229 @@ const (
 	ChangeTypeBurn        = "burn"
 	ChangeTypeTransferIn  = "transfer_in"
 	ChangeTypeTransferOut = "transfer_out"
+	ChangeTypeCorrection  = "correction"    // 对账修正，change_amount为有符号差值
+	ChangeTypeTaxPaid     = "tax_paid"      // 带税转账中付给代币合约的税费
+	ChangeTypeTaxIn       = "tax_collected" // 代币合约收到的税费
+
+	// 隔离事件状态
+	QuarantineStatusPending  = "pending"  // 待处理，地址被冻结
+	QuarantineStatusReplayed = "replayed" // 已重放并写入余额
+	QuarantineStatusResolved = "resolved" // 人工处理，不再写入余额
+
+	// Webhook投递状态
+	DeliveryStatusPending   = "pending"   // 等待投递或重试
+	DeliveryStatusDelivered = "delivered" // 已投递
+	DeliveryStatusDead      = "dead"      // 超过最大次数或不可重试，进入死信
 
 	// 系统配置键
 	ConfigKeyPointsRate   = "points_rate"        // 积分计算比率
 	ConfigKeyLastBackfill = "last_backfill_time" // 最后回溯时间
+
+	// ConfigKeyLogIndexBackfill 旧版余额变动记录的log_index回填状态，为pending时启动迁移会回填
+	ConfigKeyLogIndexBackfill = "balance_change_log_index_backfill"
+	logIndexBackfillPending   = "pending"
+	logIndexBackfillDone      = "done"
 )
 
 // AutoMigrate 自动迁移数据库表
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := migrateBalanceChangeIndex(db); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(
 		&UserBalance{},
 		&UserPoints{},
 		&BalanceChange{},
 		&BlockSyncStatus{},
 		&PointsCalculationLog{},
 		&SystemConfig{},
+		&QuarantinedEvent{},
+		&RawEventLog{},
+		&BalanceSnapshot{},
+		&BalanceSnapshotEntry{},
+		&DailyTokenStat{},
+		&DailyUserFlow{},
+		&WebhookSubscription{},
+		&WebhookDelivery{},
+		&StreamCursor{},
+		&ContractEvent{},
+		&IndexedLog{},
+		&StakePool{},
+		&StakePosition{},
+		&StakeUnstakeRequest{},
+		&StakeRewardClaim{},
+		&AuctionContract{},
+		&Auction{},
+		&AuctionBid{},
+		&DonationCampaign{},
+		&DonationDonor{},
+		&Donation{},
+		&DonationWithdrawal{},
+		&TokenTaxState{},
+		&TokenTax{},
+		&TokenTaxDistribution{},
+		&DexPair{},
+		&DexCandle{},
+		&DexSwap{},
+		&DexLiquidityEvent{},
+		&DexLPPosition{},
+		&VaultShareChange{},
+		&VaultSnapshot{},
+	); err != nil {
+		return err
+	}
+	return backfillBalanceChangeLogIndex(db)
+}
+
+// migrateBalanceChangeIndex 删除旧版按交易哈希的唯一索引，余额变动改为按日志去重
+// 旧版表没有log_index列，AutoMigrate新增的列默认为0，先登记回填状态，迁移后按归档日志回填
+func migrateBalanceChangeIndex(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&BalanceChange{}) {
+		return nil
+	}
+	if !migrator.HasColumn(&BalanceChange{}, "LogIndex") {
+		if err := db.AutoMigrate(&SystemConfig{}); err != nil {
+			return fmt.Errorf("迁移system_configs失败: %w", err)
+		}
+		marker := &SystemConfig{
+			ConfigKey:   ConfigKeyLogIndexBackfill,
+			ConfigValue: logIndexBackfillPending,
+			Description: "旧版余额变动记录的log_index回填状态",
+		}
+		err := db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "config_key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"config_value"}),
+		}).Create(marker).Error
+		if err != nil {
+			return fmt.Errorf("登记log_index回填状态失败: %w", err)
+		}
+	}
+	if !migrator.HasIndex(&BalanceChange{}, "idx_tx_hash") {
+		return nil
+	}
+	if err := migrator.DropIndex(&BalanceChange{}, "idx_tx_hash"); err != nil {
+		return fmt.Errorf("删除balance_changes旧索引失败: %w", err)
+	}
+	return nil
+}
+
+// backfillChangeEvents 各余额变动类型对应的代币事件签名，以及用户地址所在的topic位置
+var backfillChangeEvents = map[string]struct {
+	topic0 common.Hash
+	user   int
+}{
+	ChangeTypeTransferOut: {crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), 1},
+	ChangeTypeTransferIn:  {crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), 2},
+	ChangeTypeMint:        {crypto.Keccak256Hash([]byte("TokenMinted(address,uint256,uint256)")), 1},
+	ChangeTypeBurn:        {crypto.Keccak256Hash([]byte("TokenBurned(address,uint256,uint256)")), 1},
+}
+
+// UnresolvedLogIndex 无法从归档日志确定log_index的旧版余额变动使用的占位值
+// 旧版表按交易哈希唯一，占位值在(user_address, tx_hash, log_index, change_type)上不会冲突，也不会与真实的日志序号相同
+const UnresolvedLogIndex uint = 1<<32 - 1
+
+// backfillBalanceChangeLogIndex 按raw_event_logs中的归档日志回填旧版余额变动的log_index
+// 同一交易中事件签名、用户和金额都匹配的日志只有一条时才回填，避免按错误的log_index去重导致同一日志被重复计入余额；
+// 无法确定的记录设为UnresolvedLogIndex并记录警告，不阻止启动
+func backfillBalanceChangeLogIndex(db *gorm.DB) error {
+	var marker SystemConfig
+	if err := db.Where("config_key = ?", ConfigKeyLogIndexBackfill).Limit(1).Find(&marker).Error; err != nil {
+		return fmt.Errorf("获取log_index回填状态失败: %w", err)
+	}
+	if marker.ConfigValue != logIndexBackfillPending {
+		return nil
+	}
+
+	changeTypes := make([]string, 0, len(backfillChangeEvents))
+	for changeType := range backfillChangeEvents {
+		changeTypes = append(changeTypes, changeType)
+	}
+
+	var unresolved []uint64
+	var lastID uint64
+	for {
+		// 已回填的记录log_index不为0；log_index确实为0的记录会再次匹配到同一条日志
+		var changes []BalanceChange
+		err := db.Where("id > ? AND log_index = 0 AND change_type IN ?", lastID, changeTypes).
+			Order("id ASC").Limit(500).Find(&changes).Error
+		if err != nil {
+			return fmt.Errorf("获取待回填的余额变动失败: %w", err)
+		}
+		if len(changes) == 0 {
+			break
+		}
+		for _, change := range changes {
+			lastID = change.ID
+			logIndex, ok, err := matchChangeLog(db, change)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				unresolved = append(unresolved, change.ID)
+				logIndex = UnresolvedLogIndex
+			}
+			if logIndex == 0 {
+				continue
+			}
+			if err := db.Model(&BalanceChange{}).Where("id = ?", change.ID).Update("log_index", logIndex).Error; err != nil {
+				return fmt.Errorf("回填余额变动 %d 的log_index失败: %w", change.ID, err)
+			}
+		}
+	}
+
+	if len(unresolved) > 0 {
+		// 这些记录所在区块早于同步进度，正常同步不会再处理；把同步进度重置到这些区块之前会重复计入余额
+		logger.WithFields(map[string]interface{}{
+			"count":     len(unresolved),
+			"ids":       unresolved[:min(len(unresolved), 10)],
+			"log_index": UnresolvedLogIndex,
+		}).Warn("旧版余额变动无法从raw_event_logs确定log_index，已设为占位值")
+	}
+
+	err := db.Model(&SystemConfig{}).Where("config_key = ?", ConfigKeyLogIndexBackfill).
+		Update("config_value", logIndexBackfillDone).Error
+	if err != nil {
+		return fmt.Errorf("更新log_index回填状态失败: %w", err)
+	}
+	return nil
+}
+
+// matchChangeLog 在余额变动所在交易的归档日志中查找对应的事件，只有唯一匹配时返回true
+func matchChangeLog(db *gorm.DB, change BalanceChange) (uint, bool, error) {
+	event := backfillChangeEvents[change.ChangeType]
+
+	var logs []RawEventLog
+	err := db.Where("chain_id = ? AND tx_hash = ? AND removed = ?", change.ChainID, change.TxHash, false).
+		Find(&logs).Error
+	if err != nil {
+		return 0, false, fmt.Errorf("获取交易 %s 的归档日志失败: %w", change.TxHash, err)
+	}
+
+	user := common.BytesToHash(common.HexToAddress(change.UserAddress).Bytes())
+	amount := new(big.Int).Abs(ParseDecimal(change.ChangeAmount))
+
+	var (
+		logIndex uint
+		matches  int
 	)
+	for _, raw := range logs {
+		var topics []string
+		if err := json.Unmarshal([]byte(raw.Topics), &topics); err != nil {
+			return 0, false, fmt.Errorf("解析归档日志 %s#%d 的topics失败: %w", raw.TxHash, raw.LogIndex, err)
+		}
+		if len(topics) <= event.user ||
+			common.HexToHash(topics[0]) != event.topic0 ||
+			common.HexToHash(topics[event.user]) != user {
+			continue
+		}
+		// 三种事件的金额都是data中的第一个字
+		data := common.FromHex(strings.TrimSpace(raw.Data))
+		if len(data) < 32 || new(big.Int).SetBytes(data[:32]).Cmp(amount) != 0 {
+			continue
+		}
+		logIndex = raw.LogIndex
+		matches++
+	}
+	return logIndex, matches == 1, nil
 }
@@ -0,0 +1,174 @@
+package database_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+)
+
+const chainID = 1
+
+var (
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	mintTopic     = crypto.Keccak256Hash([]byte("TokenMinted(address,uint256,uint256)"))
+	approvalTopic = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+
+	alice = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob   = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+)
+
+// oldSchema 把balance_changes改回没有log_index列、按交易哈希唯一的旧版结构
+func oldSchema(t *testing.T, db *database.DB) {
+	t.Helper()
+	for _, stmt := range []string{
+		"ALTER TABLE balance_changes DROP INDEX idx_balance_change_log",
+		"ALTER TABLE balance_changes DROP COLUMN log_index",
+		"CREATE UNIQUE INDEX idx_tx_hash ON balance_changes (tx_hash)",
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+}
+
+// insertOldChange 按旧版结构写入余额变动，返回记录ID
+func insertOldChange(t *testing.T, db *database.DB, user common.Address, txHash common.Hash, changeType string, amount int64) uint64 {
+	t.Helper()
+	err := db.Exec(`INSERT INTO balance_changes
+		(user_address, chain_id, tx_hash, block_number, balance_before, balance_after, change_amount, change_type, timestamp, processed, created_at)
+		VALUES (?, ?, ?, 1, '0', ?, ?, ?, ?, false, ?)`,
+		user.Hex(), chainID, txHash.Hex(), amount, amount, changeType, time.Now(), time.Now()).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	var id uint64
+	if err := db.Raw("SELECT id FROM balance_changes WHERE tx_hash = ?", txHash.Hex()).Scan(&id).Error; err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// archiveLog 写入一条归档日志，data为依次排列的uint256
+func archiveLog(t *testing.T, db *database.DB, txHash common.Hash, logIndex uint, topics []common.Hash, words ...int64) {
+	t.Helper()
+	values := make([]string, len(topics))
+	for i, topic := range topics {
+		values[i] = topic.Hex()
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for _, word := range words {
+		data = append(data, common.BigToHash(big.NewInt(word)).Bytes()...)
+	}
+	err = db.Create(&database.RawEventLog{
+		ChainID:         chainID,
+		ContractAddress: common.HexToAddress("0x1").Hex(),
+		TxHash:          txHash.Hex(),
+		LogIndex:        logIndex,
+		BlockNumber:     1,
+		BlockHash:       common.HexToHash("0x1").Hex(),
+		BlockTimestamp:  time.Now(),
+		Topics:          string(encoded),
+		Data:            hexutil.Encode(data),
+	}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func logIndexOf(t *testing.T, db *database.DB, id uint64) uint {
+	t.Helper()
+	var change database.BalanceChange
+	if err := db.First(&change, id).Error; err != nil {
+		t.Fatal(err)
+	}
+	return change.LogIndex
+}
+
+func TestAutoMigrateBackfillsLogIndex(t *testing.T) {
+	db := dbtest.Open(t)
+	oldSchema(t, db)
+
+	// 带税转账：同一交易中先有付给合约的Transfer，另有同金额的Approval，只有一条Transfer匹配alice收到的金额
+	transferTx := common.HexToHash("0xaa")
+	transferIn := insertOldChange(t, db, alice, transferTx, database.ChangeTypeTransferIn, 950)
+	archiveLog(t, db, transferTx, 4, []common.Hash{approvalTopic, common.BytesToHash(bob.Bytes()), common.BytesToHash(alice.Bytes())}, 950)
+	archiveLog(t, db, transferTx, 5, []common.Hash{transferTopic, common.BytesToHash(bob.Bytes()), common.HexToHash("0x1")}, 50)
+	archiveLog(t, db, transferTx, 7, []common.Hash{transferTopic, common.BytesToHash(bob.Bytes()), common.BytesToHash(alice.Bytes())}, 950)
+
+	mintTx := common.HexToHash("0xbb")
+	mint := insertOldChange(t, db, bob, mintTx, database.ChangeTypeMint, 100)
+	archiveLog(t, db, mintTx, 0, []common.Hash{transferTopic, {}, common.BytesToHash(bob.Bytes())}, 100)
+	archiveLog(t, db, mintTx, 1, []common.Hash{mintTopic, common.BytesToHash(bob.Bytes())}, 100, 1700000000)
+
+	// 没有归档日志的交易无法确定log_index
+	missingTx := common.HexToHash("0xcc")
+	missing := insertOldChange(t, db, alice, missingTx, database.ChangeTypeBurn, 10)
+
+	if err := database.AutoMigrate(db.DB); err != nil {
+		t.Fatal(err)
+	}
+	if got := logIndexOf(t, db, transferIn); got != 7 {
+		t.Errorf("transfer_in的log_index = %d，期望 7", got)
+	}
+	if got := logIndexOf(t, db, mint); got != 1 {
+		t.Errorf("mint的log_index = %d，期望 1", got)
+	}
+	if got := logIndexOf(t, db, missing); got != database.UnresolvedLogIndex {
+		t.Errorf("无法回填的log_index = %d，期望占位值 %d", got, database.UnresolvedLogIndex)
+	}
+
+	// 占位值不占用真实的日志序号，同一交易中log_index为0的日志仍可写入
+	change := &database.BalanceChange{
+		UserAddress: alice.Hex(),
+		ChainID:     chainID,
+		TxHash:      missingTx.Hex(),
+		BlockNumber: 1,
+		ChangeType:  database.ChangeTypeBurn,
+		Timestamp:   time.Now(),
+	}
+	change.SetBalancesFromBigInt(big.NewInt(10), big.NewInt(0), big.NewInt(10))
+	if err := db.Create(change).Error; err != nil {
+		t.Fatalf("写入log_index为0的记录失败: %v", err)
+	}
+}
+
+func TestAutoMigrateBackfillCompletes(t *testing.T) {
+	db := dbtest.Open(t)
+	oldSchema(t, db)
+
+	txHash := common.HexToHash("0xdd")
+	id := insertOldChange(t, db, alice, txHash, database.ChangeTypeTransferOut, 30)
+	archiveLog(t, db, txHash, 3, []common.Hash{transferTopic, common.BytesToHash(alice.Bytes()), common.BytesToHash(bob.Bytes())}, 30)
+
+	if err := database.AutoMigrate(db.DB); err != nil {
+		t.Fatal(err)
+	}
+	if got := logIndexOf(t, db, id); got != 3 {
+		t.Errorf("transfer_out的log_index = %d，期望 3", got)
+	}
+
+	var marker database.SystemConfig
+	if err := db.Where("config_key = ?", database.ConfigKeyLogIndexBackfill).First(&marker).Error; err != nil {
+		t.Fatal(err)
+	}
+	if marker.ConfigValue != "done" {
+		t.Errorf("回填状态 = %s", marker.ConfigValue)
+	}
+
+	// 新结构上重复迁移不再回填
+	if err := database.AutoMigrate(db.DB); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,292 @@
+package database
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 解质押请求状态
+const (
+	UnstakeStatusPending   = "pending"   // 等待解锁或已解锁未提取
+	UnstakeStatusWithdrawn = "withdrawn" // 已通过unstake提取
+)
+
+// StakePool 质押池
+type StakePool struct {
+	ID                  uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID             int64     `gorm:"not null;index:idx_stake_pool,unique" json:"chain_id"`
+	ContractAddress     string    `gorm:"type:varchar(42);not null;index:idx_stake_pool,unique" json:"contract_address"`
+	PoolID              uint64    `gorm:"column:pid;not null;index:idx_stake_pool,unique" json:"pid"`
+	StTokenAddress      string    `gorm:"type:varchar(42);not null" json:"st_token_address"` // 零地址表示ETH
+	PoolWeight          string    `gorm:"type:decimal(65,0);not null;default:0" json:"pool_weight"`
+	MinDepositAmount    string    `gorm:"type:decimal(65,0);not null;default:0" json:"min_deposit_amount"`
+	UnstakeLockedBlocks uint64    `gorm:"not null" json:"unstake_locked_blocks"`
+	TotalStaked         string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_staked"`          // 与合约中的stTokenAmount一致
+	PendingUnstake      string    `gorm:"type:decimal(65,0);not null;default:0" json:"pending_unstake"`       // 已申请解质押、尚未提取，仍在合约中
+	TotalRewardsClaimed string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_rewards_claimed"` // 累计领取的MetaNode
+	StakerCount         int64     `gorm:"not null;default:0" json:"staker_count"`                             // 质押数量大于0的用户数
+	AddedBlock          uint64    `gorm:"not null" json:"added_block"`
+	UpdatedBlock        uint64    `gorm:"not null" json:"updated_block"`
+	CreatedAt           time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt           time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (StakePool) TableName() string {
+	return "stake_pools"
+}
+
+// StakePosition 用户在质押池中的持仓
+type StakePosition struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_stake_position,unique;index:idx_stake_position_pool" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_stake_position,unique;index:idx_stake_position_pool" json:"contract_address"`
+	PoolID          uint64    `gorm:"column:pid;not null;index:idx_stake_position,unique;index:idx_stake_position_pool" json:"pid"`
+	UserAddress     string    `gorm:"type:varchar(42);not null;index:idx_stake_position,unique" json:"user_address"`
+	StakedAmount    string    `gorm:"type:decimal(65,0);not null;default:0" json:"staked_amount"`
+	PendingUnstake  string    `gorm:"type:decimal(65,0);not null;default:0" json:"pending_unstake"`
+	TotalClaimed    string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_claimed"`
+	UpdatedBlock    uint64    `gorm:"not null" json:"updated_block"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (StakePosition) TableName() string {
+	return "stake_positions"
+}
+
+// StakeUnstakeRequest 解质押请求
+type StakeUnstakeRequest struct {
+	ID              uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64      `gorm:"not null;index:idx_stake_request_user" json:"chain_id"`
+	ContractAddress string     `gorm:"type:varchar(42);not null;index:idx_stake_request_user" json:"contract_address"`
+	PoolID          uint64     `gorm:"column:pid;not null;index:idx_stake_request_user" json:"pid"`
+	UserAddress     string     `gorm:"type:varchar(42);not null;index:idx_stake_request_user" json:"user_address"`
+	Amount          string     `gorm:"type:decimal(65,0);not null" json:"amount"`
+	UnlockBlock     uint64     `gorm:"not null" json:"unlock_block"`
+	Status          string     `gorm:"type:varchar(16);not null;index" json:"status"`
+	RequestTxHash   string     `gorm:"type:varchar(66);not null" json:"request_tx_hash"`
+	RequestBlock    uint64     `gorm:"not null" json:"request_block"`
+	RequestedAt     time.Time  `gorm:"not null" json:"requested_at"`
+	WithdrawTxHash  string     `gorm:"type:varchar(66)" json:"withdraw_tx_hash,omitempty"`
+	WithdrawBlock   uint64     `json:"withdraw_block,omitempty"`
+	WithdrawnAt     *time.Time `json:"withdrawn_at,omitempty"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (StakeUnstakeRequest) TableName() string {
+	return "stake_unstake_requests"
+}
+
+// StakeRewardClaim 奖励领取记录
+type StakeRewardClaim struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_stake_claim_user" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null;index:idx_stake_claim_user" json:"contract_address"`
+	PoolID          uint64    `gorm:"column:pid;not null" json:"pid"`
+	UserAddress     string    `gorm:"type:varchar(42);not null;index:idx_stake_claim_user" json:"user_address"`
+	Amount          string    `gorm:"type:decimal(65,0);not null" json:"amount"`
+	TxHash          string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null" json:"timestamp"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (StakeRewardClaim) TableName() string {
+	return "stake_reward_claims"
+}
+
+// ParseDecimal 解析数据库中的十进制金额，无效时返回0
+func ParseDecimal(value string) *big.Int {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return amount
+}
+
+// AddDecimal 十进制金额加上amount，返回新的十进制字符串
+func AddDecimal(value string, amount *big.Int) string {
+	return new(big.Int).Add(ParseDecimal(value), amount).String()
+}
+
+// StakeRepository 质押数据仓库
+type StakeRepository struct {
+	db *DB
+}
+
+// NewStakeRepository 创建质押数据仓库
+func NewStakeRepository(db *DB) *StakeRepository {
+	return &StakeRepository{db: db}
+}
+
+// Apply 在一个事务中登记日志并执行状态更新，日志已应用过时不执行fn并返回false
+func (r *StakeRepository) Apply(entry *IndexedLog, fn func(tx *StakeTx) error) (bool, error) {
+	return applyIndexedLog(r.db, entry, func(tx *gorm.DB) error {
+		return fn(&StakeTx{tx: tx, chainID: entry.ChainID, contract: entry.ContractAddress})
+	})
+}
+
+// StakeTx 事务内的质押数据操作，限定在一条链的一个质押合约上
+type StakeTx struct {
+	tx       *gorm.DB
+	chainID  int64
+	contract string
+}
+
+// GetPool 获取质押池，不存在时返回nil
+func (t *StakeTx) GetPool(pid uint64) (*StakePool, error) {
+	var pool StakePool
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ? AND pid = ?", t.chainID, t.contract, pid).
+		First(&pool).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取质押池失败: %w", err)
+	}
+	return &pool, nil
+}
+
+// SavePool 保存质押池
+func (t *StakeTx) SavePool(pool *StakePool) error {
+	pool.ChainID, pool.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Save(pool).Error; err != nil {
+		return fmt.Errorf("保存质押池失败: %w", err)
+	}
+	return nil
+}
+
+// GetOrNewPosition 获取用户持仓，不存在时返回未保存的零值持仓
+func (t *StakeTx) GetOrNewPosition(pid uint64, user string) (*StakePosition, error) {
+	var position StakePosition
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ? AND pid = ? AND user_address = ?", t.chainID, t.contract, pid, user).
+		First(&position).Error
+	if err == gorm.ErrRecordNotFound {
+		return &StakePosition{
+			ChainID:         t.chainID,
+			ContractAddress: t.contract,
+			PoolID:          pid,
+			UserAddress:     user,
+			StakedAmount:    "0",
+			PendingUnstake:  "0",
+			TotalClaimed:    "0",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取质押持仓失败: %w", err)
+	}
+	return &position, nil
+}
+
+// SavePosition 保存用户持仓
+func (t *StakeTx) SavePosition(position *StakePosition) error {
+	if err := t.tx.Save(position).Error; err != nil {
+		return fmt.Errorf("保存质押持仓失败: %w", err)
+	}
+	return nil
+}
+
+// CreateRequest 创建解质押请求
+func (t *StakeTx) CreateRequest(request *StakeUnstakeRequest) error {
+	request.ChainID, request.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Create(request).Error; err != nil {
+		return fmt.Errorf("创建解质押请求失败: %w", err)
+	}
+	return nil
+}
+
+// ListPendingRequests 获取用户在池中待提取的解质押请求，按解锁区块和ID升序
+func (t *StakeTx) ListPendingRequests(pid uint64, user string) ([]StakeUnstakeRequest, error) {
+	var requests []StakeUnstakeRequest
+	err := t.tx.Where("chain_id = ? AND contract_address = ? AND pid = ? AND user_address = ? AND status = ?",
+		t.chainID, t.contract, pid, user, UnstakeStatusPending).
+		Order("unlock_block ASC, id ASC").
+		Find(&requests).Error
+	if err != nil {
+		return nil, fmt.Errorf("获取解质押请求失败: %w", err)
+	}
+	return requests, nil
+}
+
+// SaveRequest 保存解质押请求
+func (t *StakeTx) SaveRequest(request *StakeUnstakeRequest) error {
+	if err := t.tx.Save(request).Error; err != nil {
+		return fmt.Errorf("保存解质押请求失败: %w", err)
+	}
+	return nil
+}
+
+// CreateClaim 记录奖励领取
+func (t *StakeTx) CreateClaim(claim *StakeRewardClaim) error {
+	claim.ChainID, claim.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Create(claim).Error; err != nil {
+		return fmt.Errorf("记录奖励领取失败: %w", err)
+	}
+	return nil
+}
+
+// ListPools 获取链上的质押池
+func (r *StakeRepository) ListPools(chainID int64) ([]StakePool, error) {
+	var pools []StakePool
+	err := r.db.Where("chain_id = ?", chainID).Order("contract_address ASC, pid ASC").Find(&pools).Error
+	return pools, err
+}
+
+// GetPool 获取质押池
+func (r *StakeRepository) GetPool(chainID int64, pid uint64) (*StakePool, error) {
+	var pool StakePool
+	err := r.db.Where("chain_id = ? AND pid = ?", chainID, pid).First(&pool).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// TopPositions 获取池中质押数量最多的持仓
+func (r *StakeRepository) TopPositions(chainID int64, pid uint64, limit int) ([]StakePosition, error) {
+	var positions []StakePosition
+	err := r.db.Where("chain_id = ? AND pid = ? AND staked_amount > 0", chainID, pid).
+		Order("staked_amount DESC").
+		Limit(limit).
+		Find(&positions).Error
+	return positions, err
+}
+
+// ListPositions 获取用户在链上所有池的持仓
+func (r *StakeRepository) ListPositions(chainID int64, user string) ([]StakePosition, error) {
+	var positions []StakePosition
+	err := r.db.Where("chain_id = ? AND user_address = ?", chainID, user).Order("pid ASC").Find(&positions).Error
+	return positions, err
+}
+
+// ListRequests 获取用户的解质押请求，status为空表示全部
+func (r *StakeRepository) ListRequests(chainID int64, user, status string) ([]StakeUnstakeRequest, error) {
+	query := r.db.Where("chain_id = ? AND user_address = ?", chainID, user)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var requests []StakeUnstakeRequest
+	err := query.Order("request_block DESC, id DESC").Find(&requests).Error
+	return requests, err
+}
+
+// ListClaims 获取用户的奖励领取记录，按时间倒序
+func (r *StakeRepository) ListClaims(chainID int64, user string, limit int) ([]StakeRewardClaim, error) {
+	var claims []StakeRewardClaim
+	err := r.db.Where("chain_id = ? AND user_address = ?", chainID, user).
+		Order("block_number DESC, id DESC").
+		Limit(limit).
+		Find(&claims).Error
+	return claims, err
+}
@@ -0,0 +1,276 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 税费类型，按TaxCollected的税额与当时的税率反推
+const (
+	TaxKindBuy      = "buy"      // 从交易对买入
+	TaxKindSell     = "sell"     // 卖出到交易对
+	TaxKindTransfer = "transfer" // 普通转账
+	TaxKindUnknown  = "unknown"  // 税率未知或多种类型税率相同
+)
+
+// TokenTaxState 带税代币的税率配置和累计税费，金额为代币最小单位
+type TokenTaxState struct {
+	ID               uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID          int64     `gorm:"not null;index:idx_token_tax_state,unique" json:"chain_id"`
+	ContractAddress  string    `gorm:"type:varchar(42);not null;index:idx_token_tax_state,unique" json:"contract_address"`
+	BuyTaxRate       int64     `gorm:"not null" json:"buy_tax_rate"` // 基点
+	SellTaxRate      int64     `gorm:"not null" json:"sell_tax_rate"`
+	TransferTaxRate  int64     `gorm:"not null" json:"transfer_tax_rate"`
+	LiquidityShare   int64     `gorm:"not null" json:"liquidity_share"` // 基点，三者之和为10000
+	MarketingShare   int64     `gorm:"not null" json:"marketing_share"`
+	BurnShare        int64     `gorm:"not null" json:"burn_share"`
+	TotalCollected   string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_collected"` // 与合约中的totalTaxCollected一致
+	TotalBurned      string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_burned"`    // 与合约中的totalBurned一致
+	TotalLiquidity   string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_liquidity"`
+	TotalMarketing   string    `gorm:"type:decimal(65,0);not null;default:0" json:"total_marketing"`
+	Undistributed    string    `gorm:"type:decimal(65,0);not null;default:0" json:"undistributed"` // 已收取、尚未在SwapAndLiquify中分配
+	TaxCount         int64     `gorm:"not null;default:0" json:"tax_count"`
+	DistributedCount int64     `gorm:"not null;default:0" json:"distributed_count"`
+	UpdatedBlock     uint64    `gorm:"not null" json:"updated_block"`
+	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (TokenTaxState) TableName() string {
+	return "token_tax_states"
+}
+
+// TokenTax 一次带税转账（TaxCollected），税费由付税方（转出方）承担，先转入代币合约
+type TokenTax struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_token_tax_payer;index:idx_token_tax_time" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null" json:"contract_address"`
+	Payer           string    `gorm:"type:varchar(42);not null;index:idx_token_tax_payer" json:"payer"`
+	Recipient       string    `gorm:"type:varchar(42);not null" json:"recipient"`
+	Kind            string    `gorm:"type:varchar(16);not null" json:"kind"`
+	Amount          string    `gorm:"type:decimal(65,0);not null" json:"amount"`     // 转账总额
+	Tax             string    `gorm:"type:decimal(65,0);not null" json:"tax"`        // 转入代币合约的税费
+	NetAmount       string    `gorm:"type:decimal(65,0);not null" json:"net_amount"` // 接收方实际到账
+	RateBps         int64     `gorm:"not null" json:"rate_bps"`                      // 实际税率（基点，向下取整）
+	TxHash          string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null;index:idx_token_tax_time" json:"timestamp"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (TokenTax) TableName() string {
+	return "token_taxes"
+}
+
+// TokenTaxDistribution 一次SwapAndLiquify中代币合约持有的税费去向
+// 销毁和营销部分按当时的分配比例计算，与合约的计算方式一致
+type TokenTaxDistribution struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID         int64     `gorm:"not null;index:idx_token_tax_dist_time" json:"chain_id"`
+	ContractAddress string    `gorm:"type:varchar(42);not null" json:"contract_address"`
+	TokensSwapped   string    `gorm:"type:decimal(65,0);not null" json:"tokens_swapped"` // 分配时合约持有的全部代币
+	Burned          string    `gorm:"type:decimal(65,0);not null" json:"burned"`
+	ToLiquidity     string    `gorm:"type:decimal(65,0);not null" json:"to_liquidity"` // 自动加池或转给流动性钱包
+	ToMarketing     string    `gorm:"type:decimal(65,0);not null" json:"to_marketing"`
+	Retained        string    `gorm:"type:decimal(65,0);not null" json:"retained"` // 取整余数，留在合约中
+	TxHash          string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex        uint      `gorm:"not null" json:"log_index"`
+	BlockNumber     uint64    `gorm:"not null" json:"block_number"`
+	Timestamp       time.Time `gorm:"not null;index:idx_token_tax_dist_time" json:"timestamp"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (TokenTaxDistribution) TableName() string {
+	return "token_tax_distributions"
+}
+
+// TaxPayerTotal 付税方的累计税费
+type TaxPayerTotal struct {
+	Payer    string `json:"payer"`
+	TaxCount int64  `json:"tax_count"`
+	TotalTax string `json:"total_tax"`
+}
+
+// TokenTaxFilter 税费查询条件，零值字段不参与过滤
+type TokenTaxFilter struct {
+	ChainID int64
+	Payer   string
+	Kind    string
+	Limit   int
+}
+
+// TaxRepository 代币税费数据仓库
+type TaxRepository struct {
+	db *DB
+}
+
+// NewTaxRepository 创建代币税费数据仓库
+func NewTaxRepository(db *DB) *TaxRepository {
+	return &TaxRepository{db: db}
+}
+
+// Apply 在一个事务中登记日志并执行状态更新，日志已应用过时不执行fn并返回false
+func (r *TaxRepository) Apply(entry *IndexedLog, fn func(tx *TaxTx) error) (bool, error) {
+	return applyIndexedLog(r.db, entry, func(tx *gorm.DB) error {
+		return fn(&TaxTx{tx: tx, chainID: entry.ChainID, contract: entry.ContractAddress})
+	})
+}
+
+// TaxTx 事务内的税费数据操作，限定在一条链的代币合约上
+type TaxTx struct {
+	tx       *gorm.DB
+	chainID  int64
+	contract string
+}
+
+// GetState 获取税费状态，不存在时返回nil
+func (t *TaxTx) GetState() (*TokenTaxState, error) {
+	var state TokenTaxState
+	err := t.tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ?", t.chainID, t.contract).
+		First(&state).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取税费状态失败: %w", err)
+	}
+	return &state, nil
+}
+
+// SaveState 保存税费状态
+func (t *TaxTx) SaveState(state *TokenTaxState) error {
+	state.ChainID, state.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Save(state).Error; err != nil {
+		return fmt.Errorf("保存税费状态失败: %w", err)
+	}
+	return nil
+}
+
+// CreateTax 记录带税转账
+func (t *TaxTx) CreateTax(tax *TokenTax) error {
+	tax.ChainID, tax.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Create(tax).Error; err != nil {
+		return fmt.Errorf("记录税费失败: %w", err)
+	}
+	return nil
+}
+
+// CreateDistribution 记录税费分配
+func (t *TaxTx) CreateDistribution(distribution *TokenTaxDistribution) error {
+	distribution.ChainID, distribution.ContractAddress = t.chainID, t.contract
+	if err := t.tx.Create(distribution).Error; err != nil {
+		return fmt.Errorf("记录税费分配失败: %w", err)
+	}
+	return nil
+}
+
+// TagTaxTransfer 把税费那一条Transfer写入的余额变动标记为税费：
+// 付税方的transfer_out改为tax_paid，代币合约的transfer_in改为tax_collected，返回标记的行数
+func (t *TaxTx) TagTaxTransfer(txHash string, logIndex uint, payer, amount string) (int64, error) {
+	base := t.tx.Model(&BalanceChange{}).
+		Where("chain_id = ? AND tx_hash = ? AND log_index = ? AND change_amount = ?", t.chainID, txHash, logIndex, amount)
+
+	paid := base.Session(&gorm.Session{}).
+		Where("user_address = ? AND change_type = ?", payer, ChangeTypeTransferOut).
+		Update("change_type", ChangeTypeTaxPaid)
+	if paid.Error != nil {
+		return 0, fmt.Errorf("标记付税余额变动失败: %w", paid.Error)
+	}
+	collected := base.Session(&gorm.Session{}).
+		Where("user_address = ? AND change_type = ?", t.contract, ChangeTypeTransferIn).
+		Update("change_type", ChangeTypeTaxIn)
+	if collected.Error != nil {
+		return 0, fmt.Errorf("标记收税余额变动失败: %w", collected.Error)
+	}
+	return paid.RowsAffected + collected.RowsAffected, nil
+}
+
+// GetState 获取链上代币的税费状态
+func (r *TaxRepository) GetState(chainID int64) (*TokenTaxState, error) {
+	var state TokenTaxState
+	err := r.db.Where("chain_id = ?", chainID).Order("id ASC").First(&state).Error
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// ListTaxes 按条件查询带税转账，按时间倒序
+func (r *TaxRepository) ListTaxes(filter TokenTaxFilter) ([]TokenTax, error) {
+	query := r.db.Where("chain_id = ?", filter.ChainID)
+	if filter.Payer != "" {
+		query = query.Where("payer = ?", filter.Payer)
+	}
+	if filter.Kind != "" {
+		query = query.Where("kind = ?", filter.Kind)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	var taxes []TokenTax
+	err := query.Order("block_number DESC, log_index DESC").Find(&taxes).Error
+	return taxes, err
+}
+
+// ListDistributions 获取税费分配记录，按时间倒序
+func (r *TaxRepository) ListDistributions(chainID int64, limit int) ([]TokenTaxDistribution, error) {
+	var distributions []TokenTaxDistribution
+	err := r.db.Where("chain_id = ?", chainID).
+		Order("block_number DESC, log_index DESC").
+		Limit(limit).
+		Find(&distributions).Error
+	return distributions, err
+}
+
+// TopPayers 累计付税最多的地址，payer不为空时只统计该地址
+func (r *TaxRepository) TopPayers(chainID int64, payer string, limit int) ([]TaxPayerTotal, error) {
+	query := r.db.Model(&TokenTax{}).
+		Select("payer, COUNT(*) AS tax_count, CAST(SUM(tax) AS CHAR) AS total_tax").
+		Where("chain_id = ?", chainID)
+	if payer != "" {
+		query = query.Where("payer = ?", payer)
+	}
+	var totals []TaxPayerTotal
+	err := query.Group("payer").Order("SUM(tax) DESC").Limit(limit).Scan(&totals).Error
+	return totals, err
+}
+
+// ForEachTaxInTimeRange 按区块顺序遍历 [start, end) 时间范围内的带税转账
+func (r *TaxRepository) ForEachTaxInTimeRange(chainID int64, start, end time.Time, fn func(tax TokenTax) error) error {
+	rows, err := r.db.Model(&TokenTax{}).
+		Where("chain_id = ? AND timestamp >= ? AND timestamp < ?", chainID, start, end).
+		Order("block_number ASC, log_index ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tax TokenTax
+		if err := r.db.ScanRows(rows, &tax); err != nil {
+			return err
+		}
+		if err := fn(tax); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ListDistributionsInTimeRange 获取 [start, end) 时间范围内的税费分配，按区块顺序
+func (r *TaxRepository) ListDistributionsInTimeRange(chainID int64, start, end time.Time) ([]TokenTaxDistribution, error) {
+	var distributions []TokenTaxDistribution
+	err := r.db.Where("chain_id = ? AND timestamp >= ? AND timestamp < ?", chainID, start, end).
+		Order("block_number ASC, log_index ASC").
+		Find(&distributions).Error
+	return distributions, err
+}
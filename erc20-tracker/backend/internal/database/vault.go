@@ -0,0 +1,177 @@
+package database
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// VaultShareChange 资金池（质押合约、交易对）中受益人份额的变动，由资金池自己的事件产生
+// 质押合约的份额就是代币数量，交易对的份额是LP代币，按VaultSnapshot折算成代币
+type VaultShareChange struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID      int64     `gorm:"not null;index:idx_vault_share_owner;index:idx_vault_share_time" json:"chain_id"`
+	VaultAddress string    `gorm:"type:varchar(42);not null;index:idx_vault_share_owner;index:idx_vault_share_time" json:"vault_address"`
+	Owner        string    `gorm:"type:varchar(42);not null;index:idx_vault_share_owner" json:"owner"`
+	Delta        string    `gorm:"type:decimal(65,0);not null" json:"delta"` // 份额变化，可以为负
+	TxHash       string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex     uint      `gorm:"not null" json:"log_index"`
+	BlockNumber  uint64    `gorm:"not null" json:"block_number"`
+	Timestamp    time.Time `gorm:"not null;index:idx_vault_share_owner;index:idx_vault_share_time" json:"timestamp"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (VaultShareChange) TableName() string {
+	return "vault_share_changes"
+}
+
+// VaultSnapshot 资金池的份额总量及其持有的代币数量，每次变化记录一条
+type VaultSnapshot struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ChainID      int64     `gorm:"not null;index:idx_vault_snapshot" json:"chain_id"`
+	VaultAddress string    `gorm:"type:varchar(42);not null;index:idx_vault_snapshot" json:"vault_address"`
+	TotalShares  string    `gorm:"type:decimal(65,0);not null" json:"total_shares"`
+	TotalAssets  string    `gorm:"type:decimal(65,0);not null" json:"total_assets"` // 资金池持有的追踪代币
+	TxHash       string    `gorm:"type:varchar(66);not null" json:"tx_hash"`
+	LogIndex     uint      `gorm:"not null" json:"log_index"`
+	BlockNumber  uint64    `gorm:"not null" json:"block_number"`
+	Timestamp    time.Time `gorm:"not null;index:idx_vault_snapshot" json:"timestamp"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (VaultSnapshot) TableName() string {
+	return "vault_snapshots"
+}
+
+// VaultOwner 资金池受益人及其第一次份额变动的时间
+type VaultOwner struct {
+	Owner      string    `json:"owner"`
+	FirstShare time.Time `json:"first_share"`
+}
+
+// recordVaultShare 在事务中记录份额变动
+func recordVaultShare(tx *gorm.DB, change *VaultShareChange) error {
+	if err := tx.Create(change).Error; err != nil {
+		return fmt.Errorf("记录资金池份额变动失败: %w", err)
+	}
+	return nil
+}
+
+// RecordVaultShare 记录质押合约中受益人的份额变动
+func (t *StakeTx) RecordVaultShare(change *VaultShareChange) error {
+	change.ChainID = t.chainID
+	change.VaultAddress = t.contract
+	return recordVaultShare(t.tx, change)
+}
+
+// RecordVaultShare 记录交易对中LP持有人的份额变动
+func (t *DexTx) RecordVaultShare(change *VaultShareChange) error {
+	change.ChainID = t.chainID
+	return recordVaultShare(t.tx, change)
+}
+
+// RecordVaultSnapshot 记录交易对的LP总量和代币储备
+func (t *DexTx) RecordVaultSnapshot(snapshot *VaultSnapshot) error {
+	snapshot.ChainID = t.chainID
+	if err := t.tx.Create(snapshot).Error; err != nil {
+		return fmt.Errorf("记录资金池快照失败: %w", err)
+	}
+	return nil
+}
+
+// VaultRepository 资金池份额数据仓库
+type VaultRepository struct {
+	db *DB
+}
+
+// NewVaultRepository 创建资金池份额数据仓库
+func NewVaultRepository(db *DB) *VaultRepository {
+	return &VaultRepository{db: db}
+}
+
+// SharesAt 受益人在资金池中截至at（不含）的份额
+func (r *VaultRepository) SharesAt(chainID int64, vault, owner string, at time.Time) (*big.Int, error) {
+	var total string
+	err := r.db.Model(&VaultShareChange{}).
+		Select("COALESCE(SUM(delta), 0)").
+		Where("chain_id = ? AND vault_address = ? AND owner = ? AND timestamp < ?", chainID, vault, owner, at).
+		Scan(&total).Error
+	if err != nil {
+		return nil, fmt.Errorf("统计资金池份额失败: %w", err)
+	}
+	return ParseDecimal(total), nil
+}
+
+// ListShareChanges 获取受益人在 [start, end) 时间范围内的份额变动，按时间顺序
+func (r *VaultRepository) ListShareChanges(chainID int64, vault, owner string, start, end time.Time) ([]VaultShareChange, error) {
+	var changes []VaultShareChange
+	err := r.db.Where("chain_id = ? AND vault_address = ? AND owner = ? AND timestamp >= ? AND timestamp < ?", chainID, vault, owner, start, end).
+		Order("block_number ASC, log_index ASC").
+		Find(&changes).Error
+	return changes, err
+}
+
+// ListOwnerVaults 获取受益人在end之前有过份额变动的资金池
+func (r *VaultRepository) ListOwnerVaults(chainID int64, owner string, vaults []string, end time.Time) ([]string, error) {
+	var result []string
+	err := r.db.Model(&VaultShareChange{}).
+		Distinct("vault_address").
+		Where("chain_id = ? AND owner = ? AND vault_address IN ? AND timestamp < ?", chainID, owner, vaults, end).
+		Pluck("vault_address", &result).Error
+	return result, err
+}
+
+// FirstShareTime 受益人在资金池中第一次份额变动的时间，没有变动时返回零值
+func (r *VaultRepository) FirstShareTime(chainID int64, owner string, vaults []string) (time.Time, error) {
+	var change VaultShareChange
+	err := r.db.Where("chain_id = ? AND owner = ? AND vault_address IN ?", chainID, owner, vaults).
+		Order("timestamp ASC").
+		First(&change).Error
+	if err == gorm.ErrRecordNotFound {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("获取首次份额变动失败: %w", err)
+	}
+	return change.Timestamp, nil
+}
+
+// ListOwnersWithoutPoints 获取在资金池中有份额、但还没有积分记录的受益人
+func (r *VaultRepository) ListOwnersWithoutPoints(chainID int64, vaults []string, before time.Time) ([]VaultOwner, error) {
+	var owners []VaultOwner
+	err := r.db.Table(VaultShareChange{}.TableName()+" AS v").
+		Select("v.owner AS owner, MIN(v.timestamp) AS first_share").
+		Joins("LEFT JOIN "+UserPoints{}.TableName()+" AS p ON p.user_address = v.owner AND p.chain_id = v.chain_id").
+		Where("v.chain_id = ? AND v.vault_address IN ? AND v.timestamp < ? AND p.id IS NULL", chainID, vaults, before).
+		Group("v.owner").
+		Scan(&owners).Error
+	return owners, err
+}
+
+// SnapshotAt 资金池在at（不含）之前的最后一个快照，没有时返回nil
+func (r *VaultRepository) SnapshotAt(chainID int64, vault string, at time.Time) (*VaultSnapshot, error) {
+	var snapshot VaultSnapshot
+	err := r.db.Where("chain_id = ? AND vault_address = ? AND timestamp < ?", chainID, vault, at).
+		Order("timestamp DESC, id DESC").
+		First(&snapshot).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取资金池快照失败: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// ListSnapshots 获取资金池在 [start, end) 时间范围内的快照，按时间顺序
+func (r *VaultRepository) ListSnapshots(chainID int64, vault string, start, end time.Time) ([]VaultSnapshot, error) {
+	var snapshots []VaultSnapshot
+	err := r.db.Where("chain_id = ? AND vault_address = ? AND timestamp >= ? AND timestamp < ?", chainID, vault, start, end).
+		Order("timestamp ASC, id ASC").
+		Find(&snapshots).Error
+	return snapshots, err
+}
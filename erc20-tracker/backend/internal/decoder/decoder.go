@@ -0,0 +1,261 @@
+package decoder
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// builtinABIs 内置的ABI，始终最先加载，保证代币事件的参数名稳定
+//
+//go:embed abis/*.json
+var builtinABIs embed.FS
+
+// ErrUnknownEvent 注册表中没有与日志匹配的事件定义
+var ErrUnknownEvent = errors.New("未知事件")
+
+// Definition 一个已注册的事件定义
+type Definition struct {
+	Source string // ABI来源：合约名或文件名
+	Event  abi.Event
+}
+
+// Registry 按topic0索引的事件定义注册表
+// 加载完成后只读，可以在多个监听器之间共享
+type Registry struct {
+	events map[common.Hash][]Definition
+}
+
+// NewRegistry 创建空注册表
+func NewRegistry() *Registry {
+	return &Registry{events: make(map[common.Hash][]Definition)}
+}
+
+// Load 创建注册表：先加载内置ABI，再按顺序加载paths中的文件或目录
+func Load(paths []string) (*Registry, error) {
+	r := NewRegistry()
+
+	entries, err := builtinABIs.ReadDir("abis")
+	if err != nil {
+		return nil, fmt.Errorf("读取内置ABI失败: %w", err)
+	}
+	for _, entry := range entries {
+		data, err := builtinABIs.ReadFile("abis/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("读取内置ABI失败: %w", err)
+		}
+		if _, err := r.LoadJSON(sourceName(entry.Name()), data); err != nil {
+			return nil, fmt.Errorf("解析内置ABI %s 失败: %w", entry.Name(), err)
+		}
+	}
+
+	for _, path := range paths {
+		if err := r.LoadPath(path); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// LoadPath 加载ABI文件或目录
+// 目录会递归查找*.json和*.abi，跳过Hardhat的调试文件、build-info以及不含ABI的JSON
+func (r *Registry) LoadPath(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("读取ABI路径失败: %w", err)
+	}
+
+	if !info.IsDir() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("读取ABI文件失败: %w", err)
+		}
+		if _, err := r.LoadJSON(sourceName(path), data); err != nil {
+			return fmt.Errorf("解析ABI文件 %s 失败: %w", path, err)
+		}
+		return nil
+	}
+
+	return filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "build-info" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := filepath.Ext(file); (ext != ".json" && ext != ".abi") || strings.HasSuffix(file, ".dbg.json") {
+			return nil
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("读取ABI文件失败: %w", err)
+		}
+		count, err := r.LoadJSON(sourceName(file), data)
+		if err != nil {
+			logger.WithFields(map[string]interface{}{
+				"file":  file,
+				"error": err,
+			}).Debug("跳过无法解析的ABI文件")
+			return nil
+		}
+		logger.WithFields(map[string]interface{}{
+			"file":   file,
+			"events": count,
+		}).Debug("已加载ABI")
+		return nil
+	})
+}
+
+// LoadJSON 解析ABI数组或包含abi字段的编译产物（Hardhat、Foundry），返回新增的事件数
+// 产物中的contractName优先于source作为来源名
+func (r *Registry) LoadJSON(source string, data []byte) (int, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var artifact struct {
+			ContractName string          `json:"contractName"`
+			ABI          json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			return 0, fmt.Errorf("解析编译产物失败: %w", err)
+		}
+		if len(artifact.ABI) == 0 {
+			return 0, errors.New("编译产物中没有abi字段")
+		}
+		if artifact.ContractName != "" {
+			source = artifact.ContractName
+		}
+		data = artifact.ABI
+	}
+
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("解析ABI失败: %w", err)
+	}
+
+	count := 0
+	for _, event := range parsed.Events {
+		if r.Add(source, event) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Add 注册一个事件定义，返回是否新增
+// 匿名事件没有topic0，不注册；相同签名且indexed参数数量相同的定义只保留第一个
+func (r *Registry) Add(source string, event abi.Event) bool {
+	if event.Anonymous {
+		return false
+	}
+
+	indexed := indexedCount(event)
+	for _, existing := range r.events[event.ID] {
+		if indexedCount(existing.Event) == indexed {
+			return false
+		}
+	}
+	r.events[event.ID] = append(r.events[event.ID], Definition{Source: source, Event: event})
+	return true
+}
+
+// Known 判断topic0是否有已注册的事件定义
+func (r *Registry) Known(topic common.Hash) bool {
+	return len(r.events[topic]) > 0
+}
+
+// Definitions 返回所有事件定义，按来源和事件名排序
+func (r *Registry) Definitions() []Definition {
+	var defs []Definition
+	for _, candidates := range r.events {
+		defs = append(defs, candidates...)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].Source != defs[j].Source {
+			return defs[i].Source < defs[j].Source
+		}
+		return defs[i].Event.Sig < defs[j].Event.Sig
+	})
+	return defs
+}
+
+// Decode 按topic0查找事件定义并解码indexed和非indexed参数
+// 找不到定义时返回包装了ErrUnknownEvent的错误
+// 同一签名可能有indexed布局不同的定义（如ERC20和ERC721的Transfer），按topics数量选择
+func (r *Registry) Decode(vLog types.Log) (*Event, error) {
+	if len(vLog.Topics) == 0 {
+		return nil, fmt.Errorf("%w: 日志没有topic", ErrUnknownEvent)
+	}
+
+	var def *Definition
+	for i, candidate := range r.events[vLog.Topics[0]] {
+		if indexedCount(candidate.Event) == len(vLog.Topics)-1 {
+			def = &r.events[vLog.Topics[0]][i]
+			break
+		}
+	}
+	if def == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, vLog.Topics[0].Hex())
+	}
+
+	args := make(map[string]interface{}, len(def.Event.Inputs))
+	if err := def.Event.Inputs.UnpackIntoMap(args, vLog.Data); err != nil {
+		return nil, fmt.Errorf("解析%s事件数据失败: %w", def.Event.Name, err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range def.Event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, vLog.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("解析%s事件topics失败: %w", def.Event.Name, err)
+	}
+
+	return &Event{
+		Name:      def.Event.RawName,
+		Signature: def.Event.Sig,
+		Source:    def.Source,
+		Inputs:    def.Event.Inputs,
+		Args:      args,
+	}, nil
+}
+
+// Topic 计算事件签名对应的topic0
+func Topic(signature string) common.Hash {
+	return crypto.Keccak256Hash([]byte(signature))
+}
+
+// indexedCount 事件的indexed参数数量
+func indexedCount(event abi.Event) int {
+	count := 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			count++
+		}
+	}
+	return count
+}
+
+// sourceName 由文件路径得到来源名（去掉目录和扩展名）
+func sourceName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
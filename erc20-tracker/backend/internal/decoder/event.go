@@ -0,0 +1,125 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Event 解码后的事件
+type Event struct {
+	Name      string                 // 事件名，如Transfer
+	Signature string                 // 规范签名，如Transfer(address,address,uint256)
+	Source    string                 // 定义所在的ABI来源
+	Inputs    abi.Arguments          // 参数定义，顺序与ABI一致
+	Args      map[string]interface{} // 参数名到go-ethereum解码值的映射
+}
+
+// Address 获取address类型的参数
+func (e *Event) Address(name string) (common.Address, error) {
+	value, err := e.arg(name)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, ok := value.(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("事件 %s 的参数 %s 不是address类型", e.Name, name)
+	}
+	return address, nil
+}
+
+// BigInt 获取uint256/int256等大整数参数
+func (e *Event) BigInt(name string) (*big.Int, error) {
+	value, err := e.arg(name)
+	if err != nil {
+		return nil, err
+	}
+	number, ok := value.(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("事件 %s 的参数 %s 不是大整数类型", e.Name, name)
+	}
+	return number, nil
+}
+
+// Values 返回可直接序列化为JSON的参数值
+// 大整数转为十进制字符串，地址为校验和格式，字节和哈希为0x十六进制
+func (e *Event) Values() map[string]interface{} {
+	values := make(map[string]interface{}, len(e.Inputs))
+	for _, input := range e.Inputs {
+		values[input.Name] = FormatValue(e.Args[input.Name])
+	}
+	return values
+}
+
+// JSON 把参数序列化为JSON对象
+func (e *Event) JSON() (string, error) {
+	encoded, err := json.Marshal(e.Values())
+	if err != nil {
+		return "", fmt.Errorf("序列化事件参数失败: %w", err)
+	}
+	return string(encoded), nil
+}
+
+// arg 获取参数的原始值
+func (e *Event) arg(name string) (interface{}, error) {
+	value, ok := e.Args[name]
+	if !ok {
+		return nil, fmt.Errorf("事件 %s 缺少参数 %s", e.Name, name)
+	}
+	return value, nil
+}
+
+// FormatValue 把go-ethereum解码出的值转换为便于存储和输出的形式
+func FormatValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		// 定长字节数组（bytes32等）
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			buf := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(buf), rv)
+			return hexutil.Encode(buf)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			items[i] = FormatValue(rv.Index(i).Interface())
+		}
+		return items
+	case reflect.Struct:
+		// tuple参数解码为匿名结构体，字段的json标签为ABI中的参数名
+		fields := make(map[string]interface{}, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			fields[name] = FormatValue(rv.Field(i).Interface())
+		}
+		return fields
+	case reflect.Uint64, reflect.Int64:
+		// 64位整数转为字符串，避免JSON消费方丢失精度
+		return fmt.Sprint(value)
+	}
+	return value
+}
@@ -0,0 +1,643 @@
+package dex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	v2bind "erc20-tracker/backend/internal/bindings/uniswapv2"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// tokenDecimals 追踪的代币精度，与积分计算和命令行输出的假设一致
+const tokenDecimals = 18
+
+func init() {
+	event.RegisterIndexer("dex", func(chain config.ChainConfig, _ *config.Config, repos *database.Repositories) (event.Indexer, error) {
+		if chain.UniswapV2Factory == "" && len(chain.UniswapV2Pairs) == 0 {
+			return nil, nil
+		}
+		return NewIndexer(chain, repos.Dex)
+	})
+}
+
+// factoryHandler 工厂事件的处理函数
+type factoryHandler func(tx *database.DexTx, vLog types.Log, timestamp time.Time) error
+
+// pairHandler 交易对事件的处理函数，pair在事务中加锁读取，处理后统一保存
+type pairHandler func(tx *database.DexTx, pair *database.DexPair, vLog types.Log, timestamp time.Time) error
+
+// pairInfo 交易对的静态信息，交易对第一次出现事件时用来创建记录
+type pairInfo struct {
+	factory       string
+	tokenIsToken0 bool
+	source        string
+}
+
+// pendingLiquidity 同一交易中Mint/Burn之前的LP Transfer，用来确定流动性提供者和LP数量
+type pendingLiquidity struct {
+	txHash   common.Hash
+	mintTo   common.Address
+	minted   *big.Int
+	burnFrom common.Address
+	burned   *big.Int
+}
+
+// Indexer Uniswap V2交易对索引器
+// 跟随工厂发现代币与计价代币的交易对，记录储备、价格K线、Swap成交量、流动性变动和LP持仓
+type Indexer struct {
+	chain         config.ChainConfig
+	token         common.Address
+	quote         common.Address
+	quoteDecimals int
+	factory       common.Address // 未配置工厂时为零地址
+	factoryF      *v2bind.UniswapV2FactoryFilterer
+	pairF         *v2bind.UniswapV2PairFilterer
+	repo          *database.DexRepository
+
+	factoryEvents *event.EventHandlers[factoryHandler]
+	pairEvents    *event.EventHandlers[pairHandler]
+
+	mu       sync.RWMutex
+	pairs    map[common.Address]pairInfo
+	pending  map[common.Address]*pendingLiquidity
+	discover func(address common.Address)
+}
+
+// NewIndexer 创建交易对索引器，并加载之前发现的交易对
+func NewIndexer(chain config.ChainConfig, repo *database.DexRepository) (*Indexer, error) {
+	if !common.IsHexAddress(chain.ContractAddress) {
+		return nil, fmt.Errorf("无效的代币合约地址: %q", chain.ContractAddress)
+	}
+	if !common.IsHexAddress(chain.UniswapV2QuoteToken) {
+		return nil, fmt.Errorf("无效的计价代币地址: %q", chain.UniswapV2QuoteToken)
+	}
+
+	idx := &Indexer{
+		chain:         chain,
+		token:         common.HexToAddress(chain.ContractAddress),
+		quote:         common.HexToAddress(chain.UniswapV2QuoteToken),
+		quoteDecimals: chain.UniswapV2QuoteDecimals,
+		repo:          repo,
+		pairs:         make(map[common.Address]pairInfo),
+		pending:       make(map[common.Address]*pendingLiquidity),
+	}
+	if chain.UniswapV2Factory != "" {
+		if !common.IsHexAddress(chain.UniswapV2Factory) {
+			return nil, fmt.Errorf("无效的Uniswap V2工厂地址: %q", chain.UniswapV2Factory)
+		}
+		idx.factory = common.HexToAddress(chain.UniswapV2Factory)
+	}
+
+	// Uniswap V2按地址大小排序token0和token1
+	tokenIsToken0 := bytes.Compare(idx.token.Bytes(), idx.quote.Bytes()) < 0
+	for _, value := range chain.UniswapV2Pairs {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("无效的交易对地址: %q", value)
+		}
+		idx.addPair(common.HexToAddress(value), pairInfo{tokenIsToken0: tokenIsToken0, source: database.DexPairSourceConfig})
+	}
+
+	// 工厂之前创建的交易对：服务重启后不会再收到它们的PairCreated事件
+	known, err := repo.ListPairs(chain.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("获取已发现的交易对失败: %w", err)
+	}
+	for _, pair := range known {
+		idx.addPair(common.HexToAddress(pair.PairAddress), pairInfo{
+			factory:       pair.FactoryAddress,
+			tokenIsToken0: pair.TokenIsToken0,
+			source:        pair.Source,
+		})
+	}
+
+	if idx.factoryF, err = v2bind.NewUniswapV2FactoryFilterer(idx.factory, nil); err != nil {
+		return nil, fmt.Errorf("创建Uniswap V2工厂绑定失败: %w", err)
+	}
+	if idx.pairF, err = v2bind.NewUniswapV2PairFilterer(common.Address{}, nil); err != nil {
+		return nil, fmt.Errorf("创建Uniswap V2交易对绑定失败: %w", err)
+	}
+
+	if idx.factoryEvents, err = event.BindEvents(v2bind.UniswapV2FactoryMetaData.GetAbi, "Uniswap V2工厂", map[string]factoryHandler{
+		"PairCreated": idx.handlePairCreated,
+	}); err != nil {
+		return nil, err
+	}
+	if idx.pairEvents, err = event.BindEvents(v2bind.UniswapV2PairMetaData.GetAbi, "Uniswap V2交易对", map[string]pairHandler{
+		"Sync":     idx.handleSync,
+		"Swap":     idx.handleSwap,
+		"Mint":     idx.handleMint,
+		"Burn":     idx.handleBurn,
+		"Transfer": idx.handleTransfer,
+	}); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Name 索引器名称
+func (idx *Indexer) Name() string {
+	return "UniswapV2"
+}
+
+// ABIs 交易对和工厂合约的ABI
+func (idx *Indexer) ABIs() map[string]string {
+	return map[string]string{
+		"UniswapV2Pair":    v2bind.UniswapV2PairMetaData.ABI,
+		"UniswapV2Factory": v2bind.UniswapV2FactoryMetaData.ABI,
+	}
+}
+
+// Addresses 工厂合约和已知的交易对
+func (idx *Indexer) Addresses() []common.Address {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	addresses := make([]common.Address, 0, len(idx.pairs)+1)
+	if idx.factory != (common.Address{}) {
+		addresses = append(addresses, idx.factory)
+	}
+	for address := range idx.pairs {
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// SetDiscoverFunc 注册发现新交易对时的回调
+func (idx *Indexer) SetDiscoverFunc(fn func(address common.Address)) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.discover = fn
+}
+
+// Bootstrap 通过工厂的getPair查找代币与计价代币的交易对
+// 起始区块之前创建的交易对不会再收到PairCreated事件，需要在启动时主动查询
+func (idx *Indexer) Bootstrap(ctx context.Context, caller bind.ContractCaller) error {
+	if idx.factory == (common.Address{}) {
+		return nil
+	}
+
+	factory, err := v2bind.NewUniswapV2FactoryCaller(idx.factory, caller)
+	if err != nil {
+		return fmt.Errorf("创建Uniswap V2工厂绑定失败: %w", err)
+	}
+	pair, err := factory.GetPair(&bind.CallOpts{Context: ctx}, idx.token, idx.quote)
+	if err != nil {
+		return fmt.Errorf("查询交易对失败: %w", err)
+	}
+	if pair == (common.Address{}) {
+		return nil
+	}
+
+	added := idx.addPair(pair, pairInfo{
+		factory:       idx.factory.Hex(),
+		tokenIsToken0: bytes.Compare(idx.token.Bytes(), idx.quote.Bytes()) < 0,
+		source:        database.DexPairSourceGetPair,
+	})
+	if added {
+		logger.WithFields(map[string]interface{}{
+			"chain": idx.chain.Name,
+			"pair":  pair.Hex(),
+			"quote": idx.quote.Hex(),
+		}).Info("通过工厂getPair发现交易对")
+	}
+	return nil
+}
+
+// HandleLog 处理工厂和交易对的日志
+// 工厂创建的其他交易对直接忽略；交易对的Approval返回false，按通用合约事件保存
+func (idx *Indexer) HandleLog(vLog types.Log, timestamp time.Time) (bool, error) {
+	var (
+		name  string
+		apply func(tx *database.DexTx) error
+	)
+	switch {
+	case vLog.Address == idx.factory && idx.factory != (common.Address{}):
+		handler, eventName, ok := idx.factoryEvents.Lookup(vLog)
+		if !ok {
+			return false, nil
+		}
+		if !idx.isTrackedPair(vLog) {
+			return true, nil
+		}
+		name = eventName
+		apply = func(tx *database.DexTx) error { return handler(tx, vLog, timestamp) }
+	default:
+		handler, eventName, ok := idx.pairEvents.Lookup(vLog)
+		if !ok {
+			return false, nil
+		}
+		if eventName == "Transfer" {
+			idx.trackPending(vLog)
+		}
+		name = eventName
+		apply = func(tx *database.DexTx) error {
+			pair, err := idx.loadPair(tx, vLog)
+			if err != nil {
+				return err
+			}
+			if err := handler(tx, pair, vLog, timestamp); err != nil {
+				return err
+			}
+			return tx.SavePair(pair)
+		}
+	}
+
+	if err := event.ApplyLog(idx, idx.chain, name, vLog, idx.repo.Apply, apply); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isTrackedPair PairCreated是否是代币与计价代币的交易对，两个token都是indexed参数
+func (idx *Indexer) isTrackedPair(vLog types.Log) bool {
+	if len(vLog.Topics) < 3 {
+		return false
+	}
+	token0 := common.BytesToAddress(vLog.Topics[1].Bytes())
+	token1 := common.BytesToAddress(vLog.Topics[2].Bytes())
+	return (token0 == idx.token && token1 == idx.quote) || (token0 == idx.quote && token1 == idx.token)
+}
+
+// handlePairCreated 工厂创建了代币的交易对，加入监听
+func (idx *Indexer) handlePairCreated(tx *database.DexTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.factoryF.ParsePairCreated(vLog)
+	if err != nil {
+		return err
+	}
+
+	info := pairInfo{
+		factory:       idx.factory.Hex(),
+		tokenIsToken0: ev.Token0 == idx.token,
+		source:        database.DexPairSourceFactory,
+	}
+	existing, err := tx.GetPair(ev.Pair.Hex())
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		if err := tx.SavePair(idx.newPair(ev.Pair, info, vLog.BlockNumber)); err != nil {
+			return err
+		}
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain": idx.chain.Name,
+		"pair":  ev.Pair.Hex(),
+		"quote": idx.quote.Hex(),
+	}).Info("发现新的交易对")
+
+	// 事务提交失败时交易对也已加入监听，重新处理该日志时不会遗漏
+	if idx.addPair(ev.Pair, info) {
+		idx.mu.RLock()
+		discover := idx.discover
+		idx.mu.RUnlock()
+		if discover != nil {
+			discover(ev.Pair)
+		}
+	}
+	return nil
+}
+
+// handleSync 储备更新：记录储备和价格，更新当前小时的K线，并记录LP总量和代币储备的快照
+// 交易对的每次swap、mint、burn都会在对应事件之前发出Sync
+func (idx *Indexer) handleSync(tx *database.DexTx, pair *database.DexPair, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.pairF.ParseSync(vLog)
+	if err != nil {
+		return err
+	}
+
+	reserveToken, reserveQuote := pair.Reserves(ev.Reserve0, ev.Reserve1)
+	price := idx.price(reserveToken, reserveQuote)
+	if price > 0 {
+		candle, err := idx.loadCandle(tx, pair, timestamp)
+		if err != nil {
+			return err
+		}
+		candle.High = max(candle.High, price)
+		candle.Low = min(candle.Low, price)
+		candle.Close = price
+		if err := tx.SaveCandle(candle); err != nil {
+			return err
+		}
+		pair.Price = price
+	}
+
+	syncedAt := timestamp
+	pair.ReserveToken = reserveToken.String()
+	pair.ReserveQuote = reserveQuote.String()
+	pair.LastSyncBlock = vLog.BlockNumber
+	pair.LastSyncAt = &syncedAt
+
+	// 添加/移除流动性时LP的Transfer在Sync之前，此时的LP总量与储备一致
+	return tx.RecordVaultSnapshot(&database.VaultSnapshot{
+		VaultAddress: pair.PairAddress,
+		TotalShares:  pair.LPSupply,
+		TotalAssets:  pair.ReserveToken,
+		TxHash:       vLog.TxHash.Hex(),
+		LogIndex:     vLog.Index,
+		BlockNumber:  vLog.BlockNumber,
+		Timestamp:    timestamp,
+	})
+}
+
+// handleSwap 成交：按代币净流向判断买卖，累计成交量
+func (idx *Indexer) handleSwap(tx *database.DexTx, pair *database.DexPair, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.pairF.ParseSwap(vLog)
+	if err != nil {
+		return err
+	}
+
+	tokenIn, quoteIn := pair.Reserves(ev.Amount0In, ev.Amount1In)
+	tokenOut, quoteOut := pair.Reserves(ev.Amount0Out, ev.Amount1Out)
+
+	// 一般只有一侧输入，闪电兑换时两侧都可能有，按净额计算
+	side := database.DexSideBuy
+	amountToken := new(big.Int).Sub(tokenOut, tokenIn)
+	amountQuote := new(big.Int).Sub(quoteIn, quoteOut)
+	if amountToken.Sign() < 0 {
+		side = database.DexSideSell
+		amountToken.Neg(amountToken)
+		amountQuote.Neg(amountQuote)
+	}
+	if amountQuote.Sign() < 0 {
+		amountQuote.SetInt64(0)
+	}
+
+	swap := &database.DexSwap{
+		PairAddress: pair.PairAddress,
+		Sender:      ev.Sender.Hex(),
+		Recipient:   ev.To.Hex(),
+		Side:        side,
+		AmountToken: amountToken.String(),
+		AmountQuote: amountQuote.String(),
+		Price:       idx.price(amountToken, amountQuote),
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   timestamp,
+	}
+	if err := tx.CreateSwap(swap); err != nil {
+		return err
+	}
+
+	candle, err := idx.loadCandle(tx, pair, timestamp)
+	if err != nil {
+		return err
+	}
+	candle.VolumeToken = database.AddDecimal(candle.VolumeToken, amountToken)
+	candle.VolumeQuote = database.AddDecimal(candle.VolumeQuote, amountQuote)
+	candle.SwapCount++
+	if err := tx.SaveCandle(candle); err != nil {
+		return err
+	}
+
+	pair.VolumeToken = database.AddDecimal(pair.VolumeToken, amountToken)
+	pair.VolumeQuote = database.AddDecimal(pair.VolumeQuote, amountQuote)
+	pair.SwapCount++
+	return nil
+}
+
+// handleMint 添加流动性，LP接收者来自同一交易中之前的 Transfer(0, to, liquidity)
+func (idx *Indexer) handleMint(tx *database.DexTx, pair *database.DexPair, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.pairF.ParseMint(vLog)
+	if err != nil {
+		return err
+	}
+
+	amountToken, amountQuote := pair.Reserves(ev.Amount0, ev.Amount1)
+	provider, liquidity := ev.Sender, big.NewInt(0)
+	if pending := idx.takePending(vLog); pending != nil && pending.minted != nil {
+		provider, liquidity = pending.mintTo, pending.minted
+	}
+	return idx.recordLiquidity(tx, pair, database.DexLiquidityAdd, provider, ev.Sender, amountToken, amountQuote, liquidity, vLog, timestamp)
+}
+
+// handleBurn 移除流动性，LP持有者来自同一交易中之前把LP转入交易对的Transfer
+func (idx *Indexer) handleBurn(tx *database.DexTx, pair *database.DexPair, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.pairF.ParseBurn(vLog)
+	if err != nil {
+		return err
+	}
+
+	amountToken, amountQuote := pair.Reserves(ev.Amount0, ev.Amount1)
+	provider, liquidity := ev.To, big.NewInt(0)
+	if pending := idx.takePending(vLog); pending != nil && pending.burned != nil {
+		liquidity = pending.burned
+		if pending.burnFrom != (common.Address{}) {
+			provider = pending.burnFrom
+		}
+	}
+	return idx.recordLiquidity(tx, pair, database.DexLiquidityRemove, provider, ev.Sender, amountToken, amountQuote, liquidity, vLog, timestamp)
+}
+
+// handleTransfer LP代币转移：维护LP持仓和总量，并记录为资金池份额变动
+// 首次添加流动性时锁定的MINIMUM_LIQUIDITY是 Transfer(0, 0)，计入总量但不属于任何地址
+func (idx *Indexer) handleTransfer(tx *database.DexTx, pair *database.DexPair, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.pairF.ParseTransfer(vLog)
+	if err != nil {
+		return err
+	}
+	if ev.Value.Sign() == 0 {
+		return nil
+	}
+
+	zero := common.Address{}
+	supply := database.ParseDecimal(pair.LPSupply)
+	if ev.From == zero {
+		supply.Add(supply, ev.Value)
+	} else {
+		if err := idx.addLPBalance(tx, pair, ev.From, new(big.Int).Neg(ev.Value), vLog, timestamp); err != nil {
+			return err
+		}
+		if ev.To == zero {
+			supply.Sub(supply, ev.Value)
+		}
+	}
+	if ev.To != zero {
+		if err := idx.addLPBalance(tx, pair, ev.To, ev.Value, vLog, timestamp); err != nil {
+			return err
+		}
+	}
+	pair.LPSupply = supply.String()
+	return nil
+}
+
+// addLPBalance 调整地址的LP持仓并记录份额变动
+func (idx *Indexer) addLPBalance(tx *database.DexTx, pair *database.DexPair, owner common.Address, delta *big.Int, vLog types.Log, timestamp time.Time) error {
+	if _, err := tx.AddLPBalance(pair.PairAddress, owner.Hex(), delta, vLog.BlockNumber); err != nil {
+		return err
+	}
+	return tx.RecordVaultShare(&database.VaultShareChange{
+		VaultAddress: pair.PairAddress,
+		Owner:        owner.Hex(),
+		Delta:        delta.String(),
+		TxHash:       vLog.TxHash.Hex(),
+		LogIndex:     vLog.Index,
+		BlockNumber:  vLog.BlockNumber,
+		Timestamp:    timestamp,
+	})
+}
+
+// recordLiquidity 记录流动性变动
+func (idx *Indexer) recordLiquidity(tx *database.DexTx, pair *database.DexPair, kind string, provider, sender common.Address,
+	amountToken, amountQuote, liquidity *big.Int, vLog types.Log, timestamp time.Time) error {
+	event := &database.DexLiquidityEvent{
+		PairAddress: pair.PairAddress,
+		Kind:        kind,
+		Provider:    provider.Hex(),
+		Sender:      sender.Hex(),
+		AmountToken: amountToken.String(),
+		AmountQuote: amountQuote.String(),
+		Liquidity:   liquidity.String(),
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   timestamp,
+	}
+	if err := tx.CreateLiquidityEvent(event); err != nil {
+		return err
+	}
+	pair.LiquidityEvents++
+	return nil
+}
+
+// loadPair 在事务中获取交易对；配置的交易对和重放时清空过的交易对第一次出现事件时创建
+func (idx *Indexer) loadPair(tx *database.DexTx, vLog types.Log) (*database.DexPair, error) {
+	pair, err := tx.GetPair(vLog.Address.Hex())
+	if err != nil || pair != nil {
+		return pair, err
+	}
+
+	idx.mu.RLock()
+	info, ok := idx.pairs[vLog.Address]
+	idx.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("未知的交易对: %s", vLog.Address.Hex())
+	}
+	return idx.newPair(vLog.Address, info, vLog.BlockNumber), nil
+}
+
+// loadCandle 获取交易对当前小时的K线，不存在时以上一次的价格开盘
+func (idx *Indexer) loadCandle(tx *database.DexTx, pair *database.DexPair, timestamp time.Time) (*database.DexCandle, error) {
+	bucket := timestamp.UTC().Truncate(time.Hour)
+	candle, err := tx.GetCandle(pair.PairAddress, bucket)
+	if err != nil || candle != nil {
+		return candle, err
+	}
+	return &database.DexCandle{
+		PairAddress: pair.PairAddress,
+		BucketStart: bucket,
+		Open:        pair.Price,
+		High:        pair.Price,
+		Low:         pair.Price,
+		Close:       pair.Price,
+		VolumeToken: "0",
+		VolumeQuote: "0",
+	}, nil
+}
+
+// price 计算每个代币值多少计价代币（已按精度换算），代币数量为0时返回0
+func (idx *Indexer) price(tokenAmount, quoteAmount *big.Int) float64 {
+	if tokenAmount.Sign() <= 0 {
+		return 0
+	}
+	ratio := new(big.Float).Quo(new(big.Float).SetInt(quoteAmount), new(big.Float).SetInt(tokenAmount))
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(tokenDecimals-idx.quoteDecimals))), nil))
+	if tokenDecimals > idx.quoteDecimals {
+		ratio.Mul(ratio, scale)
+	} else {
+		ratio.Quo(ratio, scale)
+	}
+	result, _ := ratio.Float64()
+	return result
+}
+
+// trackPending 记录交易中Mint/Burn之前的LP Transfer
+// 在登记日志之外执行，Transfer已处理、Mint/Burn重新处理时也能取到
+func (idx *Indexer) trackPending(vLog types.Log) {
+	ev, err := idx.pairF.ParseTransfer(vLog)
+	if err != nil {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	pending := idx.pending[vLog.Address]
+	if pending == nil || pending.txHash != vLog.TxHash {
+		pending = &pendingLiquidity{txHash: vLog.TxHash}
+		idx.pending[vLog.Address] = pending
+	}
+
+	zero := common.Address{}
+	switch {
+	case ev.From == zero && ev.To != zero:
+		pending.mintTo, pending.minted = ev.To, ev.Value
+	case ev.To == vLog.Address:
+		pending.burnFrom = ev.From
+	case ev.From == vLog.Address && ev.To == zero:
+		pending.burned = ev.Value
+	}
+}
+
+// takePending 取出同一交易中记录的LP Transfer
+func (idx *Indexer) takePending(vLog types.Log) *pendingLiquidity {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	pending := idx.pending[vLog.Address]
+	if pending == nil || pending.txHash != vLog.TxHash {
+		return nil
+	}
+	delete(idx.pending, vLog.Address)
+	return pending
+}
+
+// addPair 记录已知的交易对，返回是否新增
+func (idx *Indexer) addPair(address common.Address, info pairInfo) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if _, ok := idx.pairs[address]; ok {
+		return false
+	}
+	idx.pairs[address] = info
+	return true
+}
+
+// newPair 创建零值交易对
+func (idx *Indexer) newPair(address common.Address, info pairInfo, block uint64) *database.DexPair {
+	return &database.DexPair{
+		PairAddress:     address.Hex(),
+		FactoryAddress:  info.factory,
+		TokenAddress:    idx.token.Hex(),
+		QuoteAddress:    idx.quote.Hex(),
+		TokenIsToken0:   info.tokenIsToken0,
+		Source:          info.source,
+		ReserveToken:    "0",
+		ReserveQuote:    "0",
+		LPSupply:        "0",
+		VolumeToken:     "0",
+		VolumeQuote:     "0",
+		DiscoveredBlock: block,
+	}
+}
+
+// abs 整数绝对值
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,231 @@
+package dex
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+
+	"erc20-tracker/backend/internal/database"
+)
+
+// K线周期
+const (
+	IntervalHour = "1h"
+	IntervalDay  = "1d"
+)
+
+const (
+	dayLayout = "2006-01-02"
+
+	// defaultTopProviders 交易对详情中返回的LP持仓数
+	defaultTopProviders = 20
+)
+
+// ErrPairNotFound 交易对不存在或还没有事件
+var ErrPairNotFound = errors.New("交易对没有索引数据")
+
+// Position LP持仓及其对应的代币和计价代币数量
+type Position struct {
+	database.DexLPPosition
+	Share       float64 `json:"share"` // 占LP总量的比例
+	TokenAmount string  `json:"token_amount"`
+	QuoteAmount string  `json:"quote_amount"`
+}
+
+// PairDetail 交易对及LP持仓最多的地址
+type PairDetail struct {
+	database.DexPair
+	TopProviders []Position `json:"top_providers"`
+}
+
+// Candle 价格K线，成交量为最小单位
+type Candle struct {
+	Start       time.Time `json:"start"`
+	Open        float64   `json:"open"`
+	High        float64   `json:"high"`
+	Low         float64   `json:"low"`
+	Close       float64   `json:"close"`
+	VolumeToken string    `json:"volume_token"`
+	VolumeQuote string    `json:"volume_quote"`
+	SwapCount   int64     `json:"swap_count"`
+}
+
+// PriceSeries 交易对在 [from, to] 日期范围内的K线
+type PriceSeries struct {
+	ChainID     int64    `json:"chain_id"`
+	PairAddress string   `json:"pair_address"`
+	Interval    string   `json:"interval"`
+	From        string   `json:"from"`
+	To          string   `json:"to"`
+	Candles     []Candle `json:"candles"`
+}
+
+// Service 交易对数据查询服务
+type Service struct {
+	repo *database.DexRepository
+	loc  *time.Location
+}
+
+// NewService 创建交易对查询服务，日K线按loc时区划分
+func NewService(repos *database.Repositories, loc *time.Location) *Service {
+	return &Service{repo: repos.Dex, loc: loc}
+}
+
+// Pairs 链上已索引的交易对
+func (s *Service) Pairs(chainID int64) ([]database.DexPair, error) {
+	return s.repo.ListPairs(chainID)
+}
+
+// Pair 交易对详情和LP持仓最多的地址
+func (s *Service) Pair(chainID int64, address string) (*PairDetail, error) {
+	pair, err := s.getPair(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := s.repo.TopPositions(chainID, pair.PairAddress, defaultTopProviders)
+	if err != nil {
+		return nil, fmt.Errorf("获取LP持仓失败: %w", err)
+	}
+	detail := &PairDetail{DexPair: *pair, TopProviders: make([]Position, 0, len(positions))}
+	for _, position := range positions {
+		detail.TopProviders = append(detail.TopProviders, Underlying(pair, position))
+	}
+	return detail, nil
+}
+
+// Prices 交易对在 [from, to] 日期范围内的K线，interval为1h或1d
+// 日K线由小时K线合并，没有成交和储备变化的时段没有K线
+func (s *Service) Prices(chainID int64, address, interval string, from, to time.Time) (*PriceSeries, error) {
+	if interval != IntervalHour && interval != IntervalDay {
+		return nil, fmt.Errorf("无效的K线周期: %s", interval)
+	}
+	pair, err := s.getPair(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to = s.dayOf(from), s.dayOf(to)
+	hours, err := s.repo.ListCandles(chainID, pair.PairAddress, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("获取K线失败: %w", err)
+	}
+
+	series := &PriceSeries{
+		ChainID:     chainID,
+		PairAddress: pair.PairAddress,
+		Interval:    interval,
+		From:        from.Format(dayLayout),
+		To:          to.Format(dayLayout),
+		Candles:     make([]Candle, 0, len(hours)),
+	}
+	for _, hour := range hours {
+		start := hour.BucketStart.In(s.loc)
+		if interval == IntervalDay {
+			start = s.dayOf(start)
+		}
+
+		last := len(series.Candles) - 1
+		if last >= 0 && series.Candles[last].Start.Equal(start) {
+			merged := &series.Candles[last]
+			merged.High = max(merged.High, hour.High)
+			merged.Low = min(merged.Low, hour.Low)
+			merged.Close = hour.Close
+			merged.VolumeToken = sumDecimal(merged.VolumeToken, hour.VolumeToken)
+			merged.VolumeQuote = sumDecimal(merged.VolumeQuote, hour.VolumeQuote)
+			merged.SwapCount += hour.SwapCount
+			continue
+		}
+		series.Candles = append(series.Candles, Candle{
+			Start:       start,
+			Open:        hour.Open,
+			High:        hour.High,
+			Low:         hour.Low,
+			Close:       hour.Close,
+			VolumeToken: hour.VolumeToken,
+			VolumeQuote: hour.VolumeQuote,
+			SwapCount:   hour.SwapCount,
+		})
+	}
+	return series, nil
+}
+
+// Swaps 交易对最近的成交
+func (s *Service) Swaps(chainID int64, address string, limit int) ([]database.DexSwap, error) {
+	pair, err := s.getPair(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListSwaps(chainID, pair.PairAddress, limit)
+}
+
+// Liquidity 交易对最近的流动性变动
+func (s *Service) Liquidity(chainID int64, address string, limit int) ([]database.DexLiquidityEvent, error) {
+	pair, err := s.getPair(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListLiquidityEvents(chainID, pair.PairAddress, "", limit)
+}
+
+// Positions 地址在各交易对的LP持仓
+func (s *Service) Positions(chainID int64, owner string) ([]Position, error) {
+	positions, err := s.repo.ListPositionsByOwner(chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[string]*database.DexPair)
+	result := make([]Position, 0, len(positions))
+	for _, position := range positions {
+		pair, ok := pairs[position.PairAddress]
+		if !ok {
+			if pair, err = s.repo.GetPair(chainID, position.PairAddress); err != nil {
+				return nil, fmt.Errorf("获取交易对失败: %w", err)
+			}
+			pairs[position.PairAddress] = pair
+		}
+		result = append(result, Underlying(pair, position))
+	}
+	return result, nil
+}
+
+// Underlying 按当前储备计算LP持仓对应的代币和计价代币数量
+func Underlying(pair *database.DexPair, position database.DexLPPosition) Position {
+	result := Position{DexLPPosition: position, TokenAmount: "0", QuoteAmount: "0"}
+	supply := database.ParseDecimal(pair.LPSupply)
+	if supply.Sign() <= 0 {
+		return result
+	}
+
+	balance := database.ParseDecimal(position.LPBalance)
+	token := new(big.Int).Mul(balance, database.ParseDecimal(pair.ReserveToken))
+	quote := new(big.Int).Mul(balance, database.ParseDecimal(pair.ReserveQuote))
+	result.TokenAmount = token.Div(token, supply).String()
+	result.QuoteAmount = quote.Div(quote, supply).String()
+	result.Share, _ = new(big.Rat).SetFrac(balance, supply).Float64()
+	return result
+}
+
+// getPair 获取交易对，不存在时返回ErrPairNotFound
+func (s *Service) getPair(chainID int64, address string) (*database.DexPair, error) {
+	pair, err := s.repo.GetPair(chainID, address)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrPairNotFound
+	}
+	return pair, err
+}
+
+// dayOf 返回时间在统计时区中的当天零点
+func (s *Service) dayOf(t time.Time) time.Time {
+	t = t.In(s.loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+}
+
+// sumDecimal 两个十进制金额相加
+func sumDecimal(a, b string) string {
+	return new(big.Int).Add(database.ParseDecimal(a), database.ParseDecimal(b)).String()
+}
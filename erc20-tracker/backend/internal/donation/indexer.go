@@ -0,0 +1,223 @@
+package donation
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	beggingbind "erc20-tracker/backend/internal/bindings/begging"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+func init() {
+	event.RegisterIndexer("donation", func(chain config.ChainConfig, _ *config.Config, repos *database.Repositories) (event.Indexer, error) {
+		if chain.BeggingContract == "" {
+			return nil, nil
+		}
+		return NewIndexer(chain, repos.Donation)
+	})
+}
+
+// donationHandler 捐赠合约事件的处理函数，在记录日志的同一事务中执行
+type donationHandler func(tx *database.DonationTx, vLog types.Log) error
+
+// Indexer BeggingContract事件索引器
+// 维护完整的捐赠者账本、提款记录和捐赠时间窗口
+type Indexer struct {
+	chain    config.ChainConfig
+	address  common.Address
+	filterer *beggingbind.BeggingContractFilterer
+	repo     *database.DonationRepository
+	events   *event.EventHandlers[donationHandler]
+}
+
+// NewIndexer 创建捐赠合约索引器
+func NewIndexer(chain config.ChainConfig, repo *database.DonationRepository) (*Indexer, error) {
+	if !common.IsHexAddress(chain.BeggingContract) {
+		return nil, fmt.Errorf("无效的捐赠合约地址: %q", chain.BeggingContract)
+	}
+	address := common.HexToAddress(chain.BeggingContract)
+
+	filterer, err := beggingbind.NewBeggingContractFilterer(address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建捐赠合约绑定失败: %w", err)
+	}
+
+	idx := &Indexer{
+		chain:    chain,
+		address:  address,
+		filterer: filterer,
+		repo:     repo,
+	}
+	byName := map[string]donationHandler{
+		"Donation":        idx.handleDonation,
+		"Withdrawal":      idx.handleWithdrawal,
+		"DonationTimeSet": idx.handleDonationTimeSet,
+	}
+	if idx.events, err = event.BindEvents(beggingbind.BeggingContractMetaData.GetAbi, "捐赠合约", byName); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Name 索引器名称
+func (idx *Indexer) Name() string {
+	return "BeggingContract"
+}
+
+// ABIs 捐赠合约ABI
+func (idx *Indexer) ABIs() map[string]string {
+	return map[string]string{"BeggingContract": beggingbind.BeggingContractMetaData.ABI}
+}
+
+// Addresses 捐赠合约地址
+func (idx *Indexer) Addresses() []common.Address {
+	return []common.Address{idx.address}
+}
+
+// HandleLog 处理捐赠合约日志
+func (idx *Indexer) HandleLog(vLog types.Log, _ time.Time) (bool, error) {
+	handler, name, ok := idx.events.Lookup(vLog)
+	if !ok {
+		return false, nil
+	}
+	err := event.ApplyLog(idx, idx.chain, name, vLog, idx.repo.Apply, func(tx *database.DonationTx) error {
+		return handler(tx, vLog)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// handleDonation 捐赠：记录明细并累加捐赠者和合约汇总，事件中的时间戳即区块时间
+func (idx *Indexer) handleDonation(tx *database.DonationTx, vLog types.Log) error {
+	ev, err := idx.filterer.ParseDonation(vLog)
+	if err != nil {
+		return err
+	}
+
+	donatedAt := unixTime(ev.Timestamp)
+	campaign, err := tx.GetOrNewCampaign()
+	if err != nil {
+		return err
+	}
+	donor, err := tx.GetDonor(ev.Donor.Hex())
+	if err != nil {
+		return err
+	}
+	if donor == nil {
+		donor = &database.DonationDonor{
+			Donor:          ev.Donor.Hex(),
+			TotalAmount:    "0",
+			FirstDonatedAt: donatedAt,
+			FirstBlock:     vLog.BlockNumber,
+		}
+		campaign.DonorCount++
+	}
+
+	donor.TotalAmount = database.AddDecimal(donor.TotalAmount, ev.Amount)
+	donor.DonationCount++
+	donor.LastDonatedAt = donatedAt
+	donor.LastBlock = vLog.BlockNumber
+
+	campaign.TotalDonated = database.AddDecimal(campaign.TotalDonated, ev.Amount)
+	campaign.Balance = database.AddDecimal(campaign.Balance, ev.Amount)
+	campaign.DonationCount++
+	campaign.UpdatedBlock = vLog.BlockNumber
+
+	donation := &database.Donation{
+		Donor:       ev.Donor.Hex(),
+		Amount:      ev.Amount.String(),
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   donatedAt,
+	}
+	if err := tx.CreateDonation(donation); err != nil {
+		return err
+	}
+	if err := tx.SaveDonor(donor); err != nil {
+		return err
+	}
+	return tx.SaveCampaign(campaign)
+}
+
+// handleWithdrawal 所有者提款，合约每次取出全部余额
+func (idx *Indexer) handleWithdrawal(tx *database.DonationTx, vLog types.Log) error {
+	ev, err := idx.filterer.ParseWithdrawal(vLog)
+	if err != nil {
+		return err
+	}
+
+	campaign, err := tx.GetOrNewCampaign()
+	if err != nil {
+		return err
+	}
+	if database.ParseDecimal(campaign.Balance).Cmp(ev.Amount) != 0 {
+		// 起始区块晚于部分捐赠，或有ETH未经receive转入（如selfdestruct）
+		logger.WithFields(map[string]interface{}{
+			"chain":   idx.chain.Name,
+			"balance": campaign.Balance,
+			"amount":  ev.Amount.String(),
+			"tx_hash": vLog.TxHash.Hex(),
+		}).Warn("提款金额与索引的捐赠余额不一致")
+	}
+
+	campaign.Owner = ev.Owner.Hex()
+	campaign.TotalWithdrawn = database.AddDecimal(campaign.TotalWithdrawn, ev.Amount)
+	campaign.Balance = "0"
+	campaign.WithdrawalCount++
+	campaign.UpdatedBlock = vLog.BlockNumber
+
+	withdrawal := &database.DonationWithdrawal{
+		Owner:       ev.Owner.Hex(),
+		Amount:      ev.Amount.String(),
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   unixTime(ev.Timestamp),
+	}
+	if err := tx.CreateWithdrawal(withdrawal); err != nil {
+		return err
+	}
+	return tx.SaveCampaign(campaign)
+}
+
+// handleDonationTimeSet 设置捐赠时间窗口
+// disableTimeLimit不发出事件，关闭时间限制后这里记录的窗口不会更新
+func (idx *Indexer) handleDonationTimeSet(tx *database.DonationTx, vLog types.Log) error {
+	ev, err := idx.filterer.ParseDonationTimeSet(vLog)
+	if err != nil {
+		return err
+	}
+
+	campaign, err := tx.GetOrNewCampaign()
+	if err != nil {
+		return err
+	}
+	start, end := unixTime(ev.StartTime), unixTime(ev.EndTime)
+	campaign.WindowStart = &start
+	campaign.WindowEnd = &end
+	campaign.WindowSetBlock = vLog.BlockNumber
+	campaign.WindowSetTxHash = vLog.TxHash.Hex()
+	campaign.UpdatedBlock = vLog.BlockNumber
+
+	logger.WithFields(map[string]interface{}{
+		"chain": idx.chain.Name,
+		"start": start,
+		"end":   end,
+	}).Info("捐赠时间窗口已设置")
+	return tx.SaveCampaign(campaign)
+}
+
+// unixTime 把合约中的秒级时间戳转换为UTC时间
+func unixTime(seconds *big.Int) time.Time {
+	return time.Unix(seconds.Int64(), 0).UTC()
+}
@@ -0,0 +1,125 @@
+package donation
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"erc20-tracker/backend/internal/database"
+)
+
+var (
+	// ErrCampaignNotFound 链上还没有捐赠记录
+	ErrCampaignNotFound = errors.New("捐赠合约没有索引数据")
+	// ErrDonorNotFound 地址没有捐赠过
+	ErrDonorNotFound = errors.New("该地址没有捐赠记录")
+)
+
+// 捐赠时间窗口状态
+const (
+	WindowUnrestricted = "unrestricted" // 未设置过时间窗口
+	WindowNotStarted   = "not_started"
+	WindowOpen         = "open"
+	WindowEnded        = "ended"
+)
+
+// Campaign 捐赠合约视图
+type Campaign struct {
+	database.DonationCampaign
+	// WindowStatus 按当前时间计算的窗口状态；合约关闭时间限制不发出事件，此时状态可能过时
+	WindowStatus string `json:"window_status"`
+}
+
+// LeaderboardEntry 排行榜条目
+type LeaderboardEntry struct {
+	Rank int64 `json:"rank"`
+	database.DonationDonor
+}
+
+// Donor 捐赠者视图
+type Donor struct {
+	database.DonationDonor
+	Rank      int64               `json:"rank"`
+	Donations []database.Donation `json:"donations"`
+}
+
+// Service 捐赠数据查询服务
+type Service struct {
+	repo *database.DonationRepository
+}
+
+// NewService 创建捐赠查询服务
+func NewService(repos *database.Repositories) *Service {
+	return &Service{repo: repos.Donation}
+}
+
+// Campaign 捐赠合约汇总和时间窗口状态
+func (s *Service) Campaign(chainID int64, now time.Time) (*Campaign, error) {
+	campaign, err := s.repo.GetCampaign(chainID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCampaignNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Campaign{DonationCampaign: *campaign, WindowStatus: windowStatus(campaign, now)}, nil
+}
+
+// Leaderboard 按累计捐赠排序的捐赠者，offset从0开始
+func (s *Service) Leaderboard(chainID int64, offset, limit int) ([]LeaderboardEntry, error) {
+	donors, err := s.repo.Leaderboard(chainID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]LeaderboardEntry, 0, len(donors))
+	for i, donor := range donors {
+		entries = append(entries, LeaderboardEntry{Rank: int64(offset + i + 1), DonationDonor: donor})
+	}
+	return entries, nil
+}
+
+// Donor 捐赠者的累计捐赠、名次和最近的捐赠记录
+func (s *Service) Donor(chainID int64, address string, limit int) (*Donor, error) {
+	donor, err := s.repo.GetDonor(chainID, address)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDonorNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := s.repo.DonorRank(donor)
+	if err != nil {
+		return nil, err
+	}
+	donations, err := s.repo.ListDonations(chainID, address, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &Donor{DonationDonor: *donor, Rank: rank, Donations: donations}, nil
+}
+
+// Donations 最近的捐赠记录
+func (s *Service) Donations(chainID int64, limit int) ([]database.Donation, error) {
+	return s.repo.ListDonations(chainID, "", limit)
+}
+
+// Withdrawals 最近的提款记录
+func (s *Service) Withdrawals(chainID int64, limit int) ([]database.DonationWithdrawal, error) {
+	return s.repo.ListWithdrawals(chainID, limit)
+}
+
+// windowStatus 计算时间窗口状态，与合约的withinDonationTime一致（两端都包含）
+func windowStatus(campaign *database.DonationCampaign, now time.Time) string {
+	switch {
+	case campaign.WindowStart == nil || campaign.WindowEnd == nil:
+		return WindowUnrestricted
+	case now.Before(*campaign.WindowStart):
+		return WindowNotStarted
+	case now.After(*campaign.WindowEnd):
+		return WindowEnded
+	default:
+		return WindowOpen
+	}
+}
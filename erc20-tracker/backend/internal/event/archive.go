@@ -0,0 +1,187 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"erc20-tracker/backend/internal/alert"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/decoder"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// archiveLogs 原样归档获取到的日志，返回日志所在区块的时间
+// 在对日志做任何处理之前调用，区块时间获取失败或归档失败时整批返回错误，由调用方重试
+func (el *EventListener) archiveLogs(logs []types.Log) (map[uint64]time.Time, error) {
+	timestamps := make(map[uint64]time.Time)
+	for _, vLog := range logs {
+		timestamp, err := el.blockTime(timestamps, vLog.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		if err := el.archiveLog(vLog, timestamp); err != nil {
+			return nil, err
+		}
+	}
+	return timestamps, nil
+}
+
+// blockTime 获取区块时间，已获取过的区块从timestamps中读取
+func (el *EventListener) blockTime(timestamps map[uint64]time.Time, blockNumber uint64) (time.Time, error) {
+	if timestamp, ok := timestamps[blockNumber]; ok {
+		return timestamp, nil
+	}
+	header, err := el.client.HeaderByNumber(el.ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("获取区块 %d 信息失败: %w", blockNumber, err)
+	}
+	timestamp := time.Unix(int64(header.Time), 0).In(el.loc)
+	timestamps[blockNumber] = timestamp
+	return timestamp, nil
+}
+
+// archiveLog 原样归档获取到的日志
+func (el *EventListener) archiveLog(vLog types.Log, timestamp time.Time) error {
+	topics, err := encodeTopics(vLog.Topics)
+	if err != nil {
+		return err
+	}
+
+	record := &database.RawEventLog{
+		ChainID:         el.chainConfig.ChainID,
+		ContractAddress: vLog.Address.Hex(),
+		TxHash:          vLog.TxHash.Hex(),
+		TxIndex:         vLog.TxIndex,
+		LogIndex:        vLog.Index,
+		BlockNumber:     vLog.BlockNumber,
+		BlockHash:       vLog.BlockHash.Hex(),
+		BlockTimestamp:  timestamp,
+		Topics:          topics,
+		Data:            hexutil.Encode(vLog.Data),
+		Removed:         vLog.Removed,
+	}
+	if err := el.repos.RawEventLog.Save(record); err != nil {
+		return fmt.Errorf("归档原始日志失败: %w", err)
+	}
+	return nil
+}
+
+// NewReplayProcessor 创建不连接RPC的事件处理器，用于把归档日志重放到指定仓库
+// 重放时的隔离告警只写日志，不发送Webhook
+func NewReplayProcessor(chainConfig config.ChainConfig, repos *database.Repositories, globalConfig *config.Config) (*EventListener, error) {
+	registry, err := decoder.Load(globalConfig.Decoder.ABIPaths)
+	if err != nil {
+		return nil, fmt.Errorf("加载ABI失败: %w", err)
+	}
+
+	contractAddress := common.HexToAddress(chainConfig.ContractAddress)
+	addresses, err := trackedAddresses(contractAddress, chainConfig.TrackedContracts)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(globalConfig.Timezone)
+	if err != nil {
+		logger.WithField("error", err).Warn("加载全局时区失败，使用本地时区")
+		loc = time.Local
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	el := &EventListener{
+		decoder:         registry,
+		contractAddress: contractAddress,
+		addresses:       addresses,
+		chainConfig:     chainConfig,
+		repos:           repos,
+		alerter:         alert.LogAlerter{},
+		ctx:             ctx,
+		cancel:          cancel,
+		loc:             loc,
+	}
+	el.handlers = el.tokenHandlers()
+	if err := el.attachIndexers(globalConfig); err != nil {
+		cancel()
+		return nil, err
+	}
+	return el, nil
+}
+
+// ApplyArchivedLog 按正常同步的流程处理一条归档日志（去重、冻结与负余额检查、写入余额）
+func (el *EventListener) ApplyArchivedLog(raw database.RawEventLog) error {
+	if raw.Removed {
+		return nil
+	}
+
+	vLog, err := decodeLog(raw.ContractAddress, raw.TxHash, raw.TxIndex, raw.LogIndex,
+		raw.BlockNumber, raw.BlockHash, raw.Topics, raw.Data)
+	if err != nil {
+		return fmt.Errorf("还原归档日志 %d 失败: %w", raw.ID, err)
+	}
+
+	if handled, err := el.indexLog(vLog, raw.BlockTimestamp.In(el.loc)); err != nil || handled {
+		return err
+	}
+
+	if _, ok := el.handlerFor(vLog); !ok {
+		return el.captureLog(vLog, raw.BlockTimestamp.In(el.loc))
+	}
+
+	exists, err := el.repos.BalanceChange.ExistsByLog(el.chainConfig.ChainID, raw.TxHash, raw.LogIndex)
+	if err != nil {
+		return fmt.Errorf("检查日志是否已处理失败: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	return el.applyLog(vLog, raw.BlockTimestamp.In(el.loc), "")
+}
+
+// encodeTopics 把topics编码为JSON数组字符串
+func encodeTopics(topics []common.Hash) (string, error) {
+	values := make([]string, len(topics))
+	for i, topic := range topics {
+		values[i] = topic.Hex()
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("序列化topics失败: %w", err)
+	}
+	return string(encoded), nil
+}
+
+// decodeLog 从数据库中保存的字段还原日志
+func decodeLog(contractAddress, txHash string, txIndex, logIndex uint, blockNumber uint64, blockHash, topicsJSON, dataHex string) (types.Log, error) {
+	var topics []string
+	if err := json.Unmarshal([]byte(topicsJSON), &topics); err != nil {
+		return types.Log{}, fmt.Errorf("解析topics失败: %w", err)
+	}
+	data, err := hexutil.Decode(dataHex)
+	if err != nil {
+		return types.Log{}, fmt.Errorf("解析data失败: %w", err)
+	}
+
+	vLog := types.Log{
+		Address:     common.HexToAddress(contractAddress),
+		Topics:      make([]common.Hash, len(topics)),
+		Data:        data,
+		BlockNumber: blockNumber,
+		TxHash:      common.HexToHash(txHash),
+		TxIndex:     txIndex,
+		BlockHash:   common.HexToHash(blockHash),
+		Index:       logIndex,
+	}
+	for i, topic := range topics {
+		vLog.Topics[i] = common.HexToHash(topic)
+	}
+	return vLog, nil
+}
@@ -0,0 +1,114 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/decoder"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// captureLog 按ABI通用解码并保存没有专门处理函数的事件
+// 注册表中没有定义的事件也会保存（只记录topic0），补充ABI后可用RedecodeCaptured重新解码
+func (el *EventListener) captureLog(vLog types.Log, timestamp time.Time) error {
+	record := &database.ContractEvent{
+		ChainID:         el.chainConfig.ChainID,
+		ContractAddress: vLog.Address.Hex(),
+		TxHash:          vLog.TxHash.Hex(),
+		LogIndex:        vLog.Index,
+		BlockNumber:     vLog.BlockNumber,
+		Timestamp:       timestamp,
+	}
+	if len(vLog.Topics) > 0 {
+		record.Topic0 = vLog.Topics[0].Hex()
+	}
+	el.decodeInto(record, vLog)
+
+	if err := el.repos.ContractEvent.Save(record); err != nil {
+		return fmt.Errorf("保存合约事件失败: %w", err)
+	}
+	return nil
+}
+
+// decodeInto 解码日志并填充记录的解码字段，解码失败时记录保持未解码状态
+func (el *EventListener) decodeInto(record *database.ContractEvent, vLog types.Log) {
+	ev, err := el.decoder.Decode(vLog)
+	if err == nil {
+		record.Args, err = ev.JSON()
+	}
+	if err != nil {
+		fields := map[string]interface{}{
+			"chain":     el.chainConfig.Name,
+			"contract":  record.ContractAddress,
+			"topic0":    record.Topic0,
+			"tx_hash":   record.TxHash,
+			"log_index": record.LogIndex,
+		}
+		if errors.Is(err, decoder.ErrUnknownEvent) {
+			logger.WithFields(fields).Debug("未知事件，只保存topic0")
+		} else {
+			fields["error"] = err
+			logger.WithFields(fields).Warn("解码合约事件失败")
+		}
+		return
+	}
+
+	record.EventName = ev.Name
+	record.Signature = ev.Signature
+	record.Source = ev.Source
+	record.Decoded = true
+}
+
+// RedecodeCaptured 用当前注册表重新解码链上所有未解码的合约事件，返回成功解码的数量
+// 原始日志从归档表读取，dryRun为true时只统计不写入
+func (el *EventListener) RedecodeCaptured(dryRun bool) (int, error) {
+	const batchSize = 500
+
+	decoded := 0
+	var afterID uint64
+	for {
+		records, err := el.repos.ContractEvent.List(database.ContractEventFilter{
+			ChainID:       el.chainConfig.ChainID,
+			UndecodedOnly: true,
+			AfterID:       afterID,
+			Limit:         batchSize,
+		})
+		if err != nil {
+			return decoded, fmt.Errorf("获取未解码事件失败: %w", err)
+		}
+		if len(records) == 0 {
+			return decoded, nil
+		}
+
+		for i := range records {
+			record := &records[i]
+			afterID = record.ID
+
+			raw, err := el.repos.RawEventLog.GetByLog(record.ChainID, record.TxHash, record.LogIndex)
+			if err != nil {
+				return decoded, fmt.Errorf("获取事件 %d 的归档日志失败: %w", record.ID, err)
+			}
+			vLog, err := decodeLog(raw.ContractAddress, raw.TxHash, raw.TxIndex, raw.LogIndex,
+				raw.BlockNumber, raw.BlockHash, raw.Topics, raw.Data)
+			if err != nil {
+				return decoded, fmt.Errorf("还原归档日志 %d 失败: %w", raw.ID, err)
+			}
+
+			el.decodeInto(record, vLog)
+			if !record.Decoded {
+				continue
+			}
+			decoded++
+			if dryRun {
+				continue
+			}
+			if err := el.repos.ContractEvent.Save(record); err != nil {
+				return decoded, fmt.Errorf("保存合约事件失败: %w", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/decoder"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// Indexer 专门合约的索引器，监听器把这些合约的日志交给索引器处理
+// 同一链的HandleLog按日志顺序调用，索引器需要自己保证同一日志重复处理时幂等（通常用ApplyLog登记日志）
+// 索引器也可以监听代币合约，此时HandleLog对Transfer等余额事件必须返回false，交给代币处理函数
+type Indexer interface {
+	Name() string
+	// ABIs 合约名到ABI JSON的映射，加载到解码注册表，供事件流和通用解码使用
+	ABIs() map[string]string
+	// Addresses 需要监听的合约地址
+	Addresses() []common.Address
+	// HandleLog 处理一条日志，handled为false时按通用合约事件保存
+	HandleLog(vLog types.Log, timestamp time.Time) (handled bool, err error)
+}
+
+// EventHandlers 按topic0分发索引器关心的合约事件，H为索引器自己的处理函数类型
+// 索引器的合约绑定只用于解析日志，不需要RPC客户端
+type EventHandlers[H any] struct {
+	handlers map[common.Hash]H
+	names    map[common.Hash]string
+}
+
+// BindEvents 按事件名把处理函数映射到topic0，contract用于错误信息
+func BindEvents[H any](getABI func() (*abi.ABI, error), contract string, byName map[string]H) (*EventHandlers[H], error) {
+	parsed, err := getABI()
+	if err != nil {
+		return nil, fmt.Errorf("解析%sABI失败: %w", contract, err)
+	}
+	events := &EventHandlers[H]{
+		handlers: make(map[common.Hash]H, len(byName)),
+		names:    make(map[common.Hash]string, len(byName)),
+	}
+	for name, handler := range byName {
+		ev, ok := parsed.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("%sABI中没有%s事件", contract, name)
+		}
+		events.handlers[ev.ID] = handler
+		events.names[ev.ID] = name
+	}
+	return events, nil
+}
+
+// Lookup 查找日志对应的处理函数和事件名，不关心的日志返回false
+func (e *EventHandlers[H]) Lookup(vLog types.Log) (handler H, name string, ok bool) {
+	if len(vLog.Topics) == 0 {
+		return handler, "", false
+	}
+	handler, ok = e.handlers[vLog.Topics[0]]
+	return handler, e.names[vLog.Topics[0]], ok
+}
+
+// ApplyLog 登记索引器日志并在同一事务中执行fn，同一日志重复处理时跳过fn
+// apply为索引器数据仓库的Apply方法，fn在其事务中执行
+func ApplyLog[T any](indexer Indexer, chain config.ChainConfig, name string, vLog types.Log,
+	apply func(entry *database.IndexedLog, fn func(tx T) error) (bool, error), fn func(tx T) error) error {
+	entry := &database.IndexedLog{
+		ChainID:         chain.ChainID,
+		Indexer:         indexer.Name(),
+		ContractAddress: vLog.Address.Hex(),
+		TxHash:          vLog.TxHash.Hex(),
+		LogIndex:        vLog.Index,
+		BlockNumber:     vLog.BlockNumber,
+		EventName:       name,
+	}
+	applied, err := apply(entry, fn)
+	if err != nil {
+		return fmt.Errorf("处理%s事件失败: %w", name, err)
+	}
+	if !applied {
+		logger.WithFields(map[string]interface{}{
+			"chain":     chain.Name,
+			"indexer":   indexer.Name(),
+			"event":     name,
+			"tx_hash":   vLog.TxHash.Hex(),
+			"log_index": vLog.Index,
+		}).Debug("索引器事件已处理，跳过重复处理")
+	}
+	return nil
+}
+
+// Discoverer 处理日志时会发现新合约的索引器（如工厂合约部署的子合约）
+// 监听器创建索引器后调用SetDiscoverFunc注册回调，索引器发现新合约时调用fn，
+// 新地址立即加入日志查询，并补查当前区块范围内该地址的日志
+type Discoverer interface {
+	SetDiscoverFunc(fn func(address common.Address))
+}
+
+// Bootstrapper 启动时需要读取链上状态的索引器（如查找起始区块之前已存在的合约）
+// 监听器在加入监听地址之前用自己的RPC客户端调用Bootstrap，不连接RPC的重放处理器不调用
+type Bootstrapper interface {
+	Bootstrap(ctx context.Context, caller bind.ContractCaller) error
+}
+
+// IndexerFactory 为一条链创建索引器，链上未配置对应合约时返回nil
+type IndexerFactory func(chain config.ChainConfig, cfg *config.Config, repos *database.Repositories) (Indexer, error)
+
+var (
+	indexersMu sync.RWMutex
+	indexers   = make(map[string]IndexerFactory)
+)
+
+// RegisterIndexer 注册索引器类型，索引器在自己的包中通过init注册
+func RegisterIndexer(name string, factory IndexerFactory) {
+	indexersMu.Lock()
+	defer indexersMu.Unlock()
+
+	if _, exists := indexers[name]; exists {
+		panic(fmt.Sprintf("索引器 %s 重复注册", name))
+	}
+	indexers[name] = factory
+}
+
+// newIndexers 按注册名顺序创建链上配置了合约的索引器，并把它们的ABI加载到注册表
+func newIndexers(chain config.ChainConfig, cfg *config.Config, repos *database.Repositories, registry *decoder.Registry) ([]Indexer, error) {
+	indexersMu.RLock()
+	names := make([]string, 0, len(indexers))
+	for name := range indexers {
+		names = append(names, name)
+	}
+	indexersMu.RUnlock()
+	sort.Strings(names)
+
+	var created []Indexer
+	for _, name := range names {
+		indexersMu.RLock()
+		factory := indexers[name]
+		indexersMu.RUnlock()
+
+		indexer, err := factory(chain, cfg, repos)
+		if err != nil {
+			return nil, fmt.Errorf("创建索引器 %s 失败: %w", name, err)
+		}
+		if indexer == nil {
+			continue
+		}
+		for source, data := range indexer.ABIs() {
+			if _, err := registry.LoadJSON(source, []byte(data)); err != nil {
+				return nil, fmt.Errorf("加载索引器 %s 的ABI %s 失败: %w", name, source, err)
+			}
+		}
+		created = append(created, indexer)
+	}
+	return created, nil
+}
+
+// attachIndexers 创建索引器并把它们的合约加入监听地址
+func (el *EventListener) attachIndexers(cfg *config.Config) error {
+	created, err := newIndexers(el.chainConfig, cfg, el.repos, el.decoder)
+	if err != nil {
+		return err
+	}
+
+	el.indexers = make(map[common.Address]Indexer)
+	el.addressesChanged = make(chan struct{}, 1)
+	for _, indexer := range created {
+		if bootstrapper, ok := indexer.(Bootstrapper); ok && el.client != nil {
+			if err := bootstrapper.Bootstrap(el.ctx, el.client); err != nil {
+				return fmt.Errorf("初始化索引器 %s 失败: %w", indexer.Name(), err)
+			}
+		}
+		for _, address := range indexer.Addresses() {
+			if err := el.watchAddress(indexer, address); err != nil {
+				return err
+			}
+		}
+		if discoverer, ok := indexer.(Discoverer); ok {
+			discoverer.SetDiscoverFunc(func(address common.Address) {
+				if err := el.watchAddress(indexer, address); err != nil {
+					logger.WithFields(map[string]interface{}{
+						"chain":   el.chainConfig.Name,
+						"indexer": indexer.Name(),
+						"error":   err,
+					}).Error("加入新发现的合约失败")
+				}
+			})
+		}
+	}
+	return nil
+}
+
+// watchAddress 把索引器的合约加入监听地址，已由同一索引器处理的地址忽略
+func (el *EventListener) watchAddress(indexer Indexer, address common.Address) error {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	if existing, ok := el.indexers[address]; ok {
+		if existing != indexer {
+			return fmt.Errorf("合约 %s 同时由索引器 %s 和 %s 处理", address.Hex(), existing.Name(), indexer.Name())
+		}
+		return nil
+	}
+
+	el.indexers[address] = indexer
+	if !slices.Contains(el.addresses, address) {
+		el.addresses = append(el.addresses, address)
+	}
+
+	// 通知实时订阅按新的地址列表重新订阅
+	select {
+	case el.addressesChanged <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// watchedAddresses 当前监听的合约地址
+func (el *EventListener) watchedAddresses() []common.Address {
+	el.mu.RLock()
+	defer el.mu.RUnlock()
+	return slices.Clone(el.addresses)
+}
+
+// indexLog 把索引器合约的日志交给索引器，返回是否已处理
+func (el *EventListener) indexLog(vLog types.Log, timestamp time.Time) (bool, error) {
+	el.mu.RLock()
+	indexer, ok := el.indexers[vLog.Address]
+	el.mu.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	handled, err := indexer.HandleLog(vLog, timestamp)
+	if err != nil {
+		return false, fmt.Errorf("索引器 %s 处理日志失败: %w", indexer.Name(), err)
+	}
+	return handled, nil
+}
@@ -2,66 +2,57 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"math/big"
-	"strings"
+	"slices"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
+	"erc20-tracker/backend/internal/alert"
 	"erc20-tracker/backend/internal/config"
 	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/decoder"
+	"erc20-tracker/backend/internal/stream"
+	"erc20-tracker/backend/internal/webhook"
 	"erc20-tracker/backend/pkg/logger"
 )
 
-// ERC20 ABI for Transfer, Mint, Burn events
-const ERC20ABI = `[
-	{
-		"anonymous": false,
-		"inputs": [
-			{"indexed": true, "name": "from", "type": "address"},
-			{"indexed": true, "name": "to", "type": "address"},
-			{"indexed": false, "name": "value", "type": "uint256"}
-		],
-		"name": "Transfer",
-		"type": "event"
-	},
-	{
-		"anonymous": false,
-		"inputs": [
-			{"indexed": true, "name": "to", "type": "address"},
-			{"indexed": false, "name": "amount", "type": "uint256"},
-			{"indexed": false, "name": "timestamp", "type": "uint256"}
-		],
-		"name": "TokenMinted",
-		"type": "event"
-	},
-	{
-		"anonymous": false,
-		"inputs": [
-			{"indexed": true, "name": "from", "type": "address"},
-			{"indexed": false, "name": "amount", "type": "uint256"},
-			{"indexed": false, "name": "timestamp", "type": "uint256"}
-		],
-		"name": "TokenBurned",
-		"type": "event"
-	}
-]`
+// 代币合约事件签名，这些事件由专门的处理函数写入余额
+const (
+	SigTransfer    = "Transfer(address,address,uint256)"
+	SigTokenMinted = "TokenMinted(address,uint256,uint256)"
+	SigTokenBurned = "TokenBurned(address,uint256,uint256)"
+)
+
+// logHandler 代币合约事件的处理函数，released含义见applyLog
+type logHandler func(vLog types.Log, ev *decoder.Event, timestamp time.Time, released string) error
 
 // EventListener 事件监听器
 type EventListener struct {
-	client          *ethclient.Client
-	contractABI     abi.ABI
-	contractAddress common.Address
-	chainConfig     config.ChainConfig
-	repos           *database.Repositories
-	ctx             context.Context
-	cancel          context.CancelFunc
-	loc             *time.Location
+	client           *ethclient.Client
+	decoder          *decoder.Registry
+	handlers         map[common.Hash]logHandler // 代币合约topic0到处理函数的映射
+	contractAddress  common.Address
+	mu               sync.RWMutex               // 保护addresses和indexers，索引器发现新合约时会修改
+	addresses        []common.Address           // 查询日志的合约地址：代币合约、额外跟踪的合约和索引器合约
+	indexers         map[common.Address]Indexer // 索引器合约地址到索引器的映射
+	addressesChanged chan struct{}              // 监听地址增加时通知实时订阅重新订阅
+	chainConfig      config.ChainConfig
+	repos            *database.Repositories
+	alerter          alert.Alerter
+	notifier         *webhook.Notifier // 重放处理器为nil，不发送通知
+	publisher        stream.Publisher  // 未配置事件流输出时为nil
+	ctx              context.Context
+	cancel           context.CancelFunc
+	loc              *time.Location
 }
 
 // NewEventListener 创建事件监听器
@@ -72,14 +63,18 @@ func NewEventListener(chainConfig config.ChainConfig, repos *database.Repositori
 		return nil, fmt.Errorf("连接RPC失败: %w", err)
 	}
 
-	// 解析合约ABI
-	contractABI, err := abi.JSON(strings.NewReader(ERC20ABI))
+	// 加载事件ABI
+	registry, err := decoder.Load(globalConfig.Decoder.ABIPaths)
 	if err != nil {
-		return nil, fmt.Errorf("解析ABI失败: %w", err)
+		return nil, fmt.Errorf("加载ABI失败: %w", err)
 	}
 
 	// 解析合约地址
 	contractAddress := common.HexToAddress(chainConfig.ContractAddress)
+	addresses, err := trackedAddresses(contractAddress, chainConfig.TrackedContracts)
+	if err != nil {
+		return nil, err
+	}
 
 	// 加载时区位置
 	// 首先尝试使用链特定的时区配置
@@ -102,16 +97,60 @@ func NewEventListener(chainConfig config.ChainConfig, repos *database.Repositori
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &EventListener{
+	el := &EventListener{
 		client:          client,
-		contractABI:     contractABI,
+		decoder:         registry,
 		contractAddress: contractAddress,
+		addresses:       addresses,
 		chainConfig:     chainConfig,
 		repos:           repos,
+		alerter:         alert.NewAlerter(globalConfig.Alert),
+		notifier:        webhook.NewNotifier(repos, globalConfig),
 		ctx:             ctx,
 		cancel:          cancel,
 		loc:             loc,
-	}, nil
+	}
+	el.handlers = el.tokenHandlers()
+	if err := el.attachIndexers(globalConfig); err != nil {
+		client.Close()
+		cancel()
+		return nil, err
+	}
+	return el, nil
+}
+
+// tokenHandlers 代币合约事件的处理函数
+func (el *EventListener) tokenHandlers() map[common.Hash]logHandler {
+	return map[common.Hash]logHandler{
+		decoder.Topic(SigTransfer):    el.processTransferEvent,
+		decoder.Topic(SigTokenMinted): el.processMintEvent,
+		decoder.Topic(SigTokenBurned): el.processBurnEvent,
+	}
+}
+
+// trackedAddresses 校验额外跟踪的合约地址，返回包含代币合约在内的去重地址列表
+func trackedAddresses(contractAddress common.Address, tracked []string) ([]common.Address, error) {
+	addresses := []common.Address{contractAddress}
+	for _, value := range tracked {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("无效的跟踪合约地址: %q", value)
+		}
+		address := common.HexToAddress(value)
+		if slices.Contains(addresses, address) {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// handlerFor 获取日志的专门处理函数，只有代币合约自身的已知事件才有
+func (el *EventListener) handlerFor(vLog types.Log) (logHandler, bool) {
+	if vLog.Address != el.contractAddress || len(vLog.Topics) == 0 {
+		return nil, false
+	}
+	handler, ok := el.handlers[vLog.Topics[0]]
+	return handler, ok
 }
 
 // Start 开始监听事件
@@ -120,6 +159,8 @@ func (el *EventListener) Start(confirmationBlocks int) error {
 		"chain":    el.chainConfig.Name,
 		"chain_id": el.chainConfig.ChainID,
 		"contract": el.contractAddress.Hex(),
+		"tracked":  len(el.chainConfig.TrackedContracts),
+		"indexed":  len(el.indexers),
 	}).Data).Info("开始事件监听")
 
 	// 获取最后同步的区块号
@@ -188,8 +229,8 @@ func (el *EventListener) syncHistoricalEvents(fromBlock uint64, confirmationBloc
 			toBlock = uint64(confirmedBlock)
 		}
 
-		// 处理这批区块的事件
-		if err := el.processBlockRange(fromBlock, toBlock); err != nil {
+		// 处理这批区块的事件，有日志处理失败时不推进游标，稍后重试这一批（已处理的日志会被跳过）
+		if err := el.processBlockRangeStrict(fromBlock, toBlock); err != nil {
 			logger.WithFields(map[string]interface{}{
 				"error":      err,
 				"from_block": fromBlock,
@@ -217,39 +258,51 @@ func (el *EventListener) syncHistoricalEvents(fromBlock uint64, confirmationBloc
 func (el *EventListener) listenRealTimeEvents(confirmationBlocks int) {
 	logger.WithField("chain", el.chainConfig.Name).Info("开始实时事件监听")
 
-	// 尝试WebSocket订阅，如果失败则使用轮询
-	query := ethereum.FilterQuery{
-		Addresses: []common.Address{el.contractAddress},
-		Topics: [][]common.Hash{
-			{
-				el.contractABI.Events["Transfer"].ID,
-				el.contractABI.Events["TokenMinted"].ID,
-				el.contractABI.Events["TokenBurned"].ID,
-			},
-		},
-	}
-
-	// 尝试创建日志订阅
-	logs := make(chan types.Log)
-	sub, err := el.client.SubscribeFilterLogs(el.ctx, query, logs)
-	if err != nil {
-		logger.WithField("error", err).Error("创建日志订阅失败")
-		logger.WithField("chain", el.chainConfig.Name).Info("切换到轮询模式监听事件")
-		// 使用轮询模式
-		el.pollForEvents(confirmationBlocks)
-		return
+	for {
+		// 尝试WebSocket订阅，如果失败则使用轮询
+		// 不按topic过滤，未知事件也会被获取并保存
+		query := ethereum.FilterQuery{
+			Addresses: el.watchedAddresses(),
+		}
+
+		// 尝试创建日志订阅
+		logs := make(chan types.Log)
+		sub, err := el.client.SubscribeFilterLogs(el.ctx, query, logs)
+		if err != nil {
+			logger.WithField("error", err).Error("创建日志订阅失败")
+			logger.WithField("chain", el.chainConfig.Name).Info("切换到轮询模式监听事件")
+			// 使用轮询模式
+			el.pollForEvents(confirmationBlocks)
+			return
+		}
+
+		if !el.consumeSubscription(sub, logs, confirmationBlocks) {
+			return
+		}
+		logger.WithField("chain", el.chainConfig.Name).Info("监听地址已更新，重新订阅日志")
 	}
+}
+
+// consumeSubscription 处理订阅推送的日志，监听地址增加时返回true以便重新订阅
+func (el *EventListener) consumeSubscription(sub ethereum.Subscription, logs chan types.Log, confirmationBlocks int) bool {
 	defer sub.Unsubscribe()
 
 	// WebSocket订阅模式
 	for {
 		select {
 		case <-el.ctx.Done():
-			return
+			return false
 		case err := <-sub.Err():
 			logger.WithField("error", err).Error("日志订阅错误")
-			return
+			return false
+		case <-el.addressesChanged:
+			return true
 		case vLog := <-logs:
+			// 节点推送removed日志表示其所在区块已被重组
+			if vLog.Removed {
+				el.handleReorg(vLog, common.Hash{})
+				continue
+			}
 			// 等待确认
 			go el.waitAndProcessLog(vLog, confirmationBlocks)
 		}
@@ -286,9 +339,9 @@ func (el *EventListener) pollForEvents(confirmationBlocks int) {
 				continue
 			}
 
-			// 处理新区块的事件
+			// 处理新区块的事件，有日志处理失败时不推进游标，下次轮询重试
 			toBlock := uint64(confirmedBlock)
-			if err := el.processBlockRange(lastSyncedBlock+1, toBlock); err != nil {
+			if err := el.processBlockRangeStrict(lastSyncedBlock+1, toBlock); err != nil {
 				logger.WithFields(map[string]interface{}{
 					"error":      err,
 					"from_block": lastSyncedBlock + 1,
@@ -329,6 +382,18 @@ func (el *EventListener) waitAndProcessLog(vLog types.Log, confirmationBlocks in
 		}
 
 		if currentBlock >= vLog.BlockNumber+uint64(confirmationBlocks) {
+			// 确认期间区块可能被重组，处理前核对区块哈希
+			header, err := el.client.HeaderByNumber(el.ctx, new(big.Int).SetUint64(vLog.BlockNumber))
+			if err != nil {
+				logger.WithField("error", err).Error("获取区块头失败")
+				time.Sleep(5 * time.Second)
+				continue
+			}
+			if header.Hash() != vLog.BlockHash {
+				el.handleReorg(vLog, header.Hash())
+				return
+			}
+
 			// 已确认，处理事件
 			if err := el.processLog(vLog); err != nil {
 				logger.WithFields(map[string]interface{}{
@@ -344,26 +409,104 @@ func (el *EventListener) waitAndProcessLog(vLog types.Log, confirmationBlocks in
 	}
 }
 
-// processBlockRange 处理区块范围内的事件
-func (el *EventListener) processBlockRange(fromBlock, toBlock uint64) error {
+// handleReorg 记录被重组回滚的日志并发出通知，日志不会写入余额
+func (el *EventListener) handleReorg(vLog types.Log, canonicalHash common.Hash) {
+	logger.WithFields(map[string]interface{}{
+		"chain":          el.chainConfig.Name,
+		"block":          vLog.BlockNumber,
+		"block_hash":     vLog.BlockHash.Hex(),
+		"canonical_hash": canonicalHash.Hex(),
+		"tx_hash":        vLog.TxHash.Hex(),
+	}).Warn("区块重组，丢弃未确认的日志")
+
+	el.notifier.Notify(webhook.ReorgRollback(el.chainConfig.ChainID, vLog.BlockNumber, vLog.BlockHash, canonicalHash, vLog.TxHash, vLog.Index))
+}
+
+// LatestBlock 获取链上最新区块号
+func (el *EventListener) LatestBlock() (uint64, error) {
+	return el.client.BlockNumber(el.ctx)
+}
+
+// FetchLogs 查询区块范围内代币合约、跟踪合约和索引器合约的所有事件日志（只读，不写数据库）
+func (el *EventListener) FetchLogs(fromBlock, toBlock uint64) ([]types.Log, error) {
+	return el.fetchLogs(fromBlock, toBlock, el.watchedAddresses())
+}
+
+// fetchLogs 查询区块范围内指定合约的所有事件日志
+func (el *EventListener) fetchLogs(fromBlock, toBlock uint64, addresses []common.Address) ([]types.Log, error) {
 	// 创建事件查询
 	query := ethereum.FilterQuery{
 		FromBlock: big.NewInt(int64(fromBlock)),
 		ToBlock:   big.NewInt(int64(toBlock)),
-		Addresses: []common.Address{el.contractAddress},
-		Topics: [][]common.Hash{
-			{
-				el.contractABI.Events["Transfer"].ID,
-				el.contractABI.Events["TokenMinted"].ID,
-				el.contractABI.Events["TokenBurned"].ID,
-			},
-		},
+		Addresses: addresses,
 	}
 
 	// 查询日志
 	logs, err := el.client.FilterLogs(el.ctx, query)
 	if err != nil {
-		return fmt.Errorf("查询日志失败: %w", err)
+		return nil, fmt.Errorf("查询日志失败: %w", err)
+	}
+	return logs, nil
+}
+
+// SyncRange 手动同步指定区块范围（供管理命令使用）
+// 按批次处理区块，仅当同步进度超过当前游标时才推进游标
+// 某一批中有日志处理失败时不推进游标，处理完该批后停止并返回这些日志的错误
+func (el *EventListener) SyncRange(fromBlock, toBlock uint64) error {
+	if fromBlock > toBlock {
+		return fmt.Errorf("起始区块 %d 大于结束区块 %d", fromBlock, toBlock)
+	}
+
+	batchSize := uint64(1000)
+	for start := fromBlock; start <= toBlock; start += batchSize {
+		end := start + batchSize - 1
+		if end > toBlock {
+			end = toBlock
+		}
+
+		if err := el.processBlockRangeStrict(start, end); err != nil {
+			return err
+		}
+
+		lastSyncedBlock, err := el.repos.BlockSyncStatus.GetLastSyncedBlock(el.chainConfig.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取最后同步区块失败: %w", err)
+		}
+		if end > lastSyncedBlock {
+			if err := el.repos.BlockSyncStatus.UpdateLastSyncedBlock(el.chainConfig.ChainID, end); err != nil {
+				return fmt.Errorf("更新同步状态失败: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// processBlockRangeStrict 处理区块范围内的事件，任何一条日志处理失败都返回错误，调用方据此不推进同步游标
+func (el *EventListener) processBlockRangeStrict(fromBlock, toBlock uint64) error {
+	failures, err := el.processBlockRange(fromBlock, toBlock)
+	if err != nil {
+		return fmt.Errorf("处理区块 %d-%d 失败: %w", fromBlock, toBlock, err)
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("区块 %d-%d 中有 %d 条事件处理失败，同步游标未推进: %w", fromBlock, toBlock, len(failures), errors.Join(failures...))
+	}
+	return nil
+}
+
+// processBlockRange 处理区块范围内的事件
+// 处理过程中索引器发现了新合约时，补查并处理该范围内新合约的日志
+// 查询或发布失败时返回err；单条日志处理失败不中断，错误在failures中返回
+func (el *EventListener) processBlockRange(fromBlock, toBlock uint64) (failures []error, err error) {
+	addresses := el.watchedAddresses()
+	logs, err := el.fetchLogs(fromBlock, toBlock, addresses)
+	if err != nil {
+		return nil, err
+	}
+	// 先归档全部日志，再逐条处理，保证所有获取到的日志都可重放
+	timestamps, err := el.archiveLogs(logs)
+	if err != nil {
+		return nil, err
 	}
 
 	logger.WithFields(map[string]interface{}{
@@ -372,288 +515,316 @@ func (el *EventListener) processBlockRange(fromBlock, toBlock uint64) error {
 		"logs_count": len(logs),
 	}).Debug("处理区块范围事件")
 
-	// 处理每个日志
+	failures = el.processLogs(logs, timestamps)
+
+	for {
+		var added []common.Address
+		for _, address := range el.watchedAddresses() {
+			if !slices.Contains(addresses, address) {
+				added = append(added, address)
+			}
+		}
+		if len(added) == 0 {
+			break
+		}
+
+		discovered, err := el.fetchLogs(fromBlock, toBlock, added)
+		if err != nil {
+			return failures, err
+		}
+		discoveredTimes, err := el.archiveLogs(discovered)
+		if err != nil {
+			return failures, err
+		}
+		maps.Copy(timestamps, discoveredTimes)
+		logger.WithFields(map[string]interface{}{
+			"from_block": fromBlock,
+			"to_block":   toBlock,
+			"contracts":  len(added),
+			"logs_count": len(discovered),
+		}).Debug("补查新发现合约的事件")
+
+		failures = append(failures, el.processLogs(discovered, timestamps)...)
+		logs = append(logs, discovered...)
+		addresses = append(addresses, added...)
+	}
+
+	// 发布失败时返回错误，调用方不推进同步游标并重新处理该范围
+	if el.publisher != nil {
+		sort.SliceStable(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].Index < logs[j].Index
+		})
+		return failures, el.publishRange(fromBlock, toBlock, logs, timestamps)
+	}
+
+	return failures, nil
+}
+
+// processLogs 逐条处理已归档的日志，单条失败记录错误后继续处理其他日志，返回失败日志的错误
+func (el *EventListener) processLogs(logs []types.Log, timestamps map[uint64]time.Time) []error {
+	var failures []error
 	for _, vLog := range logs {
-		if err := el.processLog(vLog); err != nil {
+		if err := el.handleLog(vLog, timestamps[vLog.BlockNumber]); err != nil {
 			logger.WithFields(map[string]interface{}{
 				"error":   err,
 				"tx_hash": vLog.TxHash.Hex(),
 			}).Error("处理事件失败")
-			// 继续处理其他事件，不因单个事件失败而停止
+			failures = append(failures, fmt.Errorf("日志 %s#%d: %w", vLog.TxHash.Hex(), vLog.Index, err))
 		}
 	}
-
-	return nil
+	return failures
 }
 
-// processLog 处理单个日志事件
+// processLog 归档并处理实时订阅推送的单个日志
 func (el *EventListener) processLog(vLog types.Log) error {
-	// 检查是否已处理过此交易
-	exists, err := el.repos.BalanceChange.ExistsByTxHash(vLog.TxHash.Hex())
+	timestamps, err := el.archiveLogs([]types.Log{vLog})
 	if err != nil {
-		return fmt.Errorf("检查交易是否存在失败: %w", err)
+		return err
 	}
-	if exists {
+	return el.handleLog(vLog, timestamps[vLog.BlockNumber])
+}
+
+// handleLog 处理一条已归档的日志
+func (el *EventListener) handleLog(vLog types.Log, timestamp time.Time) error {
+	// 已被重组移除的日志只归档，不写入余额
+	if vLog.Removed {
 		return nil
 	}
 
-	// 获取区块信息以获取时间戳
-	block, err := el.client.BlockByNumber(el.ctx, big.NewInt(int64(vLog.BlockNumber)))
+	// 索引器合约的日志由索引器处理
+	if handled, err := el.indexLog(vLog, timestamp); err != nil || handled {
+		return err
+	}
+
+	// 没有专门处理函数的事件按ABI通用解码后保存，不参与余额变动去重
+	if _, ok := el.handlerFor(vLog); !ok {
+		return el.captureLog(vLog, timestamp)
+	}
+
+	// 检查是否已处理过此日志
+	exists, err := el.repos.BalanceChange.ExistsByLog(el.chainConfig.ChainID, vLog.TxHash.Hex(), vLog.Index)
 	if err != nil {
-		return fmt.Errorf("获取区块信息失败: %w", err)
+		return fmt.Errorf("检查日志是否已处理失败: %w", err)
+	}
+	if exists {
+		return nil
 	}
 
-	timestamp := time.Unix(int64(block.Time()), 0).In(el.loc)
+	return el.applyLog(vLog, timestamp, "")
+}
 
-	// 根据事件类型处理
-	switch vLog.Topics[0] {
-	case el.contractABI.Events["Transfer"].ID:
-		return el.processTransferEvent(vLog, timestamp)
-	case el.contractABI.Events["TokenMinted"].ID:
-		return el.processMintEvent(vLog, timestamp)
-	case el.contractABI.Events["TokenBurned"].ID:
-		return el.processBurnEvent(vLog, timestamp)
-	default:
-		logger.WithField("topic", vLog.Topics[0].Hex()).Warn("未知事件类型")
+// applyLog 根据事件类型处理日志
+// released非空时表示重放该地址的隔离事件：只跳过该地址的冻结检查，其他地址仍然检查冻结状态，也仍然检查负余额
+func (el *EventListener) applyLog(vLog types.Log, timestamp time.Time, released string) error {
+	handler, ok := el.handlerFor(vLog)
+	if !ok {
+		return el.captureLog(vLog, timestamp)
 	}
 
-	return nil
+	ev, err := el.decoder.Decode(vLog)
+	if err != nil {
+		return fmt.Errorf("解析事件失败: %w", err)
+	}
+	return handler(vLog, ev, timestamp, released)
 }
 
 // processTransferEvent 处理转账事件
-func (el *EventListener) processTransferEvent(vLog types.Log, timestamp time.Time) error {
-	// 检查交易是否已经处理过
+func (el *EventListener) processTransferEvent(vLog types.Log, ev *decoder.Event, timestamp time.Time, released string) error {
+	// 检查日志是否已经处理过，同一交易中的多条Transfer（如带税转账）分别处理
 	txHash := vLog.TxHash.Hex()
-	exists, err := el.repos.BalanceChange.ExistsByTxHash(txHash)
+	exists, err := el.repos.BalanceChange.ExistsByLog(el.chainConfig.ChainID, txHash, vLog.Index)
 	if err != nil {
-		return fmt.Errorf("检查交易重复性失败: %w", err)
+		return fmt.Errorf("检查日志重复性失败: %w", err)
 	}
 	if exists {
 		logger.WithFields(map[string]interface{}{
-			"tx_hash": txHash,
-			"event":   "Transfer",
+			"tx_hash":   txHash,
+			"log_index": vLog.Index,
+			"event":     "Transfer",
 		}).Debug("Transfer事件已处理，跳过重复处理")
 		return nil
 	}
 
-	// 解析事件数据
-	event := struct {
-		From  common.Address
-		To    common.Address
-		Value *big.Int
-	}{}
-
-	if err := el.contractABI.UnpackIntoInterface(&event, "Transfer", vLog.Data); err != nil {
-		return fmt.Errorf("解析Transfer事件失败: %w", err)
+	// 读取解码后的参数，indexed参数已从topics中解析
+	from, err := ev.Address("from")
+	if err != nil {
+		return err
+	}
+	to, err := ev.Address("to")
+	if err != nil {
+		return err
+	}
+	value, err := ev.BigInt("value")
+	if err != nil {
+		return err
 	}
-
-	// 从topics中获取indexed参数
-	event.From = common.HexToAddress(vLog.Topics[1].Hex())
-	event.To = common.HexToAddress(vLog.Topics[2].Hex())
 
 	logger.WithFields(map[string]interface{}{
-		"from":    event.From.Hex(),
-		"to":      event.To.Hex(),
-		"value":   event.Value.String(),
+		"from":    from.Hex(),
+		"to":      to.Hex(),
+		"value":   value.String(),
 		"tx_hash": txHash,
 		"block":   vLog.BlockNumber,
 	}).Debug("处理Transfer事件")
 
-	// 处理发送方余额变动（如果不是mint）
-	if event.From != (common.Address{}) {
-		if err := el.updateUserBalanceWithoutDuplicateCheck(event.From.Hex(), event.Value, database.ChangeTypeTransferOut, vLog, timestamp, false); err != nil {
-			return fmt.Errorf("更新发送方余额失败: %w", err)
-		}
+	// 写入前检查冻结状态和负余额，任何一方不通过则整条事件进入隔离
+	// 发送方（不是mint时）和接收方（不是burn时）的余额变动
+	var deltas []balanceDelta
+	if from != (common.Address{}) {
+		deltas = append(deltas, balanceDelta{userAddress: from.Hex(), amount: value, isIncrease: false, changeType: database.ChangeTypeTransferOut})
 	}
-
-	// 处理接收方余额变动（如果不是burn）
-	if event.To != (common.Address{}) {
-		if err := el.updateUserBalanceWithoutDuplicateCheck(event.To.Hex(), event.Value, database.ChangeTypeTransferIn, vLog, timestamp, true); err != nil {
-			return fmt.Errorf("更新接收方余额失败: %w", err)
-		}
+	if to != (common.Address{}) {
+		deltas = append(deltas, balanceDelta{userAddress: to.Hex(), amount: value, isIncrease: true, changeType: database.ChangeTypeTransferIn})
 	}
-
-	return nil
-}
-
-// processTransferEvents 处理转账事件
-func (el *EventListener) processTransferEvents(logs []types.Log) error {
-	for _, vLog := range logs {
-		// 获取区块信息以获取时间戳
-		block, err := el.client.BlockByNumber(el.ctx, big.NewInt(int64(vLog.BlockNumber)))
-		if err != nil {
-			return fmt.Errorf("获取区块信息失败: %w", err)
-		}
-
-		// 转换时间戳
-		timestamp := time.Unix(int64(block.Time()), 0).In(el.loc)
-
-		// 解析事件数据
-		event := struct {
-			From  common.Address
-			To    common.Address
-			Value *big.Int
-		}{}
-
-		if err := el.contractABI.UnpackIntoInterface(&event, "Transfer", vLog.Data); err != nil {
-			return fmt.Errorf("解析Transfer事件失败: %w", err)
-		}
-
-		// 从topics中获取indexed参数
-		event.From = common.HexToAddress(vLog.Topics[1].Hex())
-		event.To = common.HexToAddress(vLog.Topics[2].Hex())
-
-		logger.WithFields(map[string]interface{}{
-			"from":    event.From.Hex(),
-			"to":      event.To.Hex(),
-			"value":   event.Value.String(),
-			"tx_hash": vLog.TxHash.Hex(),
-			"block":   vLog.BlockNumber,
-		}).Debug("处理Transfer事件")
-
-		// 处理发送方余额变动（如果不是mint）
-		if event.From != (common.Address{}) {
-			if err := el.updateUserBalanceWithoutDuplicateCheck(event.From.Hex(), event.Value, database.ChangeTypeTransferOut, vLog, timestamp, false); err != nil {
-				return fmt.Errorf("更新发送方余额失败: %w", err)
-			}
-		}
-
-		// 处理接收方余额变动（如果不是burn）
-		if event.To != (common.Address{}) {
-			if err := el.updateUserBalanceWithoutDuplicateCheck(event.To.Hex(), event.Value, database.ChangeTypeTransferIn, vLog, timestamp, true); err != nil {
-				return fmt.Errorf("更新接收方余额失败: %w", err)
-			}
-		}
-
+	if err := el.checkBalanceDeltas(deltas, released); err != nil {
+		return el.rejectLog(vLog, timestamp, err, released != "")
 	}
 
-	return nil
+	// 双方的余额变动在同一事务中写入，变动记录同时作为日志已处理的标记
+	return el.updateUserBalancesWithoutDuplicateCheck(vLog, timestamp, deltas)
 }
 
 // processMintEvent 处理铸造事件
-func (el *EventListener) processMintEvent(vLog types.Log, timestamp time.Time) error {
-	// 解析事件数据
-	event := struct {
-		To        common.Address
-		Amount    *big.Int
-		Timestamp *big.Int
-	}{}
-
-	if err := el.contractABI.UnpackIntoInterface(&event, "TokenMinted", vLog.Data); err != nil {
-		return fmt.Errorf("解析TokenMinted事件失败: %w", err)
+func (el *EventListener) processMintEvent(vLog types.Log, ev *decoder.Event, timestamp time.Time, released string) error {
+	// 读取解码后的参数
+	to, err := ev.Address("to")
+	if err != nil {
+		return err
+	}
+	amount, err := ev.BigInt("amount")
+	if err != nil {
+		return err
 	}
-
-	// 从topics中获取indexed参数
-	event.To = common.HexToAddress(vLog.Topics[1].Hex())
 
 	logger.WithFields(map[string]interface{}{
-		"to":      event.To.Hex(),
-		"amount":  event.Amount.String(),
+		"to":      to.Hex(),
+		"amount":  amount.String(),
 		"tx_hash": vLog.TxHash.Hex(),
 		"block":   vLog.BlockNumber,
 	}).Debug("处理TokenMinted事件")
 
-	return el.updateUserBalance(event.To.Hex(), event.Amount, database.ChangeTypeMint, vLog, timestamp, true)
+	deltas := []balanceDelta{{userAddress: to.Hex(), amount: amount, isIncrease: true, changeType: database.ChangeTypeMint}}
+	if err := el.checkBalanceDeltas(deltas, released); err != nil {
+		return el.rejectLog(vLog, timestamp, err, released != "")
+	}
+
+	return el.updateUserBalances(vLog, timestamp, deltas)
 }
 
 // processBurnEvent 处理销毁事件
-func (el *EventListener) processBurnEvent(vLog types.Log, timestamp time.Time) error {
-	// 解析事件数据
-	event := struct {
-		From      common.Address
-		Amount    *big.Int
-		Timestamp *big.Int
-	}{}
-
-	if err := el.contractABI.UnpackIntoInterface(&event, "TokenBurned", vLog.Data); err != nil {
-		return fmt.Errorf("解析TokenBurned事件失败: %w", err)
+func (el *EventListener) processBurnEvent(vLog types.Log, ev *decoder.Event, timestamp time.Time, released string) error {
+	// 读取解码后的参数
+	from, err := ev.Address("from")
+	if err != nil {
+		return err
+	}
+	amount, err := ev.BigInt("amount")
+	if err != nil {
+		return err
 	}
-
-	// 从topics中获取indexed参数
-	event.From = common.HexToAddress(vLog.Topics[1].Hex())
 
 	logger.WithFields(map[string]interface{}{
-		"from":    event.From.Hex(),
-		"amount":  event.Amount.String(),
+		"from":    from.Hex(),
+		"amount":  amount.String(),
 		"tx_hash": vLog.TxHash.Hex(),
 		"block":   vLog.BlockNumber,
 	}).Debug("处理TokenBurned事件")
 
-	return el.updateUserBalance(event.From.Hex(), event.Amount, database.ChangeTypeBurn, vLog, timestamp, false)
+	deltas := []balanceDelta{{userAddress: from.Hex(), amount: amount, isIncrease: false, changeType: database.ChangeTypeBurn}}
+	if err := el.checkBalanceDeltas(deltas, released); err != nil {
+		return el.rejectLog(vLog, timestamp, err, released != "")
+	}
+
+	return el.updateUserBalances(vLog, timestamp, deltas)
 }
 
-// updateUserBalance 更新用户余额
-func (el *EventListener) updateUserBalance(userAddress string, amount *big.Int, changeType string, vLog types.Log, timestamp time.Time, isIncrease bool) error {
-	// 检查交易是否已经处理过
+// updateUserBalances 写入一条日志对各地址的余额变动，日志已处理过时跳过
+func (el *EventListener) updateUserBalances(vLog types.Log, timestamp time.Time, deltas []balanceDelta) error {
+	// 检查日志是否已经处理过
 	txHash := vLog.TxHash.Hex()
-	exists, err := el.repos.BalanceChange.ExistsByTxHash(txHash)
+	exists, err := el.repos.BalanceChange.ExistsByLog(el.chainConfig.ChainID, txHash, vLog.Index)
 	if err != nil {
-		return fmt.Errorf("检查交易重复性失败: %w", err)
+		return fmt.Errorf("检查日志重复性失败: %w", err)
 	}
 	if exists {
 		logger.WithFields(map[string]interface{}{
-			"tx_hash": txHash,
-			"user":    userAddress,
-		}).Debug("交易已处理，跳过重复处理")
-		return nil // 交易已处理，直接返回成功
+			"tx_hash":   txHash,
+			"log_index": vLog.Index,
+		}).Debug("日志已处理，跳过重复处理")
+		return nil // 日志已处理，直接返回成功
 	}
 
-	return el.updateUserBalanceWithoutDuplicateCheck(userAddress, amount, changeType, vLog, timestamp, isIncrease)
+	return el.updateUserBalancesWithoutDuplicateCheck(vLog, timestamp, deltas)
 }
 
-// updateUserBalanceWithoutDuplicateCheck 更新用户余额（不进行重复检查）
+// updateUserBalancesWithoutDuplicateCheck 写入余额变动（不进行重复检查）
 // 用于Transfer事件中已经在上层检查过重复性的情况
-func (el *EventListener) updateUserBalanceWithoutDuplicateCheck(userAddress string, amount *big.Int, changeType string, vLog types.Log, timestamp time.Time, isIncrease bool) error {
+// 所有地址的余额、变动记录和Webhook发件箱在同一事务中写入，任何一步失败都不会留下部分结果，
+// 否则重试时已写入的变动记录会让整条日志被当作已处理
+// 余额按增量在数据库中更新，不会覆盖并发写入的对账修正
+func (el *EventListener) updateUserBalancesWithoutDuplicateCheck(vLog types.Log, timestamp time.Time, deltas []balanceDelta) error {
 	txHash := vLog.TxHash.Hex()
 
-	// 获取当前余额
-	currentBalance, err := el.repos.UserBalance.GetBalance(userAddress, el.chainConfig.ChainID)
-	if err != nil {
-		return fmt.Errorf("获取用户余额失败: %w", err)
-	}
-
-	// 计算新余额
-	newBalance := new(big.Int).Set(currentBalance)
-	if isIncrease {
-		newBalance.Add(newBalance, amount)
-	} else {
-		newBalance.Sub(newBalance, amount)
-	}
+	changes := make([]*database.BalanceChange, 0, len(deltas))
+	err := el.repos.Transaction(func(tx *database.Repositories) error {
+		for _, delta := range deltas {
+			signed := new(big.Int).Set(delta.amount)
+			if !delta.isIncrease {
+				signed.Neg(signed)
+			}
 
-	// 确保余额不为负数
-	if newBalance.Sign() < 0 {
-		newBalance.SetInt64(0)
-	}
+			oldBalance, newBalance, err := tx.UserBalance.AddBalance(delta.userAddress, el.chainConfig.ChainID, signed)
+			// 余额不允许为负数：上层已检查过，这里仍然返回错误而不是静默截断为0
+			if errors.Is(err, database.ErrNegativeBalance) {
+				return &QuarantineError{
+					UserAddress: delta.userAddress,
+					Reason:      fmt.Sprintf("余额将变为负数: 当前余额 %s, 扣减 %s", oldBalance.String(), delta.amount.String()),
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("更新用户 %s 余额失败: %w", delta.userAddress, err)
+			}
 
-	// 更新数据库中的余额
-	if err := el.repos.UserBalance.UpdateBalance(userAddress, el.chainConfig.ChainID, newBalance); err != nil {
-		return fmt.Errorf("更新用户余额失败: %w", err)
-	}
+			// 记录余额变动
+			balanceChange := &database.BalanceChange{
+				UserAddress: delta.userAddress,
+				ChainID:     el.chainConfig.ChainID,
+				TxHash:      txHash,
+				LogIndex:    vLog.Index,
+				BlockNumber: vLog.BlockNumber,
+				ChangeType:  delta.changeType,
+				Timestamp:   timestamp,
+				Processed:   false,
+			}
+			balanceChange.SetBalancesFromBigInt(oldBalance, newBalance, delta.amount)
 
-	// 记录余额变动
-	balanceChange := &database.BalanceChange{
-		UserAddress: userAddress,
-		ChainID:     el.chainConfig.ChainID,
-		TxHash:      txHash,
-		BlockNumber: vLog.BlockNumber,
-		ChangeType:  changeType,
-		Timestamp:   timestamp,
-		Processed:   false,
+			if err := tx.BalanceChange.Create(balanceChange); err != nil {
+				return fmt.Errorf("创建余额变动记录失败: %w", err)
+			}
+			if err := el.notifier.Enqueue(tx, webhook.BalanceChanged(balanceChange)); err != nil {
+				return err
+			}
+			changes = append(changes, balanceChange)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	balanceChange.SetBalancesFromBigInt(currentBalance, newBalance, amount)
 
-	if err := el.repos.BalanceChange.Create(balanceChange); err != nil {
-		return fmt.Errorf("创建余额变动记录失败: %w", err)
+	for _, change := range changes {
+		logger.WithFields(map[string]interface{}{
+			"user":        change.UserAddress,
+			"change_type": change.ChangeType,
+			"amount":      change.ChangeAmount,
+			"old_balance": change.BalanceBefore,
+			"new_balance": change.BalanceAfter,
+			"tx_hash":     txHash,
+		}).Info("用户余额已更新")
 	}
 
-	logger.WithFields(map[string]interface{}{
-		"user":        userAddress,
-		"change_type": changeType,
-		"amount":      amount.String(),
-		"old_balance": currentBalance.String(),
-		"new_balance": newBalance.String(),
-		"tx_hash":     txHash,
-	}).Info("用户余额已更新")
-
 	return nil
 }
@@ -0,0 +1,112 @@
+package event_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+	"erc20-tracker/backend/internal/event"
+)
+
+const chainID = 11155111
+
+var (
+	token         = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	alice         = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob           = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// transferLog Transfer归档日志，每个区块一笔交易
+func transferLog(t *testing.T, block uint64, from, to common.Address, value int64) database.RawEventLog {
+	t.Helper()
+	topics, err := json.Marshal([]string{
+		transferTopic.Hex(),
+		common.BytesToHash(from.Bytes()).Hex(),
+		common.BytesToHash(to.Bytes()).Hex(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return database.RawEventLog{
+		ChainID:         chainID,
+		ContractAddress: token.Hex(),
+		TxHash:          common.BigToHash(new(big.Int).SetUint64(block)).Hex(),
+		BlockNumber:     block,
+		BlockHash:       common.BigToHash(new(big.Int).SetUint64(block + 1000)).Hex(),
+		BlockTimestamp:  time.Unix(1700000000+int64(block)*12, 0).UTC(),
+		Topics:          string(topics),
+		Data:            hexutil.Encode(common.BigToHash(big.NewInt(value)).Bytes()),
+	}
+}
+
+func balanceOf(t *testing.T, repos *database.Repositories, user common.Address) int64 {
+	t.Helper()
+	balance, err := repos.UserBalance.GetBalance(user.Hex(), chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return balance.Int64()
+}
+
+func TestTransferLegsAreAtomic(t *testing.T) {
+	db := dbtest.Open(t)
+	repos := database.NewRepositories(db)
+	chain := config.ChainConfig{ChainID: chainID, Name: "test", ContractAddress: token.Hex()}
+	processor, err := event.NewReplayProcessor(chain, repos, &config.Config{Timezone: "UTC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := processor.ApplyArchivedLog(transferLog(t, 1, common.Address{}, alice, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	// 接收方的变动记录写入失败
+	err = db.Exec(`CREATE TRIGGER fail_transfer_in BEFORE INSERT ON balance_changes FOR EACH ROW
+		BEGIN
+			IF NEW.change_type = 'transfer_in' AND NEW.user_address = '` + bob.Hex() + `' THEN
+				SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'transfer_in rejected';
+			END IF;
+		END`).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transfer := transferLog(t, 2, alice, bob, 40)
+	if err := processor.ApplyArchivedLog(transfer); err == nil {
+		t.Fatal("接收方写入失败时没有返回错误")
+	}
+	if got := balanceOf(t, repos, alice); got != 100 {
+		t.Errorf("接收方失败后发送方余额 = %d，期望 100", got)
+	}
+	exists, err := repos.BalanceChange.ExistsByLog(chainID, transfer.TxHash, transfer.LogIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Fatal("接收方失败后日志被标记为已处理")
+	}
+
+	// 重试时双方都写入
+	if err := db.Exec("DROP TRIGGER fail_transfer_in").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := processor.ApplyArchivedLog(transfer); err != nil {
+		t.Fatal(err)
+	}
+	if got := balanceOf(t, repos, alice); got != 60 {
+		t.Errorf("重试后发送方余额 = %d，期望 60", got)
+	}
+	if got := balanceOf(t, repos, bob); got != 40 {
+		t.Errorf("重试后接收方余额 = %d，期望 40", got)
+	}
+}
@@ -0,0 +1,199 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"erc20-tracker/backend/internal/alert"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// QuarantineError 事件因可疑原因被拒绝写入余额
+type QuarantineError struct {
+	UserAddress string
+	Reason      string
+	Frozen      bool // 地址已被冻结（而不是事件本身异常）
+}
+
+func (e *QuarantineError) Error() string {
+	return fmt.Sprintf("事件被隔离 (用户: %s): %s", e.UserAddress, e.Reason)
+}
+
+// balanceDelta 单个事件对某个地址的余额影响
+type balanceDelta struct {
+	userAddress string
+	amount      *big.Int
+	isIncrease  bool
+	changeType  string
+}
+
+// checkBalanceDeltas 写入余额前检查冻结状态与负余额
+// 任何一项不通过都返回*QuarantineError，此时不能写入任何一方的余额
+// released为正在重放隔离事件的地址，由调用方保证其事件顺序，跳过它的冻结检查；交易对手方仍然检查
+func (el *EventListener) checkBalanceDeltas(deltas []balanceDelta, released string) error {
+	for _, delta := range deltas {
+		if released != "" && strings.EqualFold(delta.userAddress, released) {
+			continue
+		}
+		frozen, err := el.repos.QuarantinedEvent.IsFrozen(delta.userAddress, el.chainConfig.ChainID)
+		if err != nil {
+			return fmt.Errorf("检查地址冻结状态失败: %w", err)
+		}
+		if frozen {
+			return &QuarantineError{
+				UserAddress: delta.userAddress,
+				Reason:      "地址已冻结，存在待处理的隔离事件",
+				Frozen:      true,
+			}
+		}
+	}
+
+	for _, delta := range deltas {
+		if delta.isIncrease {
+			continue
+		}
+		balance, err := el.repos.UserBalance.GetBalance(delta.userAddress, el.chainConfig.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取用户余额失败: %w", err)
+		}
+		if balance.Cmp(delta.amount) < 0 {
+			return &QuarantineError{
+				UserAddress: delta.userAddress,
+				Reason:      fmt.Sprintf("余额将变为负数: 当前余额 %s, 扣减 %s", balance.String(), delta.amount.String()),
+			}
+		}
+	}
+
+	return nil
+}
+
+// rejectLog 处理被拒绝的事件：正常同步时写入隔离表并告警，重放时把错误返回给调用方
+func (el *EventListener) rejectLog(vLog types.Log, timestamp time.Time, err error, replay bool) error {
+	var qErr *QuarantineError
+	if replay || !errors.As(err, &qErr) {
+		return err
+	}
+	return el.quarantine(vLog, timestamp, qErr)
+}
+
+// quarantine 保存原始日志到隔离表，冻结地址并发出告警
+func (el *EventListener) quarantine(vLog types.Log, timestamp time.Time, qErr *QuarantineError) error {
+	txHash := vLog.TxHash.Hex()
+
+	// 同一日志可能被历史同步和实时监听重复处理
+	exists, err := el.repos.QuarantinedEvent.ExistsForLog(el.chainConfig.ChainID, txHash, vLog.Index)
+	if err != nil {
+		return fmt.Errorf("检查隔离记录失败: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	topics, err := encodeTopics(vLog.Topics)
+	if err != nil {
+		return err
+	}
+
+	record := &database.QuarantinedEvent{
+		ChainID:         el.chainConfig.ChainID,
+		UserAddress:     qErr.UserAddress,
+		ContractAddress: vLog.Address.Hex(),
+		TxHash:          txHash,
+		LogIndex:        vLog.Index,
+		TxIndex:         vLog.TxIndex,
+		BlockNumber:     vLog.BlockNumber,
+		BlockHash:       vLog.BlockHash.Hex(),
+		Topics:          topics,
+		Data:            hexutil.Encode(vLog.Data),
+		Timestamp:       timestamp,
+		Reason:          qErr.Reason,
+		Status:          database.QuarantineStatusPending,
+	}
+	if err := el.repos.QuarantinedEvent.Create(record); err != nil {
+		return fmt.Errorf("创建隔离记录失败: %w", err)
+	}
+
+	level := alert.LevelCritical
+	if qErr.Frozen {
+		level = alert.LevelWarning
+	}
+	if err := el.alerter.Send(alert.Alert{
+		Level:   level,
+		Title:   "事件已隔离",
+		Message: fmt.Sprintf("链 %s 上地址 %s 的事件已隔离: %s", el.chainConfig.Name, qErr.UserAddress, qErr.Reason),
+		Fields: map[string]interface{}{
+			"chain_id":      el.chainConfig.ChainID,
+			"user":          qErr.UserAddress,
+			"tx_hash":       txHash,
+			"log_index":     vLog.Index,
+			"block":         vLog.BlockNumber,
+			"quarantine_id": record.ID,
+		},
+		Time: time.Now().In(el.loc),
+	}); err != nil {
+		logger.WithField("error", err).Error("发送隔离告警失败")
+	}
+
+	return nil
+}
+
+// ReplayQuarantined 按区块和日志顺序重放地址的待处理隔离事件
+// 遇到仍然不能通过检查的事件时停止，该事件及其后的事件保持隔离状态
+func (el *EventListener) ReplayQuarantined(userAddress string) (int, error) {
+	events, err := el.repos.QuarantinedEvent.ListPending(el.chainConfig.ChainID, userAddress)
+	if err != nil {
+		return 0, fmt.Errorf("获取隔离事件失败: %w", err)
+	}
+
+	replayed := 0
+	for _, event := range events {
+		vLog, err := decodeLog(event.ContractAddress, event.TxHash, event.TxIndex, event.LogIndex,
+			event.BlockNumber, event.BlockHash, event.Topics, event.Data)
+		if err != nil {
+			return replayed, fmt.Errorf("还原隔离事件 %d 失败: %w", event.ID, err)
+		}
+
+		// 与processLog保持一致：日志已写入过余额变动时视为已处理
+		exists, err := el.repos.BalanceChange.ExistsByLog(el.chainConfig.ChainID, event.TxHash, event.LogIndex)
+		if err != nil {
+			return replayed, fmt.Errorf("检查日志是否已处理失败: %w", err)
+		}
+		if exists {
+			if err := el.repos.QuarantinedEvent.MarkStatus(event.ID, database.QuarantineStatusReplayed, "日志已处理，跳过"); err != nil {
+				return replayed, fmt.Errorf("更新隔离事件状态失败: %w", err)
+			}
+			replayed++
+			continue
+		}
+
+		if err := el.applyLog(vLog, event.Timestamp.In(el.loc), event.UserAddress); err != nil {
+			var qErr *QuarantineError
+			if errors.As(err, &qErr) {
+				if updateErr := el.repos.QuarantinedEvent.UpdateReason(event.ID, qErr.Reason); updateErr != nil {
+					logger.WithField("error", updateErr).Error("更新隔离原因失败")
+				}
+			}
+			return replayed, fmt.Errorf("重放隔离事件 %d 失败: %w", event.ID, err)
+		}
+
+		if err := el.repos.QuarantinedEvent.MarkStatus(event.ID, database.QuarantineStatusReplayed, "重放成功"); err != nil {
+			return replayed, fmt.Errorf("更新隔离事件状态失败: %w", err)
+		}
+		replayed++
+
+		logger.WithFields(map[string]interface{}{
+			"quarantine_id": event.ID,
+			"user":          event.UserAddress,
+			"tx_hash":       event.TxHash,
+		}).Info("隔离事件重放成功")
+	}
+
+	return replayed, nil
+}
@@ -0,0 +1,109 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"erc20-tracker/backend/internal/decoder"
+	"erc20-tracker/backend/internal/stream"
+)
+
+// SetPublisher 设置事件流发布器，每个区块范围处理完成后发布其中解码后的事件
+func (el *EventListener) SetPublisher(publisher stream.Publisher) {
+	el.publisher = publisher
+}
+
+// publishRange 解码区块范围内的日志并发布，timestamps为归档时获取的区块时间
+// 发布的是链上日志本身，与是否写入余额（去重、隔离）无关
+func (el *EventListener) publishRange(fromBlock, toBlock uint64, logs []types.Log, timestamps map[uint64]time.Time) error {
+	batch := stream.Batch{
+		ChainID:   el.chainConfig.ChainID,
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Messages:  make([]stream.Message, 0, len(logs)),
+	}
+
+	for _, vLog := range logs {
+		if vLog.Removed {
+			continue
+		}
+
+		timestamp, err := el.blockTime(timestamps, vLog.BlockNumber)
+		if err != nil {
+			return err
+		}
+
+		msg, err := el.decodeMessage(vLog, timestamp)
+		if err != nil {
+			return err
+		}
+		if msg != nil {
+			batch.Messages = append(batch.Messages, *msg)
+		}
+	}
+
+	if err := el.publisher.Publish(el.ctx, batch); err != nil {
+		return fmt.Errorf("发布事件流失败: %w", err)
+	}
+	return nil
+}
+
+// decodeMessage 把日志解码为事件流消息，注册表中没有定义的事件返回nil
+// 代币合约的转账、铸造、销毁事件额外填充From、To、Amount
+func (el *EventListener) decodeMessage(vLog types.Log, timestamp time.Time) (*stream.Message, error) {
+	ev, err := el.decoder.Decode(vLog)
+	if errors.Is(err, decoder.ErrUnknownEvent) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stream.Message{
+		Key:         stream.MessageKey(el.chainConfig.ChainID, vLog.BlockNumber, vLog.Index),
+		ChainID:     el.chainConfig.ChainID,
+		BlockNumber: vLog.BlockNumber,
+		BlockHash:   vLog.BlockHash.Hex(),
+		TxHash:      vLog.TxHash.Hex(),
+		TxIndex:     vLog.TxIndex,
+		LogIndex:    vLog.Index,
+		Contract:    vLog.Address.Hex(),
+		Event:       ev.Name,
+		Args:        ev.Values(),
+		Timestamp:   timestamp,
+	}
+
+	if _, ok := el.handlerFor(vLog); !ok {
+		return msg, nil
+	}
+
+	switch ev.Signature {
+	case SigTransfer:
+		from, _ := ev.Address("from")
+		to, _ := ev.Address("to")
+		value, err := ev.BigInt("value")
+		if err != nil {
+			return nil, err
+		}
+		msg.From, msg.To, msg.Amount = from.Hex(), to.Hex(), value.String()
+	case SigTokenMinted:
+		to, _ := ev.Address("to")
+		amount, err := ev.BigInt("amount")
+		if err != nil {
+			return nil, err
+		}
+		msg.To, msg.Amount = to.Hex(), amount.String()
+	case SigTokenBurned:
+		from, _ := ev.Address("from")
+		amount, err := ev.BigInt("amount")
+		if err != nil {
+			return nil, err
+		}
+		msg.From, msg.Amount = from.Hex(), amount.String()
+	}
+
+	return msg, nil
+}
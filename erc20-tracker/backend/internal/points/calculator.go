@@ -8,14 +8,18 @@ import (
 
 	"erc20-tracker/backend/internal/config"
 	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/vault"
+	"erc20-tracker/backend/internal/webhook"
 	"erc20-tracker/backend/pkg/logger"
 )
 
 // PointsCalculator 积分计算器
 type PointsCalculator struct {
-	repos  *database.Repositories
-	config *config.Config
-	loc    *time.Location
+	repos    *database.Repositories
+	config   *config.Config
+	notifier *webhook.Notifier
+	vaults   *vault.Resolver
+	loc      *time.Location
 }
 
 // NewPointsCalculator 创建积分计算器
@@ -28,9 +32,11 @@ func NewPointsCalculator(repos *database.Repositories, cfg *config.Config) *Poin
 	}
 
 	return &PointsCalculator{
-		repos:  repos,
-		config: cfg,
-		loc:    loc,
+		repos:    repos,
+		config:   cfg,
+		notifier: webhook.NewNotifier(repos, cfg),
+		vaults:   vault.NewResolver(cfg, repos.Vault),
+		loc:      loc,
 	}
 }
 
@@ -43,12 +49,26 @@ func (pc *PointsCalculator) CalculatePointsForUser(userAddress string, chainID i
 		"end_time":   endTime,
 	}).Debug("开始计算用户积分")
 
+	// 资金池持有的代币已按份额计入受益人，资金池地址本身不计积分
+	if pc.vaults.IsVault(chainID, userAddress) {
+		return nil
+	}
+
 	// 获取时间范围内的余额变动记录
 	changes, err := pc.repos.BalanceChange.GetChangesByTimeRange(userAddress, chainID, startTime, endTime)
 	if err != nil {
 		return fmt.Errorf("获取余额变动记录失败: %w", err)
 	}
 
+	// 在资金池中有份额时，与钱包余额合并后计算
+	attributed, err := pc.vaults.Attributed(chainID, userAddress, startTime, endTime)
+	if err != nil {
+		return fmt.Errorf("获取资金池份额失败: %w", err)
+	}
+	if !attributed.IsZero() {
+		return pc.calculateWithVaults(userAddress, chainID, changes, attributed, startTime, endTime)
+	}
+
 	// 如果没有变动记录，检查是否有余额
 	if len(changes) == 0 {
 		currentBalance, err := pc.repos.UserBalance.GetBalance(userAddress, chainID)
@@ -223,27 +243,37 @@ func (pc *PointsCalculator) calculateAverageBalance(changes []database.BalanceCh
 	return averageBalance, totalSeconds / 3600 // 返回小时数
 }
 
-// addPointsAndLog 添加积分并记录日志
+// addPointsAndLog 添加积分并记录日志，积分、计算日志和Webhook发件箱在同一事务中写入
 func (pc *PointsCalculator) addPointsAndLog(userAddress string, chainID int64, points float64, startTime, endTime time.Time, averageBalance *big.Int, holdingHours float64) error {
-	// 添加积分
-	if err := pc.repos.UserPoints.AddPoints(userAddress, chainID, points, endTime); err != nil {
-		return fmt.Errorf("添加用户积分失败: %w", err)
-	}
+	err := pc.repos.Transaction(func(tx *database.Repositories) error {
+		// 添加积分
+		if err := tx.UserPoints.AddPoints(userAddress, chainID, points, endTime); err != nil {
+			return fmt.Errorf("添加用户积分失败: %w", err)
+		}
 
-	// 记录计算日志
-	calcLog := &database.PointsCalculationLog{
-		UserAddress:     userAddress,
-		ChainID:         chainID,
-		CalculationTime: time.Now().In(pc.loc),
-		StartTime:       startTime,
-		EndTime:         endTime,
-		PointsEarned:    points,
-		HoldingHours:    holdingHours,
-	}
-	calcLog.SetAverageBalanceFromBigInt(averageBalance)
+		// 记录计算日志
+		calcLog := &database.PointsCalculationLog{
+			UserAddress:     userAddress,
+			ChainID:         chainID,
+			CalculationTime: time.Now().In(pc.loc),
+			StartTime:       startTime,
+			EndTime:         endTime,
+			PointsEarned:    points,
+			HoldingHours:    holdingHours,
+		}
+		calcLog.SetAverageBalanceFromBigInt(averageBalance)
 
-	if err := pc.repos.PointsCalculationLog.Create(calcLog); err != nil {
-		return fmt.Errorf("创建积分计算日志失败: %w", err)
+		if err := tx.PointsCalculationLog.Create(calcLog); err != nil {
+			return fmt.Errorf("创建积分计算日志失败: %w", err)
+		}
+
+		if points > 0 {
+			return pc.notifier.Enqueue(tx, webhook.PointsCredited(userAddress, chainID, points, startTime, endTime, averageBalance))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	logger.WithFields(map[string]any{
@@ -326,6 +356,17 @@ func (pc *PointsCalculator) calculatePointsForChain(chainID int64, endTime time.
 		return fmt.Errorf("获取需要计算积分的用户失败: %w", err)
 	}
 
+	// 只在资金池中持有份额、钱包里从没有过代币的受益人还没有积分记录，从第一次份额变动开始计算
+	owners, err := pc.vaults.NewOwners(chainID, endTime)
+	if err != nil {
+		return fmt.Errorf("获取资金池受益人失败: %w", err)
+	}
+	for _, owner := range owners {
+		if _, ok := usersMap[owner.Owner]; !ok {
+			usersMap[owner.Owner] = owner.FirstShare
+		}
+	}
+
 	// 如果没有需要计算积分的用户，则返回
 	if len(usersMap) == 0 {
 		return nil
@@ -413,3 +454,49 @@ func (pc *PointsCalculator) BackfillPoints(fromTime, toTime time.Time) error {
 	logger.Info("积分回溯计算完成")
 	return nil
 }
+
+// RecomputeUserPoints 清空用户积分并从第一条余额变动开始重新计算到endTime
+func (pc *PointsCalculator) RecomputeUserPoints(userAddress string, chainID int64, endTime time.Time) error {
+	startTime, err := pc.repos.BalanceChange.GetFirstChangeTime(userAddress, chainID)
+	if err != nil {
+		return fmt.Errorf("获取首次余额变动时间失败: %w", err)
+	}
+	firstShare, err := pc.vaults.FirstShareTime(chainID, userAddress)
+	if err != nil {
+		return fmt.Errorf("获取首次资金池份额变动时间失败: %w", err)
+	}
+	if !firstShare.IsZero() && (startTime.IsZero() || firstShare.Before(startTime)) {
+		startTime = firstShare
+	}
+	if startTime.IsZero() {
+		return fmt.Errorf("用户 %s 在链 %d 上没有余额变动记录", userAddress, chainID)
+	}
+	endTime = endTime.In(pc.loc)
+
+	logger.WithFields(map[string]any{
+		"user":       userAddress,
+		"chain_id":   chainID,
+		"start_time": startTime,
+		"end_time":   endTime,
+	}).Info("开始重新计算用户积分")
+
+	// 清空和重新计算在同一事务中完成，计算失败时保留原有积分和计算日志
+	return pc.repos.Transaction(func(tx *database.Repositories) error {
+		calc := *pc
+		calc.repos = tx
+
+		if err := tx.UserPoints.ResetPoints(userAddress, chainID, startTime); err != nil {
+			return fmt.Errorf("重置用户积分失败: %w", err)
+		}
+		if _, err := tx.PointsCalculationLog.DeleteByUser(userAddress, chainID); err != nil {
+			return fmt.Errorf("删除积分计算日志失败: %w", err)
+		}
+
+		if err := calc.CalculatePointsForUser(userAddress, chainID, startTime, endTime); err != nil {
+			return err
+		}
+
+		// 与定时计算保持一致，更新最后计算时间
+		return tx.UserPoints.AddPoints(userAddress, chainID, 0, endTime)
+	})
+}
@@ -0,0 +1,52 @@
+package points
+
+import (
+	"fmt"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/vault"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// calculateWithVaults 钱包余额与资金池中按份额对应的代币合并，按时间加权计算积分
+func (pc *PointsCalculator) calculateWithVaults(userAddress string, chainID int64, changes []database.BalanceChange, attributed vault.Series, startTime, endTime time.Time) error {
+	wallet, err := pc.walletSeries(userAddress, chainID, changes, startTime)
+	if err != nil {
+		return err
+	}
+
+	averageBalance := wallet.Add(attributed).Average(startTime, endTime)
+	holdingHours := endTime.Sub(startTime).Hours()
+	points := pc.calculatePoints(averageBalance, holdingHours)
+
+	logger.WithFields(map[string]any{
+		"user":               userAddress,
+		"wallet_average":     wallet.Average(startTime, endTime).String(),
+		"attributed_average": attributed.Average(startTime, endTime).String(),
+		"holding_hours":      holdingHours,
+		"points":             points,
+	}).Info("计算含资金池份额的积分")
+
+	if points <= 0 {
+		return nil
+	}
+	return pc.addPointsAndLog(userAddress, chainID, points, startTime, endTime, averageBalance, holdingHours)
+}
+
+// walletSeries 用户钱包余额在时间段内的变化
+func (pc *PointsCalculator) walletSeries(userAddress string, chainID int64, changes []database.BalanceChange, startTime time.Time) (vault.Series, error) {
+	if len(changes) == 0 {
+		balance, err := pc.repos.UserBalance.GetBalance(userAddress, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("获取用户余额失败: %w", err)
+		}
+		return vault.Series{{At: startTime, Amount: balance}}, nil
+	}
+
+	series := vault.Series{{At: startTime, Amount: changes[0].GetBalanceBeforeBigInt()}}
+	for _, change := range changes {
+		series = append(series, vault.Step{At: change.Timestamp, Amount: change.GetBalanceAfterBigInt()})
+	}
+	return series, nil
+}
@@ -0,0 +1,314 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/webhook"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// BalanceOfABI ERC20 balanceOf 方法ABI
+const BalanceOfABI = `[
+	{
+		"constant": true,
+		"inputs": [{"name": "account", "type": "address"}],
+		"name": "balanceOf",
+		"outputs": [{"name": "", "type": "uint256"}],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+// Options 单轮对账参数
+type Options struct {
+	SampleSize int  // 抽样地址数，0表示全量扫描
+	Heal       bool // 是否写入修正记录
+}
+
+// Drift 余额偏差
+type Drift struct {
+	UserAddress string   `json:"user_address"`
+	Stored      *big.Int `json:"stored"`   // 数据库中的余额
+	OnChain     *big.Int `json:"on_chain"` // 链上balanceOf结果
+	Diff        *big.Int `json:"diff"`     // OnChain - Stored
+	Healed      bool     `json:"healed"`
+	Conflict    bool     `json:"conflict"` // 对账后余额又有变动，未修正
+}
+
+// Report 对账报告
+type Report struct {
+	ChainID     int64     `json:"chain_id"`
+	ChainName   string    `json:"chain_name"`
+	BlockNumber uint64    `json:"block_number"`
+	Checked     int       `json:"checked"`
+	Drifts      []Drift   `json:"drifts"`
+	Healed      int       `json:"healed"`
+	Conflicts   int       `json:"conflicts"`
+	HealSkipped bool      `json:"heal_skipped"` // 对账期间同步游标移动，放弃修正
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+}
+
+// Reconciler 链上余额对账器
+type Reconciler struct {
+	client          *ethclient.Client
+	tokenABI        abi.ABI
+	contractAddress common.Address
+	chainConfig     config.ChainConfig
+	repos           *database.Repositories
+	notifier        *webhook.Notifier
+	loc             *time.Location
+}
+
+// NewReconciler 创建对账器
+func NewReconciler(chainConfig config.ChainConfig, repos *database.Repositories, globalConfig *config.Config) (*Reconciler, error) {
+	// 连接以太坊客户端
+	client, err := ethclient.Dial(chainConfig.RPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("连接RPC失败: %w", err)
+	}
+
+	// 解析合约ABI
+	tokenABI, err := abi.JSON(strings.NewReader(BalanceOfABI))
+	if err != nil {
+		return nil, fmt.Errorf("解析ABI失败: %w", err)
+	}
+
+	// 加载时区位置
+	loc, err := time.LoadLocation(globalConfig.Timezone)
+	if err != nil {
+		logger.WithField("error", err).Warn("加载时区失败，使用本地时区")
+		loc = time.Local
+	}
+
+	return &Reconciler{
+		client:          client,
+		tokenABI:        tokenABI,
+		contractAddress: common.HexToAddress(chainConfig.ContractAddress),
+		chainConfig:     chainConfig,
+		repos:           repos,
+		notifier:        webhook.NewNotifier(repos, globalConfig),
+		loc:             loc,
+	}, nil
+}
+
+// Close 关闭RPC连接
+func (r *Reconciler) Close() {
+	r.client.Close()
+}
+
+// Run 执行一轮对账：在最后同步区块上调用balanceOf并与user_balances比较
+func (r *Reconciler) Run(ctx context.Context, opts Options) (*Report, error) {
+	report := &Report{
+		ChainID:   r.chainConfig.ChainID,
+		ChainName: r.chainConfig.Name,
+		StartedAt: time.Now().In(r.loc),
+	}
+
+	// 以同步游标为对账基准区块，保证与数据库状态处于同一高度
+	syncedBlock, err := r.repos.BlockSyncStatus.GetLastSyncedBlock(r.chainConfig.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("获取最后同步区块失败: %w", err)
+	}
+	if syncedBlock == 0 {
+		return nil, fmt.Errorf("链 %s 尚未同步任何区块", r.chainConfig.Name)
+	}
+	report.BlockNumber = syncedBlock
+
+	balances, err := r.repos.UserBalance.ListByChain(r.chainConfig.ChainID, opts.SampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户余额失败: %w", err)
+	}
+
+	for _, balance := range balances {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		onChain, err := r.balanceOf(ctx, common.HexToAddress(balance.UserAddress), syncedBlock)
+		if err != nil {
+			return nil, fmt.Errorf("查询链上余额失败 (用户: %s): %w", balance.UserAddress, err)
+		}
+		report.Checked++
+
+		stored := balance.GetBalanceBigInt()
+		if stored.Cmp(onChain) == 0 {
+			continue
+		}
+
+		drift := Drift{
+			UserAddress: balance.UserAddress,
+			Stored:      stored,
+			OnChain:     onChain,
+			Diff:        new(big.Int).Sub(onChain, stored),
+		}
+		report.Drifts = append(report.Drifts, drift)
+
+		logger.WithFields(map[string]interface{}{
+			"chain":    r.chainConfig.Name,
+			"user":     balance.UserAddress,
+			"block":    syncedBlock,
+			"stored":   stored.String(),
+			"on_chain": onChain.String(),
+			"diff":     drift.Diff.String(),
+		}).Warn("发现余额偏差")
+	}
+
+	if opts.Heal && len(report.Drifts) > 0 {
+		if err := r.heal(ctx, report); err != nil {
+			return nil, err
+		}
+	}
+
+	report.FinishedAt = time.Now().In(r.loc)
+
+	logger.WithFields(map[string]interface{}{
+		"chain":   r.chainConfig.Name,
+		"block":   syncedBlock,
+		"checked": report.Checked,
+		"drifts":  len(report.Drifts),
+		"healed":  report.Healed,
+	}).Info("余额对账完成")
+
+	return report, nil
+}
+
+// errHealConflict 修正时发现余额在对账之后已变动
+var errHealConflict = errors.New("余额在对账之后已变动")
+
+// heal 为每个偏差写入修正记录并把余额更新为链上值
+// 余额更新以对账时读到的值为条件，与修正记录在同一事务中写入，读取之后监听器写入的变动不会被覆盖
+func (r *Reconciler) heal(ctx context.Context, report *Report) error {
+	// 对账期间监听器可能已处理了新区块，此时数据库余额已不在基准高度上，修正会引入新的错误
+	currentBlock, err := r.repos.BlockSyncStatus.GetLastSyncedBlock(r.chainConfig.ChainID)
+	if err != nil {
+		return fmt.Errorf("获取最后同步区块失败: %w", err)
+	}
+	if currentBlock != report.BlockNumber {
+		report.HealSkipped = true
+		logger.WithFields(map[string]interface{}{
+			"chain":         r.chainConfig.Name,
+			"base_block":    report.BlockNumber,
+			"current_block": currentBlock,
+		}).Warn("对账期间同步游标已移动，跳过自动修正")
+		return nil
+	}
+
+	header, err := r.client.HeaderByNumber(ctx, new(big.Int).SetUint64(report.BlockNumber))
+	if err != nil {
+		return fmt.Errorf("获取区块信息失败: %w", err)
+	}
+	timestamp := time.Unix(int64(header.Time), 0).In(r.loc)
+
+	for i := range report.Drifts {
+		drift := &report.Drifts[i]
+
+		err := r.applyCorrection(drift, report.BlockNumber, timestamp)
+		if errors.Is(err, errHealConflict) {
+			drift.Conflict = true
+			report.Conflicts++
+			logger.WithFields(map[string]interface{}{
+				"chain": r.chainConfig.Name,
+				"user":  drift.UserAddress,
+				"block": report.BlockNumber,
+			}).Warn("对账后余额已变动，跳过修正")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("写入余额修正失败 (用户: %s): %w", drift.UserAddress, err)
+		}
+
+		drift.Healed = true
+		report.Healed++
+
+		logger.WithFields(map[string]interface{}{
+			"chain": r.chainConfig.Name,
+			"user":  drift.UserAddress,
+			"block": report.BlockNumber,
+			"diff":  drift.Diff.String(),
+		}).Warn("已写入余额修正记录")
+	}
+
+	return nil
+}
+
+// applyCorrection 在一个事务中写入单个偏差的修正记录，并以对账时读到的余额为条件更新余额
+// 基准区块之后已有变动，或者余额已被监听器修改时返回errHealConflict
+func (r *Reconciler) applyCorrection(drift *Drift, blockNumber uint64, timestamp time.Time) error {
+	correction := &database.BalanceChange{
+		UserAddress: drift.UserAddress,
+		ChainID:     r.chainConfig.ChainID,
+		TxHash:      correctionHash(r.chainConfig.ChainID, blockNumber, drift.UserAddress),
+		BlockNumber: blockNumber,
+		ChangeType:  database.ChangeTypeCorrection,
+		Timestamp:   timestamp,
+		Processed:   false,
+	}
+	correction.SetBalancesFromBigInt(drift.Stored, drift.OnChain, drift.Diff)
+
+	return r.repos.Transaction(func(tx *database.Repositories) error {
+		// 实时订阅可能已写入基准区块之后的事件，此时数据库余额与基准区块的链上余额不可比
+		later, err := tx.BalanceChange.HasChangesAfterBlock(drift.UserAddress, r.chainConfig.ChainID, blockNumber)
+		if err != nil {
+			return fmt.Errorf("检查后续余额变动失败: %w", err)
+		}
+		if later {
+			return errHealConflict
+		}
+		updated, err := tx.UserBalance.CompareAndSetBalance(drift.UserAddress, r.chainConfig.ChainID, drift.Stored, drift.OnChain)
+		if err != nil {
+			return fmt.Errorf("更新用户余额失败: %w", err)
+		}
+		if !updated {
+			return errHealConflict
+		}
+		if err := tx.BalanceChange.Create(correction); err != nil {
+			return fmt.Errorf("创建修正记录失败: %w", err)
+		}
+		return r.notifier.Enqueue(tx, webhook.BalanceChanged(correction))
+	})
+}
+
+// balanceOf 在指定区块调用合约balanceOf
+func (r *Reconciler) balanceOf(ctx context.Context, account common.Address, blockNumber uint64) (*big.Int, error) {
+	data, err := r.tokenABI.Pack("balanceOf", account)
+	if err != nil {
+		return nil, fmt.Errorf("编码balanceOf调用失败: %w", err)
+	}
+
+	output, err := r.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &r.contractAddress,
+		Data: data,
+	}, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.tokenABI.Unpack("balanceOf", output)
+	if err != nil {
+		return nil, fmt.Errorf("解析balanceOf结果失败: %w", err)
+	}
+	return results[0].(*big.Int), nil
+}
+
+// correctionHash 生成修正记录的唯一标识，占用tx_hash字段
+// 同一链、区块、用户只会生成一条修正记录
+func correctionHash(chainID int64, blockNumber uint64, userAddress string) string {
+	key := fmt.Sprintf("reconcile:%d:%d:%s", chainID, blockNumber, strings.ToLower(userAddress))
+	return crypto.Keccak256Hash([]byte(key)).Hex()
+}
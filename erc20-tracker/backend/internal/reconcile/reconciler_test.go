@@ -0,0 +1,173 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+	"erc20-tracker/backend/internal/event"
+)
+
+const chainID = 11155111
+
+var (
+	token         = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	alice         = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// mint 铸造给alice的Transfer归档日志
+func mint(t *testing.T, block uint64, logIndex uint, value int64) database.RawEventLog {
+	t.Helper()
+	topics, err := json.Marshal([]string{
+		transferTopic.Hex(),
+		common.Hash{}.Hex(),
+		common.BytesToHash(alice.Bytes()).Hex(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return database.RawEventLog{
+		ChainID:         chainID,
+		ContractAddress: token.Hex(),
+		TxHash:          common.BigToHash(new(big.Int).SetUint64(block*1000 + uint64(logIndex))).Hex(),
+		LogIndex:        logIndex,
+		BlockNumber:     block,
+		BlockHash:       common.BigToHash(new(big.Int).SetUint64(block)).Hex(),
+		BlockTimestamp:  time.Unix(1700000000+int64(block)*12, 0).UTC(),
+		Topics:          string(topics),
+		Data:            hexutil.Encode(common.BigToHash(big.NewInt(value)).Bytes()),
+	}
+}
+
+// setup 创建监听器的重放处理器和只用于写入修正的对账器，alice初始余额为100
+func setup(t *testing.T, db *database.DB) (*database.Repositories, *event.EventListener, *Reconciler) {
+	t.Helper()
+	repos := database.NewRepositories(db)
+	chain := config.ChainConfig{ChainID: chainID, Name: "test", ContractAddress: token.Hex()}
+	cfg := &config.Config{Timezone: "UTC"}
+
+	listener, err := event.NewReplayProcessor(chain, repos, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.ApplyArchivedLog(mint(t, 1, 0, 100)); err != nil {
+		t.Fatal(err)
+	}
+	return repos, listener, &Reconciler{chainConfig: chain, repos: repos, loc: time.UTC}
+}
+
+func balanceOf(t *testing.T, repos *database.Repositories) int64 {
+	t.Helper()
+	balance, err := repos.UserBalance.GetBalance(alice.Hex(), chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return balance.Int64()
+}
+
+func drift(stored, onChain int64) *Drift {
+	return &Drift{
+		UserAddress: alice.Hex(),
+		Stored:      big.NewInt(stored),
+		OnChain:     big.NewInt(onChain),
+		Diff:        big.NewInt(onChain - stored),
+	}
+}
+
+func TestCorrectionInterleavedWithListener(t *testing.T) {
+	repos, listener, r := setup(t, dbtest.Open(t))
+
+	// 对账读到100之后监听器写入了同一区块内的变动，修正不能覆盖
+	if err := listener.ApplyArchivedLog(mint(t, 2, 0, 10)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.applyCorrection(drift(100, 150), 2, time.Now()); !errors.Is(err, errHealConflict) {
+		t.Fatalf("余额已变动时修正返回 %v，期望冲突", err)
+	}
+	if got := balanceOf(t, repos); got != 110 {
+		t.Fatalf("冲突后余额 = %d，期望 110", got)
+	}
+
+	// 修正之后监听器的变动在修正后的余额上累加
+	if err := r.applyCorrection(drift(110, 160), 3, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.ApplyArchivedLog(mint(t, 3, 1, 5)); err != nil {
+		t.Fatal(err)
+	}
+	if got := balanceOf(t, repos); got != 165 {
+		t.Fatalf("修正后监听器写入的余额 = %d，期望 165", got)
+	}
+
+	// 区块3中修正之后的变动记录以修正后的余额为起点
+	var change database.BalanceChange
+	err := repos.BalanceChange.ForEachChangeInRange(chainID, 2, 3, func(c database.BalanceChange) error {
+		if c.ChangeType == database.ChangeTypeTransferIn {
+			change = c
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.BalanceBefore != "160" || change.BalanceAfter != "165" {
+		t.Errorf("修正后的变动记录 %s -> %s，期望 160 -> 165", change.BalanceBefore, change.BalanceAfter)
+	}
+}
+
+func TestCorrectionConcurrentWithListener(t *testing.T) {
+	db := dbtest.Open(t)
+	dbtest.RequireRowLocks(t, db)
+	repos, listener, r := setup(t, db)
+
+	// 监听器并发写入20笔变动，同时按对账时读到的100写入修正
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n+1)
+	healed := false
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := listener.ApplyArchivedLog(mint(t, 2, uint(i), 1)); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := r.applyCorrection(drift(100, 1000), 2, time.Now())
+		switch {
+		case err == nil:
+			healed = true
+		case !errors.Is(err, errHealConflict):
+			errs <- err
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// 修正只可能在所有变动之前生效，否则必须冲突；任何一笔变动都不能丢失
+	want := int64(100 + n)
+	if healed {
+		want = 1000 + n
+	}
+	if got := balanceOf(t, repos); got != want {
+		t.Errorf("并发写入后余额 = %d，期望 %d（修正生效: %v）", got, want, healed)
+	}
+}
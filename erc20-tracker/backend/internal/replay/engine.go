@@ -0,0 +1,324 @@
+package replay
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/internal/points"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// Options 重放参数
+type Options struct {
+	FromBlock       uint64    // 起始区块，不能晚于第一条归档日志，见CheckFromBlock
+	ToBlock         uint64    // 结束区块，0表示归档中的全部日志
+	PointsUntil     time.Time // 生产库中没有积分记录的用户，积分计算到该时间
+	BatchSize       int       // 每批读取的归档日志数
+	PointsTolerance float64   // 积分比较的容差
+}
+
+// Mismatch 重放结果与生产数据的差异
+type Mismatch struct {
+	UserAddress string `json:"user_address"`
+	Field       string `json:"field"` // balance, change_count, points
+	Production  string `json:"production"`
+	Replayed    string `json:"replayed"`
+}
+
+// Result 重放结果
+type Result struct {
+	ChainID       int64        `json:"chain_id"`
+	ChainName     string       `json:"chain_name"`
+	LogsReplayed  int          `json:"logs_replayed"`
+	LastBlock     uint64       `json:"last_block"`
+	UsersCompared int          `json:"users_compared"`
+	Mismatches    []Mismatch   `json:"mismatches"`
+	Corrections   []Correction `json:"corrections,omitempty"`
+}
+
+// Correction 生产库中截至LastBlock的对账修正记录数
+// 重放不会产生修正记录，因此不计入change_count，单独列出
+type Correction struct {
+	UserAddress string `json:"user_address"`
+	Count       int64  `json:"count"`
+}
+
+// Engine 从原始日志归档重建余额、变动记录和积分，并与生产数据比较
+type Engine struct {
+	chainConfig config.ChainConfig
+	config      *config.Config
+	source      *database.Repositories // 生产库（读取归档和比较基准）
+	target      *database.DB           // 影子库（写入重放结果）
+	targetRepos *database.Repositories
+}
+
+// NewEngine 创建重放引擎
+func NewEngine(chainConfig config.ChainConfig, cfg *config.Config, source *database.Repositories, target *database.DB) *Engine {
+	return &Engine{
+		chainConfig: chainConfig,
+		config:      cfg,
+		source:      source,
+		target:      target,
+		targetRepos: database.NewRepositories(target),
+	}
+}
+
+// Run 清空影子库的派生表，按区块和日志顺序重放归档日志，重算积分并比较差异
+func (e *Engine) Run(opts Options) (*Result, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1000
+	}
+	if opts.PointsTolerance <= 0 {
+		opts.PointsTolerance = 0.01
+	}
+
+	result := &Result{
+		ChainID:   e.chainConfig.ChainID,
+		ChainName: e.chainConfig.Name,
+	}
+
+	if err := CheckFromBlock(e.source, e.chainConfig.ChainID, opts.FromBlock); err != nil {
+		return nil, err
+	}
+	if err := e.target.TruncateDerivedTables(); err != nil {
+		return nil, err
+	}
+
+	processor, err := event.NewReplayProcessor(e.chainConfig, e.targetRepos, e.config)
+	if err != nil {
+		return nil, err
+	}
+
+	for offset := 0; ; offset += opts.BatchSize {
+		logs, err := e.source.RawEventLog.ListByRange(e.chainConfig.ChainID, opts.FromBlock, opts.ToBlock, offset, opts.BatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("读取归档日志失败: %w", err)
+		}
+
+		for _, raw := range logs {
+			if err := processor.ApplyArchivedLog(raw); err != nil {
+				// 与正常同步一致：单条事件失败不影响后续事件，差异会体现在比较结果中
+				logger.WithFields(map[string]interface{}{
+					"error":     err,
+					"tx_hash":   raw.TxHash,
+					"log_index": raw.LogIndex,
+				}).Error("重放事件失败")
+			}
+			result.LogsReplayed++
+			result.LastBlock = raw.BlockNumber
+		}
+
+		if len(logs) < opts.BatchSize {
+			break
+		}
+	}
+
+	if result.LastBlock > 0 {
+		if err := e.targetRepos.BlockSyncStatus.UpdateLastSyncedBlock(e.chainConfig.ChainID, result.LastBlock); err != nil {
+			return nil, fmt.Errorf("更新影子库同步状态失败: %w", err)
+		}
+	}
+
+	productionPoints, err := e.pointsByUser(e.source)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.recomputePoints(productionPoints, opts.PointsUntil); err != nil {
+		return nil, err
+	}
+
+	if err := e.diff(result, productionPoints, opts.PointsTolerance); err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain":      e.chainConfig.Name,
+		"logs":       result.LogsReplayed,
+		"last_block": result.LastBlock,
+		"mismatches": len(result.Mismatches),
+	}).Info("归档重放完成")
+
+	return result, nil
+}
+
+// CheckFromBlock 检查重放的起始区块
+// 重放前会清空影子库的派生表，余额从0开始累计，因此必须从第一条归档日志开始，
+// 否则之前区块中的余额变动会全部丢失，比较结果中到处都是差异
+func CheckFromBlock(source *database.Repositories, chainID int64, fromBlock uint64) error {
+	first, ok, err := source.RawEventLog.FirstBlock(chainID)
+	if err != nil {
+		return fmt.Errorf("获取第一条归档日志失败: %w", err)
+	}
+	if ok && fromBlock > first {
+		return fmt.Errorf("起始区块 %d 晚于第一条归档日志所在的区块 %d，重放会从零余额开始，必须从第一条归档日志开始", fromBlock, first)
+	}
+	return nil
+}
+
+// recomputePoints 为影子库中的每个用户重算积分
+// 生产库有积分记录的用户计算到其last_calculated_at，保证两边时间窗口一致
+func (e *Engine) recomputePoints(productionPoints map[string]database.UserPoints, pointsUntil time.Time) error {
+	if pointsUntil.IsZero() {
+		pointsUntil = time.Now()
+	}
+
+	changeCounts, err := e.targetRepos.BalanceChange.CountGroupByUser(e.chainConfig.ChainID)
+	if err != nil {
+		return fmt.Errorf("统计影子库余额变动失败: %w", err)
+	}
+
+	calculator := points.NewPointsCalculator(e.targetRepos, e.config)
+	for _, user := range sortedKeys(changeCounts) {
+		endTime := pointsUntil
+		if production, ok := productionPoints[user]; ok {
+			endTime = production.LastCalculatedAt
+		}
+		if err := calculator.RecomputeUserPoints(user, e.chainConfig.ChainID, endTime); err != nil {
+			return fmt.Errorf("重算用户 %s 积分失败: %w", user, err)
+		}
+	}
+	return nil
+}
+
+// diff 比较影子库与生产库的余额、变动记录数和积分
+// 生产库可能已经同步到重放范围之后，余额和变动记录数取截至LastBlock的状态
+func (e *Engine) diff(result *Result, productionPoints map[string]database.UserPoints, tolerance float64) error {
+	chainID := e.chainConfig.ChainID
+
+	productionBalances, productionCounts, err := e.productionAt(result)
+	if err != nil {
+		return err
+	}
+	replayedBalances, err := balancesByUser(e.targetRepos, chainID)
+	if err != nil {
+		return err
+	}
+	replayedCounts, err := e.targetRepos.BalanceChange.CountGroupByUser(chainID)
+	if err != nil {
+		return fmt.Errorf("统计影子库余额变动失败: %w", err)
+	}
+	replayedPoints, err := e.pointsByUser(e.targetRepos)
+	if err != nil {
+		return err
+	}
+
+	users := make(map[string]struct{})
+	for _, m := range []map[string]string{productionBalances, replayedBalances} {
+		for user := range m {
+			users[user] = struct{}{}
+		}
+	}
+	for _, m := range []map[string]int64{productionCounts, replayedCounts} {
+		for user := range m {
+			users[user] = struct{}{}
+		}
+	}
+
+	for _, user := range sortedKeys(users) {
+		result.UsersCompared++
+
+		production, replayed := valueOr(productionBalances, user, "0"), valueOr(replayedBalances, user, "0")
+		if production != replayed {
+			result.Mismatches = append(result.Mismatches, Mismatch{user, "balance", production, replayed})
+		}
+
+		productionCount, replayedCount := productionCounts[user], replayedCounts[user]
+		if productionCount != replayedCount {
+			result.Mismatches = append(result.Mismatches, Mismatch{
+				user, "change_count", fmt.Sprintf("%d", productionCount), fmt.Sprintf("%d", replayedCount),
+			})
+		}
+
+		productionTotal, replayedTotal := productionPoints[user].TotalPoints, replayedPoints[user].TotalPoints
+		if math.Abs(productionTotal-replayedTotal) > tolerance {
+			result.Mismatches = append(result.Mismatches, Mismatch{
+				user, "points", fmt.Sprintf("%.4f", productionTotal), fmt.Sprintf("%.4f", replayedTotal),
+			})
+		}
+	}
+
+	return nil
+}
+
+// productionAt 按链上顺序累加生产库截至LastBlock的余额变动，得到当时的余额和变动记录数
+// 余额包含对账修正；修正记录单独计入result.Corrections，不计入变动记录数
+func (e *Engine) productionAt(result *Result) (map[string]string, map[string]int64, error) {
+	sums := make(map[string]*big.Int)
+	counts := make(map[string]int64)
+	corrections := make(map[string]int64)
+	err := e.source.BalanceChange.ForEachChangeInRange(e.chainConfig.ChainID, 0, result.LastBlock, func(change database.BalanceChange) error {
+		sum, ok := sums[change.UserAddress]
+		if !ok {
+			sum = new(big.Int)
+			sums[change.UserAddress] = sum
+		}
+		sum.Add(sum, change.GetDeltaBigInt())
+
+		if change.ChangeType == database.ChangeTypeCorrection {
+			corrections[change.UserAddress]++
+			return nil
+		}
+		counts[change.UserAddress]++
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取生产库余额变动失败: %w", err)
+	}
+	for _, user := range sortedKeys(corrections) {
+		result.Corrections = append(result.Corrections, Correction{user, corrections[user]})
+	}
+
+	balances := make(map[string]string, len(sums))
+	for user, sum := range sums {
+		balances[user] = sum.String()
+	}
+	return balances, counts, nil
+}
+
+// pointsByUser 按用户索引积分记录
+func (e *Engine) pointsByUser(repos *database.Repositories) (map[string]database.UserPoints, error) {
+	list, err := repos.UserPoints.ListByChain(e.chainConfig.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("获取积分记录失败: %w", err)
+	}
+	result := make(map[string]database.UserPoints, len(list))
+	for _, p := range list {
+		result[p.UserAddress] = p
+	}
+	return result, nil
+}
+
+// balancesByUser 按用户索引余额，余额统一为十进制字符串
+func balancesByUser(repos *database.Repositories, chainID int64) (map[string]string, error) {
+	list, err := repos.UserBalance.ListByChain(chainID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取余额记录失败: %w", err)
+	}
+	result := make(map[string]string, len(list))
+	for _, b := range list {
+		result[b.UserAddress] = b.GetBalanceBigInt().String()
+	}
+	return result, nil
+}
+
+func valueOr(m map[string]string, key, fallback string) string {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return fallback
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
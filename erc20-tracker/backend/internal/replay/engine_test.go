@@ -0,0 +1,110 @@
+package replay
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+	"erc20-tracker/backend/internal/event"
+)
+
+const chainID = 11155111
+
+var (
+	token         = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	alice         = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// mint 铸造给alice的Transfer归档日志，每个区块一笔交易
+func mint(t *testing.T, block uint64, value int64) database.RawEventLog {
+	t.Helper()
+	topics, err := json.Marshal([]string{
+		transferTopic.Hex(),
+		common.Hash{}.Hex(),
+		common.BytesToHash(alice.Bytes()).Hex(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return database.RawEventLog{
+		ChainID:         chainID,
+		ContractAddress: token.Hex(),
+		TxHash:          common.BigToHash(new(big.Int).SetUint64(block)).Hex(),
+		BlockNumber:     block,
+		BlockHash:       common.BigToHash(new(big.Int).SetUint64(block + 1000)).Hex(),
+		BlockTimestamp:  time.Unix(1700000000+int64(block)*12, 0).UTC(),
+		Topics:          string(topics),
+		Data:            hexutil.Encode(common.BigToHash(big.NewInt(value)).Bytes()),
+	}
+}
+
+func TestDiffAgainstProductionAtLastBlock(t *testing.T) {
+	source := database.NewRepositories(dbtest.Open(t))
+	chain := config.ChainConfig{ChainID: chainID, Name: "test", ContractAddress: token.Hex()}
+	cfg := &config.Config{Timezone: "UTC"}
+
+	listener, err := event.NewReplayProcessor(chain, source, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 生产库处理了区块1到3，归档只到区块2
+	for block := uint64(1); block <= 3; block++ {
+		raw := mint(t, block, 10)
+		if err := listener.ApplyArchivedLog(raw); err != nil {
+			t.Fatal(err)
+		}
+		if block <= 2 {
+			if err := source.RawEventLog.Save(&raw); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// 区块2结束时的对账修正
+	correction := &database.BalanceChange{
+		UserAddress: alice.Hex(),
+		ChainID:     chainID,
+		TxHash:      "correction",
+		BlockNumber: 2,
+		ChangeType:  database.ChangeTypeCorrection,
+		Timestamp:   time.Unix(1700000024, 0),
+	}
+	correction.SetBalancesFromBigInt(big.NewInt(20), big.NewInt(25), big.NewInt(5))
+	if err := source.BalanceChange.Create(correction); err != nil {
+		t.Fatal(err)
+	}
+
+	engine := NewEngine(chain, cfg, source, dbtest.Open(t))
+	result, err := engine.Run(Options{PointsUntil: time.Unix(1700000036, 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.LastBlock != 2 {
+		t.Fatalf("最后区块 = %d，期望 2", result.LastBlock)
+	}
+
+	// 区块3的变动不参与比较；修正计入余额但不计入变动记录数
+	var balance *Mismatch
+	for i, m := range result.Mismatches {
+		switch m.Field {
+		case "balance":
+			balance = &result.Mismatches[i]
+		case "change_count":
+			t.Errorf("变动记录数差异: 生产=%s 重放=%s", m.Production, m.Replayed)
+		}
+	}
+	if balance == nil || balance.Production != "25" || balance.Replayed != "20" {
+		t.Errorf("余额差异 = %+v，期望 生产=25 重放=20", balance)
+	}
+	if result.Mismatches[0].UserAddress != alice.Hex() || result.Corrections[0].UserAddress == "" {
+		t.Errorf("修正记录 = %+v，期望 %s 1条", result.Mismatches, alice.Hex())
+	}
+}
@@ -112,6 +112,12 @@ func RetryWithContext(ctx context.Context, fn RetryableFuncWithContext, config R
 	return lastErr
 }
 
+// DelayFor 返回第attempt次失败后、下一次尝试前的等待时间
+// 用于在进程外持久化重试计划（如Webhook发件箱），与RetryWithContext使用相同的退避策略
+func (c RetryConfig) DelayFor(attempt int) time.Duration {
+	return calculateDelay(c.Delay, attempt, c.Backoff)
+}
+
 // calculateDelay 计算延迟时间
 func calculateDelay(baseDelay time.Duration, attempt int, backoff BackoffType) time.Duration {
 	switch backoff {
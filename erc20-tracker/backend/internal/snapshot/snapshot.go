@@ -0,0 +1,207 @@
+package snapshot
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// 快照数据来源
+const (
+	SourceLedger       = "ledger"       // 从余额变动记录完整计算
+	SourceMaterialized = "materialized" // 从物化快照出发，叠加之后的变动
+)
+
+// Holder 持有人余额
+type Holder struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// Snapshot 某一区块高度的持有人快照
+type Snapshot struct {
+	ChainID     int64     `json:"chain_id"`
+	BlockNumber uint64    `json:"block_number"`
+	At          time.Time `json:"at,omitempty"` // 按时间查询时的时间点
+	Source      string    `json:"source"`
+	BaseBlock   uint64    `json:"base_block,omitempty"` // 物化快照所在区块
+	HolderCount int       `json:"holder_count"`
+	TotalSupply string    `json:"total_supply"` // 持有人余额之和
+	Holders     []Holder  `json:"holders"`
+}
+
+// Service 余额快照服务
+type Service struct {
+	repos *database.Repositories
+	loc   *time.Location
+}
+
+// NewService 创建余额快照服务
+func NewService(repos *database.Repositories, loc *time.Location) *Service {
+	return &Service{repos: repos, loc: loc}
+}
+
+// AtBlock 计算区块高度上的持有人及余额
+// 存在不晚于该区块的物化快照时以其为起点，否则从余额变动记录完整计算
+func (s *Service) AtBlock(chainID int64, blockNumber uint64) (*Snapshot, error) {
+	balances := make(map[string]*big.Int)
+	snap := &Snapshot{
+		ChainID:     chainID,
+		BlockNumber: blockNumber,
+		Source:      SourceLedger,
+		Holders:     []Holder{},
+	}
+
+	base, err := s.repos.BalanceSnapshot.FindLatestAtOrBefore(chainID, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("查询物化快照失败: %w", err)
+	}
+
+	var afterBlock uint64
+	if base != nil {
+		entries, err := s.repos.BalanceSnapshot.ListEntries(base.ID)
+		if err != nil {
+			return nil, fmt.Errorf("获取物化快照明细失败: %w", err)
+		}
+		for _, entry := range entries {
+			balance, ok := new(big.Int).SetString(entry.Balance, 10)
+			if !ok {
+				return nil, fmt.Errorf("无效的快照余额: %s", entry.Balance)
+			}
+			balances[entry.UserAddress] = balance
+		}
+		afterBlock = base.BlockNumber
+		snap.Source = SourceMaterialized
+		snap.BaseBlock = base.BlockNumber
+	}
+
+	// 按链上顺序累加每条变动的差值；不能直接取最后一条的balance_after，
+	// 隔离事件重放时写入的记录晚于之后区块的变动，交易对手方之后记录的balance_after已包含这笔变动
+	err = s.repos.BalanceChange.ForEachChangeInRange(chainID, afterBlock, blockNumber, func(change database.BalanceChange) error {
+		balance, ok := balances[change.UserAddress]
+		if !ok {
+			balance = new(big.Int)
+			balances[change.UserAddress] = balance
+		}
+		balance.Add(balance, change.GetDeltaBigInt())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("遍历余额变动失败: %w", err)
+	}
+
+	total := big.NewInt(0)
+	for address, balance := range balances {
+		if balance.Sign() <= 0 {
+			continue
+		}
+		snap.Holders = append(snap.Holders, Holder{Address: address, Balance: balance.String()})
+		total.Add(total, balance)
+	}
+	sortHolders(snap.Holders, balances)
+
+	snap.HolderCount = len(snap.Holders)
+	snap.TotalSupply = total.String()
+	return snap, nil
+}
+
+// AtTime 计算时间点上的持有人及余额，时间点换算为此前最后一条余额变动所在的区块
+func (s *Service) AtTime(chainID int64, t time.Time) (*Snapshot, error) {
+	blockNumber, found, err := s.repos.BalanceChange.LatestBlockAtOrBefore(chainID, t)
+	if err != nil {
+		return nil, fmt.Errorf("查询时间点对应区块失败: %w", err)
+	}
+	if !found {
+		return &Snapshot{ChainID: chainID, At: t.In(s.loc), Source: SourceLedger, TotalSupply: "0", Holders: []Holder{}}, nil
+	}
+
+	snap, err := s.AtBlock(chainID, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	snap.At = t.In(s.loc)
+	return snap, nil
+}
+
+// Materialize 把区块高度上的快照持久化，已存在时不重复创建
+func (s *Service) Materialize(chainID int64, blockNumber uint64) (*database.BalanceSnapshot, error) {
+	exists, err := s.repos.BalanceSnapshot.ExistsAtBlock(chainID, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("检查物化快照失败: %w", err)
+	}
+	if exists {
+		return s.repos.BalanceSnapshot.FindLatestAtOrBefore(chainID, blockNumber)
+	}
+
+	snap, err := s.AtBlock(chainID, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &database.BalanceSnapshot{
+		ChainID:     chainID,
+		BlockNumber: blockNumber,
+		TakenAt:     time.Now().In(s.loc),
+		HolderCount: snap.HolderCount,
+		TotalSupply: snap.TotalSupply,
+	}
+	entries := make([]database.BalanceSnapshotEntry, 0, len(snap.Holders))
+	for _, holder := range snap.Holders {
+		entries = append(entries, database.BalanceSnapshotEntry{
+			UserAddress: holder.Address,
+			Balance:     holder.Balance,
+		})
+	}
+
+	if err := s.repos.BalanceSnapshot.Create(record, entries); err != nil {
+		return nil, fmt.Errorf("保存物化快照失败: %w", err)
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain_id":     chainID,
+		"block":        blockNumber,
+		"holder_count": record.HolderCount,
+	}).Info("物化余额快照已保存")
+
+	return record, nil
+}
+
+// WriteJSON 以JSON格式输出快照
+func WriteJSON(w io.Writer, snap *Snapshot) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(snap)
+}
+
+// WriteCSV 以CSV格式输出快照持有人（address,balance）
+func WriteCSV(w io.Writer, snap *Snapshot) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"address", "balance"}); err != nil {
+		return err
+	}
+	for _, holder := range snap.Holders {
+		if err := writer.Write([]string{holder.Address, holder.Balance}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// sortHolders 按余额降序、地址升序排列
+func sortHolders(holders []Holder, balances map[string]*big.Int) {
+	sort.Slice(holders, func(i, j int) bool {
+		cmp := balances[holders[i].Address].Cmp(balances[holders[j].Address])
+		if cmp != 0 {
+			return cmp > 0
+		}
+		return holders[i].Address < holders[j].Address
+	})
+}
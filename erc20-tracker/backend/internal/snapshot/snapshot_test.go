@@ -0,0 +1,95 @@
+package snapshot
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+)
+
+const (
+	chainID = 11155111
+	alice   = "0x00000000000000000000000000000000000A11cE"
+	bob     = "0x0000000000000000000000000000000000000B0b"
+)
+
+func TestAtBlockWithReplayedChanges(t *testing.T) {
+	repos := database.NewRepositories(dbtest.Open(t))
+
+	// 按写入顺序：区块2中alice转给bob的50被隔离，区块3的变动先写入，之后重放区块2
+	changes := []struct {
+		user       string
+		block      uint64
+		logIndex   uint
+		changeType string
+		before     int64
+		after      int64
+		amount     int64
+	}{
+		{alice, 1, 0, database.ChangeTypeMint, 0, 100, 100},
+		{bob, 3, 0, database.ChangeTypeMint, 0, 10, 10},
+		{alice, 2, 0, database.ChangeTypeTransferOut, 100, 50, 50},
+		{bob, 2, 0, database.ChangeTypeTransferIn, 10, 60, 50},
+		// 对账修正排在区块的最后，change_amount带符号
+		{bob, 3, 0, database.ChangeTypeCorrection, 60, 55, -5},
+	}
+	for i, c := range changes {
+		change := &database.BalanceChange{
+			UserAddress: c.user,
+			ChainID:     chainID,
+			TxHash:      big.NewInt(int64(i + 1)).Text(16),
+			LogIndex:    c.logIndex,
+			BlockNumber: c.block,
+			ChangeType:  c.changeType,
+			Timestamp:   time.Unix(1700000000+int64(c.block)*12, 0),
+		}
+		change.SetBalancesFromBigInt(big.NewInt(c.before), big.NewInt(c.after), big.NewInt(c.amount))
+		if err := repos.BalanceChange.Create(change); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service := NewService(repos, time.UTC)
+	tests := []struct {
+		block    uint64
+		balances map[string]string
+		total    string
+	}{
+		{block: 1, balances: map[string]string{alice: "100"}, total: "100"},
+		{block: 2, balances: map[string]string{alice: "50", bob: "50"}, total: "100"},
+		{block: 3, balances: map[string]string{alice: "50", bob: "55"}, total: "105"},
+	}
+	for _, tt := range tests {
+		snap, err := service.AtBlock(chainID, tt.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string]string)
+		for _, holder := range snap.Holders {
+			got[holder.Address] = holder.Balance
+		}
+		if len(got) != len(tt.balances) || snap.TotalSupply != tt.total {
+			t.Errorf("区块 %d 快照 = %v（合计 %s），期望 %v（合计 %s）", tt.block, got, snap.TotalSupply, tt.balances, tt.total)
+			continue
+		}
+		for address, want := range tt.balances {
+			if got[address] != want {
+				t.Errorf("区块 %d %s 余额 = %s，期望 %s", tt.block, address, got[address], want)
+			}
+		}
+	}
+
+	// 以物化快照为起点叠加之后的变动，结果相同
+	if _, err := service.Materialize(chainID, 2); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := service.AtBlock(chainID, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.Source != SourceMaterialized || snap.TotalSupply != "105" {
+		t.Errorf("基于物化快照的区块3快照 = %s，合计 %s", snap.Source, snap.TotalSupply)
+	}
+}
@@ -0,0 +1,406 @@
+package stake
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	stakebind "erc20-tracker/backend/internal/bindings/stake"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+func init() {
+	event.RegisterIndexer("stake", func(chain config.ChainConfig, _ *config.Config, repos *database.Repositories) (event.Indexer, error) {
+		if chain.StakeContract == "" {
+			return nil, nil
+		}
+		return NewIndexer(chain, repos.Stake)
+	})
+}
+
+// stakeHandler 质押合约事件的处理函数，在记录日志的同一事务中执行
+type stakeHandler func(tx *database.StakeTx, vLog types.Log, timestamp time.Time) error
+
+// Indexer StakeContract事件索引器
+// 维护质押池TVL、用户持仓、解质押请求和奖励领取记录
+type Indexer struct {
+	chain    config.ChainConfig
+	address  common.Address
+	filterer *stakebind.StakeContractFilterer
+	repo     *database.StakeRepository
+	events   *event.EventHandlers[stakeHandler]
+}
+
+// NewIndexer 创建质押合约索引器
+func NewIndexer(chain config.ChainConfig, repo *database.StakeRepository) (*Indexer, error) {
+	if !common.IsHexAddress(chain.StakeContract) {
+		return nil, fmt.Errorf("无效的质押合约地址: %q", chain.StakeContract)
+	}
+	address := common.HexToAddress(chain.StakeContract)
+
+	filterer, err := stakebind.NewStakeContractFilterer(address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建质押合约绑定失败: %w", err)
+	}
+
+	idx := &Indexer{
+		chain:    chain,
+		address:  address,
+		filterer: filterer,
+		repo:     repo,
+	}
+	byName := map[string]stakeHandler{
+		"PoolAdded":        idx.handlePoolAdded,
+		"PoolUpdated":      idx.handlePoolUpdated,
+		"Staked":           idx.handleStaked,
+		"UnstakeRequested": idx.handleUnstakeRequested,
+		"Unstaked":         idx.handleUnstaked,
+		"RewardClaimed":    idx.handleRewardClaimed,
+	}
+	if idx.events, err = event.BindEvents(stakebind.StakeContractMetaData.GetAbi, "质押合约", byName); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Name 索引器名称
+func (idx *Indexer) Name() string {
+	return "StakeContract"
+}
+
+// ABIs 质押合约ABI
+func (idx *Indexer) ABIs() map[string]string {
+	return map[string]string{"StakeContract": stakebind.StakeContractMetaData.ABI}
+}
+
+// Addresses 质押合约地址
+func (idx *Indexer) Addresses() []common.Address {
+	return []common.Address{idx.address}
+}
+
+// HandleLog 处理质押合约日志，权限、暂停等其他事件返回false，按通用合约事件保存
+func (idx *Indexer) HandleLog(vLog types.Log, timestamp time.Time) (bool, error) {
+	handler, name, ok := idx.events.Lookup(vLog)
+	if !ok {
+		return false, nil
+	}
+	err := event.ApplyLog(idx, idx.chain, name, vLog, idx.repo.Apply, func(tx *database.StakeTx) error {
+		return handler(tx, vLog, timestamp)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// handlePoolAdded 新增质押池
+func (idx *Indexer) handlePoolAdded(tx *database.StakeTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.filterer.ParsePoolAdded(vLog)
+	if err != nil {
+		return err
+	}
+
+	pid := ev.Pid.Uint64()
+	pool, err := tx.GetPool(pid)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		pool = newPool(pid, vLog.BlockNumber)
+	}
+	pool.StTokenAddress = ev.StTokenAddress.Hex()
+	pool.PoolWeight = ev.PoolWeight.String()
+	pool.MinDepositAmount = ev.MinDepositAmount.String()
+	pool.UnstakeLockedBlocks = ev.UnstakeLockedBlocks.Uint64()
+	pool.AddedBlock = vLog.BlockNumber
+	pool.UpdatedBlock = vLog.BlockNumber
+
+	logger.WithFields(map[string]interface{}{
+		"chain":    idx.chain.Name,
+		"pid":      pid,
+		"st_token": pool.StTokenAddress,
+		"weight":   pool.PoolWeight,
+	}).Info("新增质押池")
+	return tx.SavePool(pool)
+}
+
+// handlePoolUpdated 更新质押池参数
+func (idx *Indexer) handlePoolUpdated(tx *database.StakeTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.filterer.ParsePoolUpdated(vLog)
+	if err != nil {
+		return err
+	}
+
+	pool, err := idx.loadPool(tx, ev.Pid.Uint64(), vLog)
+	if err != nil {
+		return err
+	}
+	pool.PoolWeight = ev.PoolWeight.String()
+	pool.MinDepositAmount = ev.MinDepositAmount.String()
+	pool.UnstakeLockedBlocks = ev.UnstakeLockedBlocks.Uint64()
+	pool.UpdatedBlock = vLog.BlockNumber
+	return tx.SavePool(pool)
+}
+
+// handleStaked 用户质押
+func (idx *Indexer) handleStaked(tx *database.StakeTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.filterer.ParseStaked(vLog)
+	if err != nil {
+		return err
+	}
+
+	pid := ev.Pid.Uint64()
+	pool, err := idx.loadPool(tx, pid, vLog)
+	if err != nil {
+		return err
+	}
+	position, err := tx.GetOrNewPosition(pid, ev.User.Hex())
+	if err != nil {
+		return err
+	}
+
+	staked := database.ParseDecimal(position.StakedAmount)
+	if staked.Sign() == 0 {
+		pool.StakerCount++
+	}
+	position.StakedAmount = staked.Add(staked, ev.Amount).String()
+	position.UpdatedBlock = vLog.BlockNumber
+	pool.TotalStaked = database.AddDecimal(pool.TotalStaked, ev.Amount)
+	pool.UpdatedBlock = vLog.BlockNumber
+
+	if err := idx.recordShare(tx, pool, ev.User, ev.Amount, vLog, timestamp); err != nil {
+		return err
+	}
+	if err := tx.SavePosition(position); err != nil {
+		return err
+	}
+	return tx.SavePool(pool)
+}
+
+// handleUnstakeRequested 申请解质押：数量从质押转入待提取，并记录解锁区块
+func (idx *Indexer) handleUnstakeRequested(tx *database.StakeTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.filterer.ParseUnstakeRequested(vLog)
+	if err != nil {
+		return err
+	}
+
+	pid := ev.Pid.Uint64()
+	pool, err := idx.loadPool(tx, pid, vLog)
+	if err != nil {
+		return err
+	}
+	position, err := tx.GetOrNewPosition(pid, ev.User.Hex())
+	if err != nil {
+		return err
+	}
+
+	wasStaking := database.ParseDecimal(position.StakedAmount).Sign() > 0
+	position.StakedAmount = idx.subDecimal(position.StakedAmount, ev.Amount, "staked_amount", vLog)
+	position.PendingUnstake = database.AddDecimal(position.PendingUnstake, ev.Amount)
+	position.UpdatedBlock = vLog.BlockNumber
+	if wasStaking && database.ParseDecimal(position.StakedAmount).Sign() == 0 && pool.StakerCount > 0 {
+		pool.StakerCount--
+	}
+	pool.TotalStaked = idx.subDecimal(pool.TotalStaked, ev.Amount, "total_staked", vLog)
+	pool.PendingUnstake = database.AddDecimal(pool.PendingUnstake, ev.Amount)
+	pool.UpdatedBlock = vLog.BlockNumber
+
+	request := &database.StakeUnstakeRequest{
+		PoolID:        pid,
+		UserAddress:   ev.User.Hex(),
+		Amount:        ev.Amount.String(),
+		UnlockBlock:   ev.UnlockBlock.Uint64(),
+		Status:        database.UnstakeStatusPending,
+		RequestTxHash: vLog.TxHash.Hex(),
+		RequestBlock:  vLog.BlockNumber,
+		RequestedAt:   timestamp,
+	}
+	if err := tx.CreateRequest(request); err != nil {
+		return err
+	}
+	if err := tx.SavePosition(position); err != nil {
+		return err
+	}
+	return tx.SavePool(pool)
+}
+
+// handleUnstaked 提取已解锁的解质押请求
+// 事件不带请求下标（合约按下标交换删除），按数量匹配已解锁的最早请求
+func (idx *Indexer) handleUnstaked(tx *database.StakeTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.filterer.ParseUnstaked(vLog)
+	if err != nil {
+		return err
+	}
+
+	pid := ev.Pid.Uint64()
+	pool, err := idx.loadPool(tx, pid, vLog)
+	if err != nil {
+		return err
+	}
+	position, err := tx.GetOrNewPosition(pid, ev.User.Hex())
+	if err != nil {
+		return err
+	}
+
+	requests, err := tx.ListPendingRequests(pid, ev.User.Hex())
+	if err != nil {
+		return err
+	}
+	if request := matchRequest(requests, ev.Amount, vLog.BlockNumber); request != nil {
+		withdrawnAt := timestamp
+		request.Status = database.UnstakeStatusWithdrawn
+		request.WithdrawTxHash = vLog.TxHash.Hex()
+		request.WithdrawBlock = vLog.BlockNumber
+		request.WithdrawnAt = &withdrawnAt
+		if err := tx.SaveRequest(request); err != nil {
+			return err
+		}
+	} else {
+		logger.WithFields(map[string]interface{}{
+			"chain":   idx.chain.Name,
+			"pid":     pid,
+			"user":    ev.User.Hex(),
+			"amount":  ev.Amount.String(),
+			"tx_hash": vLog.TxHash.Hex(),
+		}).Warn("未找到与Unstaked事件匹配的解质押请求，可能起始区块晚于请求")
+	}
+
+	position.PendingUnstake = idx.subDecimal(position.PendingUnstake, ev.Amount, "pending_unstake", vLog)
+	position.UpdatedBlock = vLog.BlockNumber
+	pool.PendingUnstake = idx.subDecimal(pool.PendingUnstake, ev.Amount, "pending_unstake", vLog)
+	pool.UpdatedBlock = vLog.BlockNumber
+
+	if err := idx.recordShare(tx, pool, ev.User, new(big.Int).Neg(ev.Amount), vLog, timestamp); err != nil {
+		return err
+	}
+	if err := tx.SavePosition(position); err != nil {
+		return err
+	}
+	return tx.SavePool(pool)
+}
+
+// handleRewardClaimed 领取奖励
+func (idx *Indexer) handleRewardClaimed(tx *database.StakeTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.filterer.ParseRewardClaimed(vLog)
+	if err != nil {
+		return err
+	}
+
+	pid := ev.Pid.Uint64()
+	pool, err := idx.loadPool(tx, pid, vLog)
+	if err != nil {
+		return err
+	}
+	position, err := tx.GetOrNewPosition(pid, ev.User.Hex())
+	if err != nil {
+		return err
+	}
+
+	claim := &database.StakeRewardClaim{
+		PoolID:      pid,
+		UserAddress: ev.User.Hex(),
+		Amount:      ev.Amount.String(),
+		TxHash:      vLog.TxHash.Hex(),
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   timestamp,
+	}
+	if err := tx.CreateClaim(claim); err != nil {
+		return err
+	}
+
+	position.TotalClaimed = database.AddDecimal(position.TotalClaimed, ev.Amount)
+	position.UpdatedBlock = vLog.BlockNumber
+	pool.TotalRewardsClaimed = database.AddDecimal(pool.TotalRewardsClaimed, ev.Amount)
+	pool.UpdatedBlock = vLog.BlockNumber
+
+	if err := tx.SavePosition(position); err != nil {
+		return err
+	}
+	return tx.SavePool(pool)
+}
+
+// loadPool 获取质押池；起始区块晚于PoolAdded时池不存在，创建缺少代币地址的占位记录
+func (idx *Indexer) loadPool(tx *database.StakeTx, pid uint64, vLog types.Log) (*database.StakePool, error) {
+	pool, err := tx.GetPool(pid)
+	if err != nil || pool != nil {
+		return pool, err
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"chain":   idx.chain.Name,
+		"pid":     pid,
+		"tx_hash": vLog.TxHash.Hex(),
+	}).Warn("质押池不存在，可能起始区块晚于PoolAdded，创建占位记录")
+	return newPool(pid, vLog.BlockNumber), nil
+}
+
+// recordShare 质押池的代币是追踪的代币时，把质押数量的变化记为受益人在质押合约中的份额
+// 申请解质押后代币仍在合约中，直到Unstaked提取才减少
+func (idx *Indexer) recordShare(tx *database.StakeTx, pool *database.StakePool, user common.Address, delta *big.Int, vLog types.Log, timestamp time.Time) error {
+	if !common.IsHexAddress(pool.StTokenAddress) ||
+		common.HexToAddress(pool.StTokenAddress) != common.HexToAddress(idx.chain.ContractAddress) {
+		return nil
+	}
+	return tx.RecordVaultShare(&database.VaultShareChange{
+		Owner:       user.Hex(),
+		Delta:       delta.String(),
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   timestamp,
+	})
+}
+
+// subDecimal 十进制金额相减，结果为负时按0处理并记录警告（缺少起始区块之前的历史）
+func (idx *Indexer) subDecimal(value string, amount *big.Int, field string, vLog types.Log) string {
+	result := new(big.Int).Sub(database.ParseDecimal(value), amount)
+	if result.Sign() < 0 {
+		logger.WithFields(map[string]interface{}{
+			"chain":   idx.chain.Name,
+			"field":   field,
+			"current": value,
+			"amount":  amount.String(),
+			"tx_hash": vLog.TxHash.Hex(),
+		}).Warn("质押数量将变为负数，按0处理")
+		return "0"
+	}
+	return result.String()
+}
+
+// newPool 创建零值质押池
+func newPool(pid uint64, block uint64) *database.StakePool {
+	return &database.StakePool{
+		PoolID:              pid,
+		PoolWeight:          "0",
+		MinDepositAmount:    "0",
+		TotalStaked:         "0",
+		PendingUnstake:      "0",
+		TotalRewardsClaimed: "0",
+		AddedBlock:          block,
+		UpdatedBlock:        block,
+	}
+}
+
+// matchRequest 在待提取请求中找数量相同且已解锁的最早请求，没有已解锁的则取数量相同的最早请求
+func matchRequest(requests []database.StakeUnstakeRequest, amount *big.Int, block uint64) *database.StakeUnstakeRequest {
+	var fallback *database.StakeUnstakeRequest
+	for i := range requests {
+		request := &requests[i]
+		if database.ParseDecimal(request.Amount).Cmp(amount) != 0 {
+			continue
+		}
+		if request.UnlockBlock <= block {
+			return request
+		}
+		if fallback == nil {
+			fallback = request
+		}
+	}
+	return fallback
+}
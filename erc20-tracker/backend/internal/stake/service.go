@@ -0,0 +1,117 @@
+package stake
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+
+	"erc20-tracker/backend/internal/database"
+)
+
+// ErrPoolNotFound 质押池不存在
+var ErrPoolNotFound = errors.New("质押池不存在")
+
+// Pool 质押池视图
+type Pool struct {
+	database.StakePool
+	ETHPool bool   `json:"eth_pool"` // 质押代币为ETH
+	TVL     string `json:"tvl"`      // 合约中锁定的质押代币：质押中加上待提取
+}
+
+// PoolDetail 质押池详情
+type PoolDetail struct {
+	Pool
+	TopStakers []database.StakePosition `json:"top_stakers"`
+}
+
+// User 用户质押视图
+type User struct {
+	ChainID         int64                          `json:"chain_id"`
+	Address         string                         `json:"address"`
+	TotalClaimed    string                         `json:"total_claimed"`
+	Positions       []database.StakePosition       `json:"positions"`
+	PendingRequests []database.StakeUnstakeRequest `json:"pending_requests"`
+	RecentClaims    []database.StakeRewardClaim    `json:"recent_claims"`
+}
+
+// Service 质押数据查询服务
+type Service struct {
+	repo *database.StakeRepository
+}
+
+// NewService 创建质押查询服务
+func NewService(repos *database.Repositories) *Service {
+	return &Service{repo: repos.Stake}
+}
+
+// Pools 链上所有质押池
+func (s *Service) Pools(chainID int64) ([]Pool, error) {
+	pools, err := s.repo.ListPools(chainID)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]Pool, 0, len(pools))
+	for _, pool := range pools {
+		views = append(views, newPoolView(pool))
+	}
+	return views, nil
+}
+
+// Pool 质押池详情，包含质押数量最多的用户
+func (s *Service) Pool(chainID int64, pid uint64, top int) (*PoolDetail, error) {
+	pool, err := s.repo.GetPool(chainID, pid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrPoolNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stakers, err := s.repo.TopPositions(chainID, pid, top)
+	if err != nil {
+		return nil, err
+	}
+	return &PoolDetail{Pool: newPoolView(*pool), TopStakers: stakers}, nil
+}
+
+// User 用户在各池的持仓、待提取的解质押请求和最近的奖励领取
+func (s *Service) User(chainID int64, address string, claims int) (*User, error) {
+	positions, err := s.repo.ListPositions(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := s.repo.ListRequests(chainID, address, database.UnstakeStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	recent, err := s.repo.ListClaims(chainID, address, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	total := big.NewInt(0)
+	for _, position := range positions {
+		total.Add(total, database.ParseDecimal(position.TotalClaimed))
+	}
+
+	return &User{
+		ChainID:         chainID,
+		Address:         address,
+		TotalClaimed:    total.String(),
+		Positions:       positions,
+		PendingRequests: requests,
+		RecentClaims:    recent,
+	}, nil
+}
+
+// newPoolView 计算质押池的TVL
+func newPoolView(pool database.StakePool) Pool {
+	tvl := new(big.Int).Add(database.ParseDecimal(pool.TotalStaked), database.ParseDecimal(pool.PendingUnstake))
+	return Pool{
+		StakePool: pool,
+		ETHPool:   pool.StTokenAddress == (common.Address{}).Hex(),
+		TVL:       tvl.String(),
+	}
+}
@@ -0,0 +1,42 @@
+package stream
+
+import "context"
+
+// ChannelSink 把消息发送到进程内channel，用于测试和嵌入式消费
+type ChannelSink struct {
+	name     string
+	messages chan Message
+}
+
+// NewChannelSink 创建channel输出，buffer为channel容量
+func NewChannelSink(name string, buffer int) *ChannelSink {
+	return &ChannelSink{name: name, messages: make(chan Message, buffer)}
+}
+
+// Name 输出名称
+func (s *ChannelSink) Name() string {
+	return s.name
+}
+
+// Messages 返回消息channel，Close后关闭
+func (s *ChannelSink) Messages() <-chan Message {
+	return s.messages
+}
+
+// Publish 按顺序发送批次中的消息，channel已满时阻塞直到ctx取消
+func (s *ChannelSink) Publish(ctx context.Context, batch Batch) error {
+	for _, msg := range batch.Messages {
+		select {
+		case s.messages <- msg:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close 关闭channel
+func (s *ChannelSink) Close() error {
+	close(s.messages)
+	return nil
+}
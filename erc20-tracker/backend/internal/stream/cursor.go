@@ -0,0 +1,57 @@
+package stream
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Position 发布位置
+type Position struct {
+	BlockNumber uint64
+	LogIndex    uint
+}
+
+// After 判断消息是否在位置之后
+func (p Position) After(m Message) bool {
+	if m.BlockNumber != p.BlockNumber {
+		return m.BlockNumber > p.BlockNumber
+	}
+	return m.LogIndex > p.LogIndex
+}
+
+// CursorStore 保存每个输出在每条链上最后发布的位置
+type CursorStore interface {
+	GetCursor(sink string, chainID int64) (Position, bool, error)
+	SaveCursor(sink string, chainID int64, position Position) error
+}
+
+// MemoryCursors 内存中的发布位置，进程重启后丢失，用于测试和进程内输出
+type MemoryCursors struct {
+	mu        sync.Mutex
+	positions map[string]Position
+}
+
+// NewMemoryCursors 创建内存发布位置存储
+func NewMemoryCursors() *MemoryCursors {
+	return &MemoryCursors{positions: make(map[string]Position)}
+}
+
+// GetCursor 获取发布位置
+func (c *MemoryCursors) GetCursor(sink string, chainID int64) (Position, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	position, ok := c.positions[cursorKey(sink, chainID)]
+	return position, ok, nil
+}
+
+// SaveCursor 保存发布位置
+func (c *MemoryCursors) SaveCursor(sink string, chainID int64, position Position) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.positions[cursorKey(sink, chainID)] = position
+	return nil
+}
+
+func cursorKey(sink string, chainID int64) string {
+	return fmt.Sprintf("%s/%d", sink, chainID)
+}
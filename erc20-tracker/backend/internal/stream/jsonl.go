@@ -0,0 +1,69 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"erc20-tracker/backend/internal/config"
+)
+
+func init() {
+	Register("jsonl", func(cfg *config.Config) (Publisher, error) {
+		return NewJSONLSink(cfg.Stream.JSONLPath)
+	})
+}
+
+// JSONLSink 把消息逐行追加写入JSON Lines文件，每个批次写完后落盘
+type JSONLSink struct {
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// NewJSONLSink 打开（或创建）JSONL文件
+func NewJSONLSink(path string) (*JSONLSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("JSONL输出路径不能为空")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("创建目录失败: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("打开文件失败: %w", err)
+	}
+	return &JSONLSink{path: path, file: file, writer: bufio.NewWriter(file)}, nil
+}
+
+// Name 输出名称
+func (s *JSONLSink) Name() string {
+	return "jsonl"
+}
+
+// Publish 追加写入一个批次
+func (s *JSONLSink) Publish(ctx context.Context, batch Batch) error {
+	encoder := json.NewEncoder(s.writer)
+	for _, msg := range batch.Messages {
+		if err := encoder.Encode(msg); err != nil {
+			return fmt.Errorf("写入消息 %s 失败: %w", msg.Key, err)
+		}
+	}
+	if err := s.writer.Flush(); err != nil {
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+	return s.file.Sync()
+}
+
+// Close 关闭文件
+func (s *JSONLSink) Close() error {
+	if err := s.writer.Flush(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,93 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// Multi 把批次发布到多个输出，并按各输出的发布位置去重
+// 同一区块范围被重新处理时（例如更新同步游标失败后重试），已发布过的消息不会再次发布
+// 输出写入成功但保存位置前进程退出时，消息可能重复一次，消费方应按Key去重
+type Multi struct {
+	mu      sync.Mutex
+	sinks   []Publisher
+	cursors CursorStore
+}
+
+// NewMulti 创建组合发布器，cursors为nil时使用内存发布位置
+func NewMulti(cursors CursorStore, sinks ...Publisher) *Multi {
+	if cursors == nil {
+		cursors = NewMemoryCursors()
+	}
+	return &Multi{sinks: sinks, cursors: cursors}
+}
+
+// Name 发布器名称
+func (m *Multi) Name() string {
+	return "multi"
+}
+
+// Publish 依次发布到每个输出，任一输出失败时返回错误
+func (m *Multi) Publish(ctx context.Context, batch Batch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batch.Sort()
+
+	for _, sink := range m.sinks {
+		position, found, err := m.cursors.GetCursor(sink.Name(), batch.ChainID)
+		if err != nil {
+			return fmt.Errorf("获取发布位置失败 (%s): %w", sink.Name(), err)
+		}
+
+		pending := batch
+		if found {
+			pending.Messages = nil
+			for _, msg := range batch.Messages {
+				if position.After(msg) {
+					pending.Messages = append(pending.Messages, msg)
+				}
+			}
+		}
+		if len(pending.Messages) == 0 {
+			continue
+		}
+
+		if err := sink.Publish(ctx, pending); err != nil {
+			return fmt.Errorf("发布到 %s 失败: %w", sink.Name(), err)
+		}
+
+		last := pending.Messages[len(pending.Messages)-1]
+		if err := m.cursors.SaveCursor(sink.Name(), batch.ChainID, Position{BlockNumber: last.BlockNumber, LogIndex: last.LogIndex}); err != nil {
+			return fmt.Errorf("保存发布位置失败 (%s): %w", sink.Name(), err)
+		}
+
+		logger.WithFields(map[string]interface{}{
+			"sink":       sink.Name(),
+			"chain_id":   batch.ChainID,
+			"from_block": batch.FromBlock,
+			"to_block":   batch.ToBlock,
+			"messages":   len(pending.Messages),
+		}).Debug("消息已发布")
+	}
+
+	return nil
+}
+
+// Close 关闭所有输出
+func (m *Multi) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for _, sink := range m.sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("关闭 %s 失败: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+)
+
+// Message 解码后的合约事件，Key在(链, 区块, 日志序号)上唯一，消费方可据此去重
+type Message struct {
+	Key         string                 `json:"key"`
+	ChainID     int64                  `json:"chain_id"`
+	BlockNumber uint64                 `json:"block_number"`
+	BlockHash   string                 `json:"block_hash"`
+	TxHash      string                 `json:"tx_hash"`
+	TxIndex     uint                   `json:"tx_index"`
+	LogIndex    uint                   `json:"log_index"`
+	Contract    string                 `json:"contract"`
+	Event       string                 `json:"event"`          // ABI中的事件名
+	From        string                 `json:"from,omitempty"` // 以下三项只在代币合约的Transfer、TokenMinted、TokenBurned事件中填充
+	To          string                 `json:"to,omitempty"`
+	Amount      string                 `json:"amount,omitempty"`
+	Args        map[string]interface{} `json:"args,omitempty"` // 按ABI解码的全部参数
+	Timestamp   time.Time              `json:"timestamp"`
+}
+
+// MessageKey 生成消息键: <chain_id>:<block_number>:<log_index>
+func MessageKey(chainID int64, blockNumber uint64, logIndex uint) string {
+	return fmt.Sprintf("%d:%d:%d", chainID, blockNumber, logIndex)
+}
+
+// Batch 一个已处理完成的区块范围内的消息，按区块和日志序号升序排列
+type Batch struct {
+	ChainID   int64
+	FromBlock uint64
+	ToBlock   uint64
+	Messages  []Message
+}
+
+// Sort 按区块和日志序号排序
+func (b *Batch) Sort() {
+	sort.Slice(b.Messages, func(i, j int) bool {
+		if b.Messages[i].BlockNumber != b.Messages[j].BlockNumber {
+			return b.Messages[i].BlockNumber < b.Messages[j].BlockNumber
+		}
+		return b.Messages[i].LogIndex < b.Messages[j].LogIndex
+	})
+}
+
+// Publisher 消息发布接口
+// 同一链的Publish按区块顺序调用；返回错误时监听器会重新处理该区块范围
+type Publisher interface {
+	Name() string
+	Publish(ctx context.Context, batch Batch) error
+	Close() error
+}
+
+// Factory 根据配置创建发布器
+type Factory func(cfg *config.Config) (Publisher, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+// Register 注册发布器类型，消息队列适配器在自己的包中通过init注册
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("发布器类型 %s 重复注册", name))
+	}
+	registry[name] = factory
+}
+
+// New 按配置中的STREAM_SINKS创建发布器，未配置时返回nil
+// 多个输出通过Multi组合，并按cursors跳过已发布过的消息
+func New(cfg *config.Config, cursors CursorStore) (Publisher, error) {
+	var sinks []Publisher
+	for _, name := range cfg.Stream.Sinks {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		registryMu.RLock()
+		factory, ok := registry[name]
+		registryMu.RUnlock()
+		if !ok {
+			closeAll(sinks)
+			return nil, fmt.Errorf("未知的发布器类型: %s", name)
+		}
+
+		sink, err := factory(cfg)
+		if err != nil {
+			closeAll(sinks)
+			return nil, fmt.Errorf("创建发布器 %s 失败: %w", name, err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return NewMulti(cursors, sinks...), nil
+}
+
+// closeAll 关闭已创建的发布器
+func closeAll(sinks []Publisher) {
+	for _, sink := range sinks {
+		_ = sink.Close()
+	}
+}
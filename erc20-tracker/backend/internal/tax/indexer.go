@@ -0,0 +1,338 @@
+package tax
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	memebind "erc20-tracker/backend/internal/bindings/meme"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/event"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// MemeToken部署时的默认税率和分配比例（基点），起始区块晚于修改事件时以此为准
+const (
+	defaultBuyTaxRate      = 500
+	defaultSellTaxRate     = 800
+	defaultTransferTaxRate = 200
+	defaultLiquidityShare  = 4000
+	defaultMarketingShare  = 3000
+	defaultBurnShare       = 3000
+
+	basisPoints = 10000
+)
+
+func init() {
+	event.RegisterIndexer("tax", func(chain config.ChainConfig, _ *config.Config, repos *database.Repositories) (event.Indexer, error) {
+		if !chain.TaxToken {
+			return nil, nil
+		}
+		return NewIndexer(chain, repos.Tax)
+	})
+}
+
+// taxHandler 税费事件的处理函数，在记录日志的同一事务中执行
+type taxHandler func(tx *database.TaxTx, vLog types.Log, timestamp time.Time) error
+
+// Indexer MemeToken税费事件索引器
+// 监听的就是代币合约本身，Transfer等余额事件返回false交给代币处理函数
+type Indexer struct {
+	chain    config.ChainConfig
+	address  common.Address
+	filterer *memebind.MemeTokenFilterer
+	repo     *database.TaxRepository
+	events   *event.EventHandlers[taxHandler]
+}
+
+// NewIndexer 创建税费索引器
+func NewIndexer(chain config.ChainConfig, repo *database.TaxRepository) (*Indexer, error) {
+	if !common.IsHexAddress(chain.ContractAddress) {
+		return nil, fmt.Errorf("无效的代币合约地址: %q", chain.ContractAddress)
+	}
+	address := common.HexToAddress(chain.ContractAddress)
+
+	filterer, err := memebind.NewMemeTokenFilterer(address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建代币合约绑定失败: %w", err)
+	}
+
+	idx := &Indexer{
+		chain:    chain,
+		address:  address,
+		filterer: filterer,
+		repo:     repo,
+	}
+	byName := map[string]taxHandler{
+		"TaxCollected":           idx.handleTaxCollected,
+		"SwapAndLiquify":         idx.handleSwapAndLiquify,
+		"TokensBurned":           idx.handleTokensBurned,
+		"TaxRatesUpdated":        idx.handleTaxRatesUpdated,
+		"TaxDistributionUpdated": idx.handleTaxDistributionUpdated,
+	}
+	if idx.events, err = event.BindEvents(memebind.MemeTokenMetaData.GetAbi, "代币合约", byName); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Name 索引器名称
+func (idx *Indexer) Name() string {
+	return "MemeToken"
+}
+
+// ABIs 代币合约ABI
+func (idx *Indexer) ABIs() map[string]string {
+	return map[string]string{"MemeToken": memebind.MemeTokenMetaData.ABI}
+}
+
+// Addresses 代币合约地址
+func (idx *Indexer) Addresses() []common.Address {
+	return []common.Address{idx.address}
+}
+
+// HandleLog 处理税费相关日志，其余日志返回false
+func (idx *Indexer) HandleLog(vLog types.Log, timestamp time.Time) (bool, error) {
+	handler, name, ok := idx.events.Lookup(vLog)
+	if !ok {
+		return false, nil
+	}
+	err := event.ApplyLog(idx, idx.chain, name, vLog, idx.repo.Apply, func(tx *database.TaxTx) error {
+		return handler(tx, vLog, timestamp)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// handleTaxCollected 带税转账：记录付税方和税额，并把紧挨着的税费Transfer标记为税费
+// 合约先发出 Transfer(from, 代币合约, tax)，紧接着发出TaxCollected，最后才是净额的Transfer
+func (idx *Indexer) handleTaxCollected(tx *database.TaxTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.filterer.ParseTaxCollected(vLog)
+	if err != nil {
+		return err
+	}
+
+	state, err := idx.loadState(tx)
+	if err != nil {
+		return err
+	}
+	kind, rate := classify(state, ev.Amount, ev.Tax)
+
+	record := &database.TokenTax{
+		Payer:       ev.From.Hex(),
+		Recipient:   ev.To.Hex(),
+		Kind:        kind,
+		Amount:      ev.Amount.String(),
+		Tax:         ev.Tax.String(),
+		NetAmount:   new(big.Int).Sub(ev.Amount, ev.Tax).String(),
+		RateBps:     rate,
+		TxHash:      vLog.TxHash.Hex(),
+		LogIndex:    vLog.Index,
+		BlockNumber: vLog.BlockNumber,
+		Timestamp:   timestamp,
+	}
+	if err := tx.CreateTax(record); err != nil {
+		return err
+	}
+
+	if vLog.Index > 0 {
+		tagged, err := tx.TagTaxTransfer(record.TxHash, vLog.Index-1, record.Payer, record.Tax)
+		if err != nil {
+			return err
+		}
+		if tagged == 0 {
+			// 税费Transfer被隔离或早于起始区块时余额变动不存在，余额仍然正确，只是变动类型保持为转账
+			logger.WithFields(map[string]interface{}{
+				"chain":   idx.chain.Name,
+				"payer":   record.Payer,
+				"tax":     record.Tax,
+				"tx_hash": record.TxHash,
+			}).Debug("没有找到税费对应的余额变动")
+		}
+	}
+
+	state.TotalCollected = database.AddDecimal(state.TotalCollected, ev.Tax)
+	state.Undistributed = database.AddDecimal(state.Undistributed, ev.Tax)
+	state.TaxCount++
+	state.UpdatedBlock = vLog.BlockNumber
+	return tx.SaveState(state)
+}
+
+// handleSwapAndLiquify 税费分配：合约把持有的全部代币按比例销毁、转给营销钱包和加入流动性
+func (idx *Indexer) handleSwapAndLiquify(tx *database.TaxTx, vLog types.Log, timestamp time.Time) error {
+	ev, err := idx.filterer.ParseSwapAndLiquify(vLog)
+	if err != nil {
+		return err
+	}
+
+	state, err := idx.loadState(tx)
+	if err != nil {
+		return err
+	}
+
+	// 与合约的 _swapAndLiquify 使用相同的向下取整
+	burned := share(ev.TokensSwapped, state.BurnShare)
+	marketing := share(ev.TokensSwapped, state.MarketingShare)
+	retained := new(big.Int).Sub(ev.TokensSwapped, burned)
+	retained.Sub(retained, marketing).Sub(retained, ev.TokensIntoLiquidity)
+	if retained.Sign() < 0 {
+		retained.SetInt64(0)
+	}
+
+	distribution := &database.TokenTaxDistribution{
+		TokensSwapped: ev.TokensSwapped.String(),
+		Burned:        burned.String(),
+		ToLiquidity:   ev.TokensIntoLiquidity.String(),
+		ToMarketing:   marketing.String(),
+		Retained:      retained.String(),
+		TxHash:        vLog.TxHash.Hex(),
+		LogIndex:      vLog.Index,
+		BlockNumber:   vLog.BlockNumber,
+		Timestamp:     timestamp,
+	}
+	if err := tx.CreateDistribution(distribution); err != nil {
+		return err
+	}
+
+	// 合约余额可能包含直接转入的代币，未分配税费不会小于0
+	undistributed := new(big.Int).Sub(database.ParseDecimal(state.Undistributed), ev.TokensSwapped)
+	undistributed.Add(undistributed, retained)
+	if undistributed.Sign() < 0 {
+		undistributed.SetInt64(0)
+	}
+	state.Undistributed = undistributed.String()
+	state.TotalLiquidity = database.AddDecimal(state.TotalLiquidity, ev.TokensIntoLiquidity)
+	state.TotalMarketing = database.AddDecimal(state.TotalMarketing, marketing)
+	state.DistributedCount++
+	state.UpdatedBlock = vLog.BlockNumber
+	return tx.SaveState(state)
+}
+
+// handleTokensBurned 销毁，只会在税费分配中发生
+func (idx *Indexer) handleTokensBurned(tx *database.TaxTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.filterer.ParseTokensBurned(vLog)
+	if err != nil {
+		return err
+	}
+
+	state, err := idx.loadState(tx)
+	if err != nil {
+		return err
+	}
+	state.TotalBurned = database.AddDecimal(state.TotalBurned, ev.Amount)
+	state.UpdatedBlock = vLog.BlockNumber
+	return tx.SaveState(state)
+}
+
+// handleTaxRatesUpdated 税率调整
+func (idx *Indexer) handleTaxRatesUpdated(tx *database.TaxTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.filterer.ParseTaxRatesUpdated(vLog)
+	if err != nil {
+		return err
+	}
+
+	state, err := idx.loadState(tx)
+	if err != nil {
+		return err
+	}
+	state.BuyTaxRate = ev.BuyTax.Int64()
+	state.SellTaxRate = ev.SellTax.Int64()
+	state.TransferTaxRate = ev.TransferTax.Int64()
+	state.UpdatedBlock = vLog.BlockNumber
+
+	logger.WithFields(map[string]interface{}{
+		"chain":    idx.chain.Name,
+		"buy":      state.BuyTaxRate,
+		"sell":     state.SellTaxRate,
+		"transfer": state.TransferTaxRate,
+	}).Info("代币税率已更新")
+	return tx.SaveState(state)
+}
+
+// handleTaxDistributionUpdated 税费分配比例调整
+func (idx *Indexer) handleTaxDistributionUpdated(tx *database.TaxTx, vLog types.Log, _ time.Time) error {
+	ev, err := idx.filterer.ParseTaxDistributionUpdated(vLog)
+	if err != nil {
+		return err
+	}
+
+	state, err := idx.loadState(tx)
+	if err != nil {
+		return err
+	}
+	state.LiquidityShare = ev.Liquidity.Int64()
+	state.MarketingShare = ev.Marketing.Int64()
+	state.BurnShare = ev.Burn.Int64()
+	state.UpdatedBlock = vLog.BlockNumber
+
+	logger.WithFields(map[string]interface{}{
+		"chain":     idx.chain.Name,
+		"liquidity": state.LiquidityShare,
+		"marketing": state.MarketingShare,
+		"burn":      state.BurnShare,
+	}).Info("税费分配比例已更新")
+	return tx.SaveState(state)
+}
+
+// loadState 获取税费状态，第一次使用时按合约默认值初始化
+func (idx *Indexer) loadState(tx *database.TaxTx) (*database.TokenTaxState, error) {
+	state, err := tx.GetState()
+	if err != nil || state != nil {
+		return state, err
+	}
+	return &database.TokenTaxState{
+		BuyTaxRate:      defaultBuyTaxRate,
+		SellTaxRate:     defaultSellTaxRate,
+		TransferTaxRate: defaultTransferTaxRate,
+		LiquidityShare:  defaultLiquidityShare,
+		MarketingShare:  defaultMarketingShare,
+		BurnShare:       defaultBurnShare,
+		TotalCollected:  "0",
+		TotalBurned:     "0",
+		TotalLiquidity:  "0",
+		TotalMarketing:  "0",
+		Undistributed:   "0",
+	}, nil
+}
+
+// classify 按当前税率反推税费类型，返回类型和实际税率（基点）
+// 事件中没有交易对信息，合约按 amount * rate / 10000 计税，只有一种税率能算出该税额时才能确定类型
+func classify(state *database.TokenTaxState, amount, tax *big.Int) (string, int64) {
+	var rate int64
+	if amount.Sign() > 0 {
+		rate = new(big.Int).Div(new(big.Int).Mul(tax, big.NewInt(basisPoints)), amount).Int64()
+	}
+
+	candidates := []struct {
+		kind string
+		rate int64
+	}{
+		{database.TaxKindBuy, state.BuyTaxRate},
+		{database.TaxKindSell, state.SellTaxRate},
+		{database.TaxKindTransfer, state.TransferTaxRate},
+	}
+	kind := database.TaxKindUnknown
+	matches := 0
+	for _, c := range candidates {
+		if share(amount, c.rate).Cmp(tax) == 0 {
+			kind = c.kind
+			matches++
+		}
+	}
+	if matches != 1 {
+		return database.TaxKindUnknown, rate
+	}
+	return kind, rate
+}
+
+// share 按基点计算份额，向下取整
+func share(amount *big.Int, bps int64) *big.Int {
+	result := new(big.Int).Mul(amount, big.NewInt(bps))
+	return result.Div(result, big.NewInt(basisPoints))
+}
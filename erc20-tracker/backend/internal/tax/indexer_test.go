@@ -0,0 +1,388 @@
+package tax
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	memebind "erc20-tracker/backend/internal/bindings/meme"
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+	"erc20-tracker/backend/internal/event"
+)
+
+const chainID = 11155111
+
+var (
+	token     = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	pair      = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	alice     = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob       = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	marketing = common.HexToAddress("0x000000000000000000000000000000000000face")
+	liquidity = common.HexToAddress("0x000000000000000000000000000000000000beef")
+	zero      = common.Address{}
+)
+
+func defaultState() *database.TokenTaxState {
+	return &database.TokenTaxState{
+		BuyTaxRate:      defaultBuyTaxRate,
+		SellTaxRate:     defaultSellTaxRate,
+		TransferTaxRate: defaultTransferTaxRate,
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  *database.TokenTaxState
+		amount int64
+		tax    int64
+		kind   string
+		rate   int64
+	}{
+		{name: "买入", state: defaultState(), amount: 1000, tax: 50, kind: database.TaxKindBuy, rate: 500},
+		{name: "卖出", state: defaultState(), amount: 1000, tax: 80, kind: database.TaxKindSell, rate: 800},
+		{name: "转账", state: defaultState(), amount: 1000, tax: 20, kind: database.TaxKindTransfer, rate: 200},
+		{name: "向下取整后唯一", state: defaultState(), amount: 30, tax: 1, kind: database.TaxKindBuy, rate: 333},
+		// 20 * 5% = 1，20 * 8% = 1.6 向下取整也是1
+		{name: "取整后买卖税额相同", state: defaultState(), amount: 20, tax: 1, kind: database.TaxKindUnknown, rate: 500},
+		{
+			name:   "买卖税率相同",
+			state:  &database.TokenTaxState{BuyTaxRate: 500, SellTaxRate: 500, TransferTaxRate: 200},
+			amount: 1000, tax: 50, kind: database.TaxKindUnknown, rate: 500,
+		},
+		{name: "没有匹配的税率", state: defaultState(), amount: 1000, tax: 7, kind: database.TaxKindUnknown, rate: 70},
+		{name: "金额为0", state: defaultState(), amount: 0, tax: 0, kind: database.TaxKindUnknown, rate: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, rate := classify(tt.state, big.NewInt(tt.amount), big.NewInt(tt.tax))
+			if kind != tt.kind || rate != tt.rate {
+				t.Errorf("classify(%d, %d) = %s, %d，期望 %s, %d", tt.amount, tt.tax, kind, rate, tt.kind, tt.rate)
+			}
+		})
+	}
+}
+
+// memeLog 一条MemeToken日志，args按ABI中的参数顺序，整数用int或int64
+type memeLog struct {
+	name string
+	args []any
+}
+
+func ev(name string, args ...any) memeLog {
+	return memeLog{name: name, args: args}
+}
+
+// transfer 普通Transfer
+func transfer(from, to common.Address, value int64) memeLog {
+	return ev("Transfer", from, to, value)
+}
+
+// taxedTransfer 合约_update中带税转账的日志顺序：税费Transfer、TaxCollected、净额Transfer
+func taxedTransfer(from, to common.Address, amount, tax int64) []memeLog {
+	return []memeLog{
+		transfer(from, token, tax),
+		ev("TaxCollected", from, to, amount, tax),
+		transfer(from, to, amount-tax),
+	}
+}
+
+// swapAndLiquify 合约_swapAndLiquify的日志顺序（未开启自动加池时流动性部分转给流动性钱包）
+func swapAndLiquify(swapped, burned, toMarketing, toLiquidity int64) []memeLog {
+	return []memeLog{
+		transfer(token, zero, burned),
+		ev("TokensBurned", burned),
+		transfer(token, marketing, toMarketing),
+		transfer(token, liquidity, toLiquidity),
+		ev("SwapAndLiquify", swapped, 0, toLiquidity),
+	}
+}
+
+// rawLogs 把每笔交易的日志编码为归档日志，每笔交易单独一个区块
+func rawLogs(t *testing.T, txs [][]memeLog) []database.RawEventLog {
+	t.Helper()
+	parsed, err := memebind.MemeTokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raws []database.RawEventLog
+	for i, logs := range txs {
+		block := uint64(i + 1)
+		for index, l := range logs {
+			abiEvent, ok := parsed.Events[l.name]
+			if !ok {
+				t.Fatalf("MemeToken ABI中没有%s事件", l.name)
+			}
+			if len(l.args) != len(abiEvent.Inputs) {
+				t.Fatalf("%s需要%d个参数", l.name, len(abiEvent.Inputs))
+			}
+
+			topics := []string{abiEvent.ID.Hex()}
+			var data []any
+			for j, input := range abiEvent.Inputs {
+				arg := l.args[j]
+				switch value := arg.(type) {
+				case int:
+					arg = big.NewInt(int64(value))
+				case int64:
+					arg = big.NewInt(value)
+				}
+				if input.Indexed {
+					topics = append(topics, common.BytesToHash(arg.(common.Address).Bytes()).Hex())
+				} else {
+					data = append(data, arg)
+				}
+			}
+			packed, err := abiEvent.Inputs.NonIndexed().Pack(data...)
+			if err != nil {
+				t.Fatalf("编码%s失败: %v", l.name, err)
+			}
+			encoded, err := json.Marshal(topics)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			raws = append(raws, database.RawEventLog{
+				ChainID:         chainID,
+				ContractAddress: token.Hex(),
+				TxHash:          common.BigToHash(new(big.Int).SetUint64(block)).Hex(),
+				LogIndex:        uint(index),
+				BlockNumber:     block,
+				BlockHash:       common.BigToHash(new(big.Int).SetUint64(block + 1000)).Hex(),
+				BlockTimestamp:  time.Unix(1700000000+int64(block)*12, 0).UTC(),
+				Topics:          string(encoded),
+				Data:            hexutil.Encode(packed),
+			})
+		}
+	}
+	return raws
+}
+
+type expectedTax struct {
+	payer common.Address
+	kind  string
+	rate  int64
+	tax   int64
+	net   int64
+}
+
+type expectedState struct {
+	collected, burned, liquidity, marketing, undistributed int64
+}
+
+func TestIndexerSequences(t *testing.T) {
+	tests := []struct {
+		name          string
+		txs           [][]memeLog
+		balances      map[common.Address]int64
+		taxes         []expectedTax // 按区块倒序
+		taxPaid       int64         // 标记为tax_paid的余额变动数
+		distributions []database.TokenTaxDistribution
+		state         expectedState
+	}{
+		{
+			name: "普通转账",
+			txs: [][]memeLog{
+				{transfer(zero, alice, 1000)},
+				taxedTransfer(alice, bob, 100, 2),
+			},
+			balances: map[common.Address]int64{alice: 900, bob: 98, token: 2},
+			taxes:    []expectedTax{{payer: alice, kind: database.TaxKindTransfer, rate: 200, tax: 2, net: 98}},
+			taxPaid:  1,
+			state:    expectedState{collected: 2, undistributed: 2},
+		},
+		{
+			name: "买入和卖出",
+			txs: [][]memeLog{
+				{transfer(zero, pair, 1000), transfer(zero, bob, 500)},
+				taxedTransfer(pair, alice, 1000, 50),
+				taxedTransfer(bob, pair, 500, 40),
+			},
+			balances: map[common.Address]int64{pair: 460, alice: 950, bob: 0, token: 90},
+			taxes: []expectedTax{
+				{payer: bob, kind: database.TaxKindSell, rate: 800, tax: 40, net: 460},
+				{payer: pair, kind: database.TaxKindBuy, rate: 500, tax: 50, net: 950},
+			},
+			taxPaid: 2,
+			state:   expectedState{collected: 90, undistributed: 90},
+		},
+		{
+			name: "税率调整后买卖税率相同",
+			txs: [][]memeLog{
+				{transfer(zero, pair, 1000), ev("TaxRatesUpdated", 500, 500, 200)},
+				taxedTransfer(pair, alice, 1000, 50),
+			},
+			balances: map[common.Address]int64{pair: 0, alice: 950, token: 50},
+			taxes:    []expectedTax{{payer: pair, kind: database.TaxKindUnknown, rate: 500, tax: 50, net: 950}},
+			taxPaid:  1,
+			state:    expectedState{collected: 50, undistributed: 50},
+		},
+		{
+			// 1001按40%/30%/30%分配：400、300、300，余下1留在合约中
+			name: "税费分配",
+			txs: [][]memeLog{
+				{transfer(zero, alice, 50050)},
+				taxedTransfer(alice, bob, 50050, 1001),
+				append(swapAndLiquify(1001, 300, 300, 400), taxedTransfer(bob, pair, 1000, 80)...),
+			},
+			balances: map[common.Address]int64{
+				alice: 0, bob: 48049, pair: 920, marketing: 300, liquidity: 400, token: 81,
+			},
+			taxes: []expectedTax{
+				{payer: bob, kind: database.TaxKindSell, rate: 800, tax: 80, net: 920},
+				{payer: alice, kind: database.TaxKindTransfer, rate: 200, tax: 1001, net: 49049},
+			},
+			taxPaid: 2,
+			distributions: []database.TokenTaxDistribution{
+				{TokensSwapped: "1001", Burned: "300", ToMarketing: "300", ToLiquidity: "400", Retained: "1"},
+			},
+			state: expectedState{collected: 1081, burned: 300, liquidity: 400, marketing: 300, undistributed: 81},
+		},
+		{
+			// 分配比例调整后按新比例计算；直接转入合约的9个代币也参与分配，各部分向下取整后余2
+			name: "分配比例调整",
+			txs: [][]memeLog{
+				{transfer(zero, alice, 1000), ev("TaxDistributionUpdated", 8000, 1000, 1000)},
+				taxedTransfer(alice, bob, 1000, 20),
+				{transfer(bob, token, 9)},
+				swapAndLiquify(29, 2, 2, 23),
+			},
+			balances: map[common.Address]int64{alice: 0, bob: 971, marketing: 2, liquidity: 23, token: 2},
+			taxes:    []expectedTax{{payer: alice, kind: database.TaxKindTransfer, rate: 200, tax: 20, net: 980}},
+			taxPaid:  1,
+			distributions: []database.TokenTaxDistribution{
+				{TokensSwapped: "29", Burned: "2", ToMarketing: "2", ToLiquidity: "23", Retained: "2"},
+			},
+			// 分配的代币多于未分配税费，未分配税费不小于0
+			state: expectedState{collected: 20, burned: 2, liquidity: 23, marketing: 2, undistributed: 0},
+		},
+		{
+			// 税费Transfer不在TaxCollected之前时不标记余额变动
+			name: "税费Transfer不在前一条",
+			txs: [][]memeLog{
+				{transfer(zero, alice, 1000)},
+				{ev("TaxCollected", alice, bob, 100, 2), transfer(alice, token, 2), transfer(alice, bob, 98)},
+			},
+			balances: map[common.Address]int64{alice: 900, bob: 98, token: 2},
+			taxes:    []expectedTax{{payer: alice, kind: database.TaxKindTransfer, rate: 200, tax: 2, net: 98}},
+			taxPaid:  0,
+			state:    expectedState{collected: 2, undistributed: 2},
+		},
+		{
+			// 前一条Transfer金额与税额不同（如分配中转给营销钱包）时不标记
+			name: "前一条Transfer金额不同",
+			txs: [][]memeLog{
+				{transfer(zero, alice, 1000)},
+				{transfer(alice, token, 3), ev("TaxCollected", alice, bob, 100, 2), transfer(alice, bob, 98)},
+			},
+			balances: map[common.Address]int64{alice: 899, bob: 98, token: 3},
+			taxes:    []expectedTax{{payer: alice, kind: database.TaxKindTransfer, rate: 200, tax: 2, net: 98}},
+			taxPaid:  0,
+			state:    expectedState{collected: 2, undistributed: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.Open(t)
+			repos := database.NewRepositories(db)
+			chain := config.ChainConfig{ChainID: chainID, Name: "test", ContractAddress: token.Hex(), TaxToken: true}
+			processor, err := event.NewReplayProcessor(chain, repos, &config.Config{Timezone: "UTC"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			raws := rawLogs(t, tt.txs)
+			for _, raw := range raws {
+				if err := processor.ApplyArchivedLog(raw); err != nil {
+					t.Fatalf("处理日志 %d#%d 失败: %v", raw.BlockNumber, raw.LogIndex, err)
+				}
+			}
+			// 重复处理不改变结果
+			for _, raw := range raws {
+				if err := processor.ApplyArchivedLog(raw); err != nil {
+					t.Fatalf("重复处理日志 %d#%d 失败: %v", raw.BlockNumber, raw.LogIndex, err)
+				}
+			}
+
+			for address, want := range tt.balances {
+				got, err := repos.UserBalance.GetBalance(address.Hex(), chainID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Cmp(big.NewInt(want)) != 0 {
+					t.Errorf("%s 余额 = %s，期望 %d", address.Hex(), got, want)
+				}
+			}
+
+			taxes, err := repos.Tax.ListTaxes(database.TokenTaxFilter{ChainID: chainID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(taxes) != len(tt.taxes) {
+				t.Fatalf("税费记录 %d 条，期望 %d 条", len(taxes), len(tt.taxes))
+			}
+			for i, want := range tt.taxes {
+				got := taxes[i]
+				if got.Payer != want.payer.Hex() || got.Kind != want.kind || got.RateBps != want.rate ||
+					got.Tax != fmt.Sprint(want.tax) || got.NetAmount != fmt.Sprint(want.net) {
+					t.Errorf("税费记录 %d = %+v，期望 %+v", i, got, want)
+				}
+			}
+
+			// 每条标记的税费Transfer对应付税方的tax_paid和代币合约的tax_collected
+			for changeType, want := range map[string]int64{
+				database.ChangeTypeTaxPaid: tt.taxPaid,
+				database.ChangeTypeTaxIn:   tt.taxPaid,
+			} {
+				var count int64
+				err := db.Model(&database.BalanceChange{}).Where("change_type = ?", changeType).Count(&count).Error
+				if err != nil {
+					t.Fatal(err)
+				}
+				if count != want {
+					t.Errorf("%s 余额变动 %d 条，期望 %d 条", changeType, count, want)
+				}
+			}
+
+			distributions, err := repos.Tax.ListDistributions(chainID, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(distributions) != len(tt.distributions) {
+				t.Fatalf("分配记录 %d 条，期望 %d 条", len(distributions), len(tt.distributions))
+			}
+			for i, want := range tt.distributions {
+				got := distributions[i]
+				if got.TokensSwapped != want.TokensSwapped || got.Burned != want.Burned || got.ToMarketing != want.ToMarketing ||
+					got.ToLiquidity != want.ToLiquidity || got.Retained != want.Retained {
+					t.Errorf("分配记录 %d = %+v", i, got)
+				}
+			}
+
+			state, err := repos.Tax.GetState(chainID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for field, pair := range map[string][2]string{
+				"total_collected": {state.TotalCollected, fmt.Sprint(tt.state.collected)},
+				"total_burned":    {state.TotalBurned, fmt.Sprint(tt.state.burned)},
+				"total_liquidity": {state.TotalLiquidity, fmt.Sprint(tt.state.liquidity)},
+				"total_marketing": {state.TotalMarketing, fmt.Sprint(tt.state.marketing)},
+				"undistributed":   {state.Undistributed, fmt.Sprint(tt.state.undistributed)},
+			} {
+				if pair[0] != pair[1] {
+					t.Errorf("%s = %s，期望 %s", field, pair[0], pair[1])
+				}
+			}
+		})
+	}
+}
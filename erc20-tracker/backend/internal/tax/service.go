@@ -0,0 +1,215 @@
+package tax
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+
+	"erc20-tracker/backend/internal/database"
+)
+
+const (
+	dayLayout = "2006-01-02"
+
+	// defaultTopPayers 汇总中返回的付税最多的地址数
+	defaultTopPayers = 10
+)
+
+var (
+	// ErrStateNotFound 链上还没有税费记录
+	ErrStateNotFound = errors.New("带税代币没有索引数据")
+	// ErrPayerNotFound 地址没有付过税
+	ErrPayerNotFound = errors.New("该地址没有付税记录")
+)
+
+// Summary 税率配置、累计税费及其去向
+type Summary struct {
+	database.TokenTaxState
+	TopPayers []database.TaxPayerTotal `json:"top_payers"`
+}
+
+// Payer 付税方视图
+type Payer struct {
+	database.TaxPayerTotal
+	Taxes []database.TokenTax `json:"taxes"`
+}
+
+// DayRevenue 一天的税费收入和分配
+type DayRevenue struct {
+	Day         string `json:"day"`
+	Collected   string `json:"collected"`
+	Buy         string `json:"buy"`
+	Sell        string `json:"sell"`
+	Transfer    string `json:"transfer"`
+	Unknown     string `json:"unknown"`
+	TaxCount    int64  `json:"tax_count"`
+	Burned      string `json:"burned"`
+	ToLiquidity string `json:"to_liquidity"`
+	ToMarketing string `json:"to_marketing"`
+}
+
+// Revenue [from, to] 日期范围内的每日税费
+type Revenue struct {
+	ChainID   int64        `json:"chain_id"`
+	From      string       `json:"from"`
+	To        string       `json:"to"`
+	Collected string       `json:"collected"`
+	Days      []DayRevenue `json:"days"`
+}
+
+// Service 代币税费查询服务
+type Service struct {
+	repo *database.TaxRepository
+	loc  *time.Location
+}
+
+// NewService 创建代币税费查询服务，每日收入按loc时区划分
+func NewService(repos *database.Repositories, loc *time.Location) *Service {
+	return &Service{repo: repos.Tax, loc: loc}
+}
+
+// Summary 税率配置、累计税费和付税最多的地址
+func (s *Service) Summary(chainID int64) (*Summary, error) {
+	state, err := s.repo.GetState(chainID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrStateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	top, err := s.repo.TopPayers(chainID, "", defaultTopPayers)
+	if err != nil {
+		return nil, fmt.Errorf("获取付税排行失败: %w", err)
+	}
+	return &Summary{TokenTaxState: *state, TopPayers: top}, nil
+}
+
+// Payer 地址的累计税费和最近的带税转账
+func (s *Service) Payer(chainID int64, address string, limit int) (*Payer, error) {
+	totals, err := s.repo.TopPayers(chainID, address, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 {
+		return nil, ErrPayerNotFound
+	}
+
+	taxes, err := s.repo.ListTaxes(database.TokenTaxFilter{ChainID: chainID, Payer: address, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	return &Payer{TaxPayerTotal: totals[0], Taxes: taxes}, nil
+}
+
+// Taxes 最近的带税转账，kind为空时不按类型过滤
+func (s *Service) Taxes(chainID int64, kind string, limit int) ([]database.TokenTax, error) {
+	return s.repo.ListTaxes(database.TokenTaxFilter{ChainID: chainID, Kind: kind, Limit: limit})
+}
+
+// Distributions 最近的税费分配
+func (s *Service) Distributions(chainID int64, limit int) ([]database.TokenTaxDistribution, error) {
+	return s.repo.ListDistributions(chainID, limit)
+}
+
+// Revenue 统计 [from, to] 日期范围内每天收取的税费（按类型）和分配去向
+func (s *Service) Revenue(chainID int64, from, to time.Time) (*Revenue, error) {
+	from, to = s.dayOf(from), s.dayOf(to)
+	end := to.AddDate(0, 0, 1)
+
+	type dayTotals struct {
+		collected, burned, liquidity, marketing *big.Int
+		byKind                                  map[string]*big.Int
+		count                                   int64
+	}
+	var order []string
+	days := make(map[string]*dayTotals)
+	dayFor := func(t time.Time) *dayTotals {
+		key := s.dayOf(t).Format(dayLayout)
+		d, ok := days[key]
+		if !ok {
+			d = &dayTotals{
+				collected: big.NewInt(0),
+				burned:    big.NewInt(0),
+				liquidity: big.NewInt(0),
+				marketing: big.NewInt(0),
+				byKind:    make(map[string]*big.Int),
+			}
+			days[key] = d
+		}
+		return d
+	}
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		dayFor(day)
+		order = append(order, day.Format(dayLayout))
+	}
+
+	total := big.NewInt(0)
+	err := s.repo.ForEachTaxInTimeRange(chainID, from, end, func(tax database.TokenTax) error {
+		d := dayFor(tax.Timestamp)
+		amount := database.ParseDecimal(tax.Tax)
+		d.collected.Add(d.collected, amount)
+		if d.byKind[tax.Kind] == nil {
+			d.byKind[tax.Kind] = big.NewInt(0)
+		}
+		d.byKind[tax.Kind].Add(d.byKind[tax.Kind], amount)
+		d.count++
+		total.Add(total, amount)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("遍历税费记录失败: %w", err)
+	}
+
+	distributions, err := s.repo.ListDistributionsInTimeRange(chainID, from, end)
+	if err != nil {
+		return nil, fmt.Errorf("获取税费分配失败: %w", err)
+	}
+	for _, dist := range distributions {
+		d := dayFor(dist.Timestamp)
+		d.burned.Add(d.burned, database.ParseDecimal(dist.Burned))
+		d.liquidity.Add(d.liquidity, database.ParseDecimal(dist.ToLiquidity))
+		d.marketing.Add(d.marketing, database.ParseDecimal(dist.ToMarketing))
+	}
+
+	result := &Revenue{
+		ChainID:   chainID,
+		From:      from.Format(dayLayout),
+		To:        to.Format(dayLayout),
+		Collected: total.String(),
+		Days:      make([]DayRevenue, 0, len(order)),
+	}
+	for _, key := range order {
+		d := days[key]
+		result.Days = append(result.Days, DayRevenue{
+			Day:         key,
+			Collected:   d.collected.String(),
+			Buy:         kindTotal(d.byKind, database.TaxKindBuy),
+			Sell:        kindTotal(d.byKind, database.TaxKindSell),
+			Transfer:    kindTotal(d.byKind, database.TaxKindTransfer),
+			Unknown:     kindTotal(d.byKind, database.TaxKindUnknown),
+			TaxCount:    d.count,
+			Burned:      d.burned.String(),
+			ToLiquidity: d.liquidity.String(),
+			ToMarketing: d.marketing.String(),
+		})
+	}
+	return result, nil
+}
+
+// dayOf 返回时间在统计时区中的当天零点
+func (s *Service) dayOf(t time.Time) time.Time {
+	t = t.In(s.loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+}
+
+// kindTotal 某种税费类型的合计，没有记录时为0
+func kindTotal(byKind map[string]*big.Int, kind string) string {
+	if amount, ok := byKind[kind]; ok {
+		return amount.String()
+	}
+	return "0"
+}
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// 资金池类型
+const (
+	KindStake = "stake" // 质押合约，份额就是质押的代币数量
+	KindPair  = "pair"  // Uniswap V2交易对，LP份额按交易对的代币储备折算
+)
+
+// Vault 积分资金池
+type Vault struct {
+	Address string `json:"address"`
+	Kind    string `json:"kind"`
+}
+
+// Resolver 计算受益人在资金池中按份额对应的代币数量
+type Resolver struct {
+	repo   *database.VaultRepository
+	vaults map[int64]map[string]Vault
+}
+
+// NewResolver 按各链的积分资金池配置创建，是质押合约地址的为质押资金池，其余按交易对处理
+func NewResolver(cfg *config.Config, repo *database.VaultRepository) *Resolver {
+	r := &Resolver{repo: repo, vaults: make(map[int64]map[string]Vault)}
+	for _, chain := range cfg.Chains {
+		for _, address := range chain.PointsVaults {
+			if !common.IsHexAddress(address) {
+				logger.WithFields(map[string]interface{}{
+					"chain": chain.Name,
+					"vault": address,
+				}).Warn("无效的积分资金池地址，已忽略")
+				continue
+			}
+
+			vault := Vault{Address: common.HexToAddress(address).Hex(), Kind: KindPair}
+			if strings.EqualFold(address, chain.StakeContract) {
+				vault.Kind = KindStake
+			}
+			if r.vaults[chain.ChainID] == nil {
+				r.vaults[chain.ChainID] = make(map[string]Vault)
+			}
+			r.vaults[chain.ChainID][vault.Address] = vault
+		}
+	}
+	return r
+}
+
+// Vaults 链上配置的资金池，按地址排序
+func (r *Resolver) Vaults(chainID int64) []Vault {
+	vaults := make([]Vault, 0, len(r.vaults[chainID]))
+	for _, vault := range r.vaults[chainID] {
+		vaults = append(vaults, vault)
+	}
+	sort.Slice(vaults, func(i, j int) bool { return vaults[i].Address < vaults[j].Address })
+	return vaults
+}
+
+// IsVault 地址是否为链上配置的资金池
+func (r *Resolver) IsVault(chainID int64, address string) bool {
+	if !common.IsHexAddress(address) {
+		return false
+	}
+	_, ok := r.vaults[chainID][common.HexToAddress(address).Hex()]
+	return ok
+}
+
+// Attributed 受益人在 [start, end) 内在所有资金池中按份额对应的代币数量，没有份额时返回nil
+func (r *Resolver) Attributed(chainID int64, owner string, start, end time.Time) (Series, error) {
+	addresses := r.addresses(chainID)
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	held, err := r.repo.ListOwnerVaults(chainID, owner, addresses, end)
+	if err != nil {
+		return nil, fmt.Errorf("获取受益人的资金池失败: %w", err)
+	}
+
+	var total Series
+	for _, address := range held {
+		series, err := r.vaultSeries(chainID, r.vaults[chainID][address], owner, start, end)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(series)
+	}
+	return total, nil
+}
+
+// NewOwners 在资金池中有份额、但还没有积分记录的受益人（钱包中从没有过代币）
+func (r *Resolver) NewOwners(chainID int64, before time.Time) ([]database.VaultOwner, error) {
+	addresses := r.addresses(chainID)
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	return r.repo.ListOwnersWithoutPoints(chainID, addresses, before)
+}
+
+// FirstShareTime 受益人在资金池中第一次份额变动的时间，没有时返回零值
+func (r *Resolver) FirstShareTime(chainID int64, owner string) (time.Time, error) {
+	addresses := r.addresses(chainID)
+	if len(addresses) == 0 {
+		return time.Time{}, nil
+	}
+	return r.repo.FirstShareTime(chainID, owner, addresses)
+}
+
+// vaultSeries 受益人在一个资金池中对应的代币数量
+func (r *Resolver) vaultSeries(chainID int64, vault Vault, owner string, start, end time.Time) (Series, error) {
+	initial, err := r.repo.SharesAt(chainID, vault.Address, owner, start)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := r.repo.ListShareChanges(chainID, vault.Address, owner, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("获取资金池份额变动失败: %w", err)
+	}
+
+	shares := Series{{At: start, Amount: initial}}
+	current := new(big.Int).Set(initial)
+	for _, change := range changes {
+		current = new(big.Int).Add(current, database.ParseDecimal(change.Delta))
+		shares = append(shares, Step{At: change.Timestamp, Amount: current})
+	}
+	if vault.Kind == KindStake {
+		return shares, nil
+	}
+	return r.pairSeries(chainID, vault, shares, start, end)
+}
+
+// pairSeries 把LP份额按交易对当时的储备和LP总量折算成代币数量
+func (r *Resolver) pairSeries(chainID int64, vault Vault, shares Series, start, end time.Time) (Series, error) {
+	snapshot, err := r.repo.SnapshotAt(chainID, vault.Address, start)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := r.repo.ListSnapshots(chainID, vault.Address, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("获取资金池快照失败: %w", err)
+	}
+
+	var assets, supply Series
+	if snapshot != nil {
+		assets = append(assets, Step{At: start, Amount: database.ParseDecimal(snapshot.TotalAssets)})
+		supply = append(supply, Step{At: start, Amount: database.ParseDecimal(snapshot.TotalShares)})
+	}
+	for _, s := range snapshots {
+		assets = append(assets, Step{At: s.Timestamp, Amount: database.ParseDecimal(s.TotalAssets)})
+		supply = append(supply, Step{At: s.Timestamp, Amount: database.ParseDecimal(s.TotalShares)})
+	}
+
+	times := breakpoints(shares, assets)
+	result := make(Series, 0, len(times))
+	for _, t := range times {
+		amount := new(big.Int)
+		if total := supply.At(t); total.Sign() > 0 {
+			amount.Mul(shares.At(t), assets.At(t))
+			amount.Quo(amount, total)
+		}
+		result = append(result, Step{At: t, Amount: amount})
+	}
+	return result, nil
+}
+
+// addresses 链上配置的资金池地址
+func (r *Resolver) addresses(chainID int64) []string {
+	vaults := r.Vaults(chainID)
+	addresses := make([]string, 0, len(vaults))
+	for _, vault := range vaults {
+		addresses = append(addresses, vault.Address)
+	}
+	return addresses
+}
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Step 从At开始生效的代币数量
+type Step struct {
+	At     time.Time
+	Amount *big.Int
+}
+
+// Series 按时间排序的阶梯序列，第一个点之前数量为0；同一时间有多个点时以最后一个为准
+type Series []Step
+
+// At 序列在t时刻的数量
+func (s Series) At(t time.Time) *big.Int {
+	i := sort.Search(len(s), func(i int) bool { return s[i].At.After(t) })
+	if i == 0 {
+		return big.NewInt(0)
+	}
+	return s[i-1].Amount
+}
+
+// IsZero 序列是否处处为0
+func (s Series) IsZero() bool {
+	for _, step := range s {
+		if step.Amount.Sign() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add 两个序列逐点相加
+func (s Series) Add(other Series) Series {
+	if len(other) == 0 {
+		return s
+	}
+	if len(s) == 0 {
+		return other
+	}
+
+	times := breakpoints(s, other)
+	result := make(Series, 0, len(times))
+	for _, t := range times {
+		result = append(result, Step{At: t, Amount: new(big.Int).Add(s.At(t), other.At(t))})
+	}
+	return result
+}
+
+// Average 序列在 [start, end) 上的时间加权平均数量
+func (s Series) Average(start, end time.Time) *big.Int {
+	if !end.After(start) {
+		return big.NewInt(0)
+	}
+
+	weighted := new(big.Int)
+	for i, step := range s {
+		from := step.At
+		if from.Before(start) {
+			from = start
+		}
+		to := end
+		if i+1 < len(s) && s[i+1].At.Before(end) {
+			to = s[i+1].At
+		}
+		if !to.After(from) {
+			continue
+		}
+		duration := big.NewInt(int64(to.Sub(from)))
+		weighted.Add(weighted, duration.Mul(duration, step.Amount))
+	}
+	return weighted.Quo(weighted, big.NewInt(int64(end.Sub(start))))
+}
+
+// breakpoints 多个序列所有变化点的时间，去重并排序
+func breakpoints(series ...Series) []time.Time {
+	var times []time.Time
+	for _, s := range series {
+		for _, step := range s {
+			times = append(times, step.At)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	unique := times[:0]
+	for _, t := range times {
+		if len(unique) == 0 || !unique[len(unique)-1].Equal(t) {
+			unique = append(unique, t)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/retry"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// Dispatcher 轮询发件箱并投递到期的Webhook
+// 投递语义为至少一次：接收方应按X-Webhook-Id去重。同一时间只应运行一个Dispatcher
+type Dispatcher struct {
+	repos    *database.Repositories
+	config   config.WebhookConfig
+	client   *http.Client
+	retryCfg retry.RetryConfig
+}
+
+// NewDispatcher 创建投递器，client为nil时使用按配置超时的默认客户端
+func NewDispatcher(repos *database.Repositories, cfg *config.Config, client *http.Client) *Dispatcher {
+	if client == nil {
+		client = &http.Client{Timeout: cfg.Webhook.Timeout}
+	}
+	return &Dispatcher{
+		repos:  repos,
+		config: cfg.Webhook,
+		client: client,
+		retryCfg: retry.RetryConfig{
+			MaxAttempts: cfg.Webhook.MaxAttempts,
+			Delay:       cfg.Webhook.RetryDelay,
+			Backoff:     retry.ExponentialBackoff,
+		},
+	}
+}
+
+// Run 按轮询间隔投递，直到ctx取消
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchDue(ctx); err != nil {
+				logger.WithField("error", err).Error("投递Webhook失败")
+			}
+		}
+	}
+}
+
+// DispatchDue 投递一批到期的记录，返回成功投递的数量
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	deliveries, err := d.repos.Webhook.ListDue(time.Now(), d.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("获取待投递记录失败: %w", err)
+	}
+
+	subs := make(map[uint64]*database.WebhookSubscription)
+	delivered := 0
+	for _, delivery := range deliveries {
+		select {
+		case <-ctx.Done():
+			return delivered, ctx.Err()
+		default:
+		}
+
+		sub, ok := subs[delivery.SubscriptionID]
+		if !ok {
+			if sub, err = d.repos.Webhook.GetSubscription(delivery.SubscriptionID); err != nil {
+				return delivered, fmt.Errorf("获取订阅失败: %w", err)
+			}
+			subs[delivery.SubscriptionID] = sub
+		}
+
+		if d.deliver(ctx, sub, delivery) {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// deliver 投递一条记录并更新发件箱状态
+func (d *Dispatcher) deliver(ctx context.Context, sub *database.WebhookSubscription, delivery database.WebhookDelivery) bool {
+	attempts := delivery.Attempts + 1
+	now := time.Now()
+
+	var statusCode int
+	var err error
+	if sub.Active {
+		statusCode, err = Send(ctx, d.client, sub.URL, sub.Secret, delivery.EventID, delivery.EventType, []byte(delivery.Payload), now)
+	} else {
+		err = retry.NewNonRetryableError(fmt.Errorf("订阅 %s 已停用", sub.Name))
+	}
+
+	if err == nil {
+		if updateErr := d.repos.Webhook.MarkDelivered(delivery.ID, attempts, statusCode, now); updateErr != nil {
+			logger.WithField("error", updateErr).Error("更新投递状态失败")
+		}
+		return true
+	}
+
+	dead := retry.IsNonRetryableError(err) || attempts >= d.retryCfg.MaxAttempts
+	nextAttemptAt := now.Add(d.retryCfg.DelayFor(attempts))
+	if updateErr := d.repos.Webhook.MarkFailed(delivery.ID, attempts, statusCode, err.Error(), nextAttemptAt, dead); updateErr != nil {
+		logger.WithField("error", updateErr).Error("更新投递状态失败")
+	}
+
+	fields := map[string]interface{}{
+		"error":        err,
+		"delivery_id":  delivery.ID,
+		"subscription": sub.Name,
+		"event_id":     delivery.EventID,
+		"attempts":     attempts,
+	}
+	if dead {
+		logger.WithFields(fields).Error("Webhook投递失败，已转入死信")
+	} else {
+		fields["next_attempt_at"] = nextAttemptAt
+		logger.WithFields(fields).Warn("Webhook投递失败，等待重试")
+	}
+	return false
+}
@@ -0,0 +1,244 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/internal/database/dbtest"
+)
+
+const testSecret = "test-secret"
+
+// receiver 本地的Webhook接收方，按顺序返回statuses中的状态码，用完后重复最后一个
+type receiver struct {
+	t        *testing.T
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		rc.t.Errorf("读取请求体失败: %v", err)
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	status := rc.statuses[min(len(rc.requests), len(rc.statuses))-1]
+	w.WriteHeader(status)
+}
+
+// setup 创建测试库、订阅和指向本地接收方的投递器
+func setup(t *testing.T, maxAttempts int, statuses ...int) (*database.Repositories, *Notifier, *Dispatcher, *receiver) {
+	t.Helper()
+
+	repos := database.NewRepositories(dbtest.Open(t))
+	rc := &receiver{t: t, statuses: statuses}
+	server := httptest.NewServer(rc)
+	t.Cleanup(server.Close)
+
+	if err := repos.Webhook.CreateSubscription(&database.WebhookSubscription{
+		Name:   "test",
+		URL:    server.URL,
+		Secret: testSecret,
+		Active: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{Webhook: config.WebhookConfig{
+		Enabled:     true,
+		BatchSize:   10,
+		Timeout:     5 * time.Second,
+		MaxAttempts: maxAttempts,
+		RetryDelay:  time.Millisecond,
+	}}
+	return repos, NewNotifier(repos, cfg), NewDispatcher(repos, cfg, server.Client()), rc
+}
+
+// testEvent 不带过滤字段的测试事件
+func testEvent(id string) Event {
+	return Event{
+		ID:   id,
+		Type: EventTest,
+		Data: map[string]interface{}{"message": "hello"},
+		Time: time.Now(),
+	}
+}
+
+// dispatch 等待重试延迟后投递一轮
+func dispatch(t *testing.T, d *Dispatcher) int {
+	t.Helper()
+	time.Sleep(20 * time.Millisecond)
+	delivered, err := d.DispatchDue(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return delivered
+}
+
+// onlyDelivery 获取发件箱中唯一的投递记录
+func onlyDelivery(t *testing.T, repos *database.Repositories) database.WebhookDelivery {
+	t.Helper()
+	deliveries, err := repos.Webhook.ListDeliveries("", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("发件箱中有 %d 条记录，期望 1 条", len(deliveries))
+	}
+	return deliveries[0]
+}
+
+func TestDispatcherSignsRequests(t *testing.T) {
+	repos, notifier, dispatcher, rc := setup(t, 3, http.StatusOK)
+	notifier.Notify(testEvent("evt-signed"))
+
+	if delivered := dispatch(t, dispatcher); delivered != 1 {
+		t.Fatalf("投递了 %d 条，期望 1 条", delivered)
+	}
+	if len(rc.requests) != 1 {
+		t.Fatalf("接收方收到 %d 个请求，期望 1 个", len(rc.requests))
+	}
+
+	req, body := rc.requests[0], rc.bodies[0]
+	if got := req.Header.Get(HeaderID); got != "evt-signed" {
+		t.Errorf("%s = %q", HeaderID, got)
+	}
+	if got := req.Header.Get(HeaderEvent); got != EventTest {
+		t.Errorf("%s = %q", HeaderEvent, got)
+	}
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("无效的时间戳: %v", err)
+	}
+	if !Verify(testSecret, timestamp, body, req.Header.Get(HeaderSignature)) {
+		t.Errorf("签名校验失败: %s", req.Header.Get(HeaderSignature))
+	}
+	if Verify("wrong-secret", timestamp, body, req.Header.Get(HeaderSignature)) {
+		t.Error("错误的密钥也通过了签名校验")
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		t.Fatal(err)
+	}
+	if envelope.ID != "evt-signed" || envelope.Type != EventTest {
+		t.Errorf("请求体 = %+v", envelope)
+	}
+
+	delivery := onlyDelivery(t, repos)
+	if delivery.Status != database.DeliveryStatusDelivered || delivery.Attempts != 1 || delivery.LastStatusCode != http.StatusOK {
+		t.Errorf("投递记录 = %+v", delivery)
+	}
+}
+
+func TestDispatcherRetriesWithSameEventID(t *testing.T) {
+	repos, notifier, dispatcher, rc := setup(t, 3, http.StatusServiceUnavailable, http.StatusOK)
+	notifier.Notify(testEvent("evt-retry"))
+
+	if delivered := dispatch(t, dispatcher); delivered != 0 {
+		t.Fatalf("第一次投递成功了 %d 条，期望失败", delivered)
+	}
+	delivery := onlyDelivery(t, repos)
+	if delivery.Status != database.DeliveryStatusPending || delivery.Attempts != 1 || delivery.LastStatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("失败后的投递记录 = %+v", delivery)
+	}
+
+	if delivered := dispatch(t, dispatcher); delivered != 1 {
+		t.Fatalf("重试投递了 %d 条，期望 1 条", delivered)
+	}
+	delivery = onlyDelivery(t, repos)
+	if delivery.Status != database.DeliveryStatusDelivered || delivery.Attempts != 2 {
+		t.Errorf("重试后的投递记录 = %+v", delivery)
+	}
+	if len(rc.requests) != 2 || rc.requests[0].Header.Get(HeaderID) != rc.requests[1].Header.Get(HeaderID) {
+		t.Errorf("重试请求的事件标识不一致")
+	}
+}
+
+func TestDispatcherDeadLetters(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		maxAttempts int
+		attempts    int // 转入死信前的投递次数
+	}{
+		{name: "重试次数用完", status: http.StatusInternalServerError, maxAttempts: 3, attempts: 3},
+		{name: "客户端错误不重试", status: http.StatusBadRequest, maxAttempts: 3, attempts: 1},
+		{name: "限流继续重试", status: http.StatusTooManyRequests, maxAttempts: 2, attempts: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos, notifier, dispatcher, rc := setup(t, tt.maxAttempts, tt.status)
+			notifier.Notify(testEvent("evt-dead"))
+
+			for range tt.attempts + 1 {
+				dispatch(t, dispatcher)
+			}
+			if len(rc.requests) != tt.attempts {
+				t.Errorf("接收方收到 %d 个请求，期望 %d 个", len(rc.requests), tt.attempts)
+			}
+			delivery := onlyDelivery(t, repos)
+			if delivery.Status != database.DeliveryStatusDead || delivery.Attempts != tt.attempts || delivery.LastStatusCode != tt.status {
+				t.Fatalf("投递记录 = %+v", delivery)
+			}
+
+			// 死信重新投递后从第一次开始计数
+			redelivered, err := repos.Webhook.Redeliver(delivery.ID, 0, time.Now())
+			if err != nil || redelivered != 1 {
+				t.Fatalf("重新投递 %d 条: %v", redelivered, err)
+			}
+			delivery = onlyDelivery(t, repos)
+			if delivery.Status != database.DeliveryStatusPending || delivery.Attempts != 0 {
+				t.Errorf("重新投递后的记录 = %+v", delivery)
+			}
+		})
+	}
+}
+
+func TestEnqueueFollowsTransaction(t *testing.T) {
+	repos, notifier, _, _ := setup(t, 3, http.StatusOK)
+
+	rollback := errors.New("回滚")
+	err := repos.Transaction(func(tx *database.Repositories) error {
+		if err := notifier.Enqueue(tx, testEvent("evt-rollback")); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("事务返回 %v", err)
+	}
+	deliveries, err := repos.Webhook.ListDeliveries("", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 0 {
+		t.Fatalf("事务回滚后发件箱中仍有 %d 条记录", len(deliveries))
+	}
+
+	err = repos.Transaction(func(tx *database.Repositories) error {
+		return notifier.Enqueue(tx, testEvent("evt-commit"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery := onlyDelivery(t, repos); delivery.EventID != "evt-commit" {
+		t.Errorf("发件箱中的事件为 %s", delivery.EventID)
+	}
+}
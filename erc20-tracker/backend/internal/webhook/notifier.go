@@ -0,0 +1,258 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// subscriptionCacheTTL 订阅列表缓存时间，新增或停用订阅最多延迟该时长生效
+const subscriptionCacheTTL = 30 * time.Second
+
+// Event 待通知的事件
+type Event struct {
+	ID          string // 事件标识，同一事件重复通知时只投递一次
+	Type        string
+	ChainID     int64
+	UserAddress string // 事件关联的地址，没有时为空
+
+	// 余额变动事件用于过滤的字段
+	Amount        *big.Int
+	BalanceBefore *big.Int
+	BalanceAfter  *big.Int
+
+	Data interface{}
+	Time time.Time
+}
+
+// Notifier 把事件按订阅过滤后写入发件箱，由Dispatcher异步投递
+// 未启用Webhook时为nil，所有方法都可以在nil上安全调用
+type Notifier struct {
+	repos *database.Repositories
+
+	mu       sync.Mutex
+	subs     []database.WebhookSubscription
+	loadedAt time.Time
+}
+
+// NewNotifier 创建通知器，未启用Webhook时返回nil
+func NewNotifier(repos *database.Repositories, cfg *config.Config) *Notifier {
+	if !cfg.Webhook.Enabled {
+		return nil
+	}
+	return &Notifier{repos: repos}
+}
+
+// Notify 为匹配的订阅写入发件箱
+// 写入失败只记录日志，不影响调用方的主流程；需要与业务数据一起提交的事件使用Enqueue
+func (n *Notifier) Notify(event Event) {
+	if n == nil {
+		return
+	}
+	if err := n.Enqueue(n.repos, event); err != nil {
+		logger.WithFields(map[string]interface{}{
+			"error":    err,
+			"event_id": event.ID,
+		}).Error("写入Webhook发件箱失败")
+	}
+}
+
+// Enqueue 通过repos为匹配的订阅写入发件箱
+// repos绑定到事务时，投递记录与调用方在该事务中的其他写入一起提交或回滚
+func (n *Notifier) Enqueue(repos *database.Repositories, event Event) error {
+	if n == nil {
+		return nil
+	}
+
+	subs, err := n.subscriptions()
+	if err != nil {
+		return fmt.Errorf("获取Webhook订阅失败: %w", err)
+	}
+
+	var matched []database.WebhookSubscription
+	for _, sub := range subs {
+		if Matches(sub, event) {
+			matched = append(matched, sub)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(Envelope{
+		ID:        event.ID,
+		Type:      event.Type,
+		ChainID:   event.ChainID,
+		CreatedAt: event.Time,
+		Data:      event.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("序列化Webhook事件失败: %w", err)
+	}
+
+	deliveries := make([]database.WebhookDelivery, 0, len(matched))
+	for _, sub := range matched {
+		deliveries = append(deliveries, database.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        string(body),
+			Status:         database.DeliveryStatusPending,
+			NextAttemptAt:  event.Time,
+		})
+	}
+
+	if err := repos.Webhook.Enqueue(deliveries); err != nil {
+		return fmt.Errorf("写入Webhook发件箱失败: %w", err)
+	}
+	return nil
+}
+
+// subscriptions 获取启用的订阅（带缓存）
+func (n *Notifier) subscriptions() ([]database.WebhookSubscription, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.subs != nil && time.Since(n.loadedAt) < subscriptionCacheTTL {
+		return n.subs, nil
+	}
+
+	subs, err := n.repos.Webhook.ListSubscriptions(true)
+	if err != nil {
+		return nil, err
+	}
+	n.subs = subs
+	n.loadedAt = time.Now()
+	return subs, nil
+}
+
+// Matches 判断事件是否满足订阅的过滤条件
+// 地址、金额和余额阈值只约束带有对应字段的事件，不需要的事件类型应通过EventTypes排除
+func Matches(sub database.WebhookSubscription, event Event) bool {
+	if !sub.Active {
+		return false
+	}
+	if sub.ChainID != 0 && event.ChainID != 0 && sub.ChainID != event.ChainID {
+		return false
+	}
+	if sub.EventTypes != "" && !containsFold(sub.EventTypes, event.Type) {
+		return false
+	}
+	if sub.Addresses != "" && event.UserAddress != "" && !containsFold(sub.Addresses, event.UserAddress) {
+		return false
+	}
+
+	if event.Amount != nil {
+		if minAmount := parseAmount(sub.MinAmount); minAmount.Sign() > 0 && new(big.Int).Abs(event.Amount).Cmp(minAmount) < 0 {
+			return false
+		}
+	}
+
+	if event.BalanceBefore != nil && event.BalanceAfter != nil {
+		if threshold := parseAmount(sub.BalanceThreshold); threshold.Sign() > 0 && !crosses(event.BalanceBefore, event.BalanceAfter, threshold) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// crosses 判断余额是否跨越阈值（向上达到或向下跌破）
+func crosses(before, after, threshold *big.Int) bool {
+	up := before.Cmp(threshold) < 0 && after.Cmp(threshold) >= 0
+	down := before.Cmp(threshold) >= 0 && after.Cmp(threshold) < 0
+	return up || down
+}
+
+// containsFold 判断逗号分隔的列表中是否包含值（忽略大小写）
+func containsFold(list, value string) bool {
+	for _, item := range strings.Split(list, ",") {
+		if strings.EqualFold(strings.TrimSpace(item), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAmount 解析十进制金额，无效时返回0
+func parseAmount(value string) *big.Int {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return amount
+}
+
+// BalanceChanged 由余额变动记录构造事件
+func BalanceChanged(change *database.BalanceChange) Event {
+	before, after := change.GetBalanceBeforeBigInt(), change.GetBalanceAfterBigInt()
+	return Event{
+		ID:            fmt.Sprintf("%s:%d:%d", EventBalanceChanged, change.ChainID, change.ID),
+		Type:          EventBalanceChanged,
+		ChainID:       change.ChainID,
+		UserAddress:   change.UserAddress,
+		Amount:        new(big.Int).Sub(after, before),
+		BalanceBefore: before,
+		BalanceAfter:  after,
+		Data: map[string]interface{}{
+			"balance_change_id": change.ID,
+			"user_address":      change.UserAddress,
+			"tx_hash":           change.TxHash,
+			"block_number":      change.BlockNumber,
+			"change_type":       change.ChangeType,
+			"change_amount":     change.ChangeAmount,
+			"balance_before":    change.BalanceBefore,
+			"balance_after":     change.BalanceAfter,
+			"timestamp":         change.Timestamp,
+		},
+		Time: time.Now(),
+	}
+}
+
+// PointsCredited 构造积分到账事件
+func PointsCredited(userAddress string, chainID int64, points float64, startTime, endTime time.Time, averageBalance *big.Int) Event {
+	return Event{
+		ID:          fmt.Sprintf("%s:%d:%s:%d", EventPointsCredited, chainID, userAddress, endTime.Unix()),
+		Type:        EventPointsCredited,
+		ChainID:     chainID,
+		UserAddress: userAddress,
+		Data: map[string]interface{}{
+			"user_address":    userAddress,
+			"points":          points,
+			"start_time":      startTime,
+			"end_time":        endTime,
+			"average_balance": averageBalance.String(),
+		},
+		Time: time.Now(),
+	}
+}
+
+// ReorgRollback 构造区块重组事件，canonicalHash为零值表示节点推送了removed日志
+func ReorgRollback(chainID int64, blockNumber uint64, blockHash, canonicalHash common.Hash, txHash common.Hash, logIndex uint) Event {
+	data := map[string]interface{}{
+		"block_number": blockNumber,
+		"block_hash":   blockHash.Hex(),
+		"tx_hash":      txHash.Hex(),
+		"log_index":    logIndex,
+		"applied":      false, // 日志在确认前被回滚，未写入余额
+	}
+	if canonicalHash != (common.Hash{}) {
+		data["canonical_hash"] = canonicalHash.Hex()
+	}
+	return Event{
+		ID:      fmt.Sprintf("%s:%d:%s:%d", EventReorgRollback, chainID, blockHash.Hex(), logIndex),
+		Type:    EventReorgRollback,
+		ChainID: chainID,
+		Data:    data,
+		Time:    time.Now(),
+	}
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"fmt"
+	"time"
+
+	"erc20-tracker/backend/internal/config"
+	"erc20-tracker/backend/internal/database"
+	"erc20-tracker/backend/pkg/logger"
+)
+
+// StallMonitor 检查各链同步状态，停滞和恢复时各通知一次
+type StallMonitor struct {
+	repos     *database.Repositories
+	notifier  *Notifier
+	chains    []config.ChainConfig
+	threshold time.Duration
+	stalled   map[int64]bool
+}
+
+// NewStallMonitor 创建同步停滞监控
+func NewStallMonitor(repos *database.Repositories, notifier *Notifier, cfg *config.Config) *StallMonitor {
+	return &StallMonitor{
+		repos:     repos,
+		notifier:  notifier,
+		chains:    cfg.GetEnabledChains(),
+		threshold: cfg.Webhook.StallThreshold,
+		stalled:   make(map[int64]bool),
+	}
+}
+
+// Check 检查一次所有链的同步状态
+func (m *StallMonitor) Check(now time.Time) {
+	for _, chain := range m.chains {
+		status, err := m.repos.BlockSyncStatus.GetOrCreate(chain.ChainID)
+		if err != nil {
+			logger.WithFields(map[string]interface{}{
+				"error": err,
+				"chain": chain.Name,
+			}).Error("获取同步状态失败")
+			continue
+		}
+
+		lag := now.Sub(status.LastSyncedAt)
+		stalled := lag > m.threshold
+		if stalled == m.stalled[chain.ChainID] {
+			continue
+		}
+		m.stalled[chain.ChainID] = stalled
+
+		eventType := EventSyncRecovered
+		if stalled {
+			eventType = EventSyncStalled
+			logger.WithFields(map[string]interface{}{
+				"chain":             chain.Name,
+				"last_synced_block": status.LastSyncedBlock,
+				"stalled_for":       lag.String(),
+			}).Warn("同步停滞")
+		}
+
+		m.notifier.Notify(Event{
+			ID:      fmt.Sprintf("%s:%d:%d:%d", eventType, chain.ChainID, status.LastSyncedBlock, status.LastSyncedAt.Unix()),
+			Type:    eventType,
+			ChainID: chain.ChainID,
+			Data: map[string]interface{}{
+				"chain_name":        chain.Name,
+				"last_synced_block": status.LastSyncedBlock,
+				"last_synced_at":    status.LastSyncedAt,
+				"lag_seconds":       int64(lag.Seconds()),
+				"threshold_seconds": int64(m.threshold.Seconds()),
+			},
+			Time: now,
+		})
+	}
+}
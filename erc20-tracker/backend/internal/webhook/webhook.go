@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"erc20-tracker/backend/internal/retry"
+)
+
+// 事件类型
+const (
+	EventBalanceChanged = "balance.changed" // 写入了新的余额变动记录
+	EventPointsCredited = "points.credited" // 积分计算增加了积分
+	EventReorgRollback  = "reorg.rollback"  // 等待确认的日志所在区块被重组，日志未写入余额
+	EventSyncStalled    = "sync.stalled"    // 同步状态超过阈值未更新
+	EventSyncRecovered  = "sync.recovered"  // 停滞后恢复同步
+	EventTest           = "webhook.test"    // 手动发送的测试事件
+)
+
+// 请求头
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Envelope Webhook请求体
+type Envelope struct {
+	ID        string      `json:"id"` // 事件标识，同一事件的重试保持不变，接收方据此去重
+	Type      string      `json:"type"`
+	ChainID   int64       `json:"chain_id,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// Sign 计算签名: hex(HMAC-SHA256(secret, timestamp + "." + body))
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验签名请求头（sha256=<hex>），供接收方使用
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	expected := "sha256=" + Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// Send 发送一次签名的Webhook请求，返回HTTP状态码
+// 4xx（408、429除外）返回retry.NonRetryableError，调用方应直接转入死信
+func Send(ctx context.Context, client *http.Client, url, secret, eventID, eventType string, body []byte, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, retry.NewNonRetryableError(fmt.Errorf("创建请求失败: %w", err))
+	}
+
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, eventID)
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("发送请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 读取少量响应内容用于排查，其余丢弃以便复用连接
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+
+	err = fmt.Errorf("接收方返回状态码 %d", resp.StatusCode)
+	if text := strings.TrimSpace(string(snippet)); text != "" {
+		err = fmt.Errorf("接收方返回状态码 %d: %s", resp.StatusCode, text)
+	}
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return resp.StatusCode, retry.NewNonRetryableError(err)
+	}
+	return resp.StatusCode, err
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// blockOutput 区块查询结果
+type blockOutput struct {
+	Number       uint64   `json:"number"`
+	Hash         string   `json:"hash"`
+	ParentHash   string   `json:"parent_hash"`
+	Timestamp    uint64   `json:"timestamp"`
+	Time         string   `json:"time"`
+	Miner        string   `json:"miner"`
+	GasUsed      uint64   `json:"gas_used"`
+	GasLimit     uint64   `json:"gas_limit"`
+	BaseFee      string   `json:"base_fee,omitempty"`
+	Difficulty   string   `json:"difficulty"`
+	TxCount      int      `json:"tx_count"`
+	Transactions []string `json:"transactions,omitempty"`
+}
+
+// runBlock 按区块号或哈希查询区块，都不指定时查询最新区块
+func runBlock(args []string) error {
+	fs, opts := newFlagSet("block")
+	number := fs.Int64("number", -1, "区块号，默认最新区块")
+	hash := fs.String("hash", "", "区块哈希")
+	withTxs := fs.Bool("txs", false, "列出区块内的交易哈希")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *number >= 0 && *hash != "" {
+		return errors.New("--number 和 --hash 只能指定一个")
+	}
+
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	var block *types.Block
+	switch {
+	case *hash != "":
+		var blockHash common.Hash
+		if blockHash, err = parseHash(*hash); err != nil {
+			return err
+		}
+		block, err = s.client.BlockByHash(ctx, blockHash)
+	case *number >= 0:
+		block, err = s.client.BlockByNumber(ctx, big.NewInt(*number))
+	default:
+		block, err = s.client.BlockByNumber(ctx, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("获取区块失败: %w", err)
+	}
+
+	out := blockOutput{
+		Number:     block.NumberU64(),
+		Hash:       block.Hash().Hex(),
+		ParentHash: block.ParentHash().Hex(),
+		Timestamp:  block.Time(),
+		Time:       time.Unix(int64(block.Time()), 0).UTC().Format(time.RFC3339),
+		Miner:      block.Coinbase().Hex(),
+		GasUsed:    block.GasUsed(),
+		GasLimit:   block.GasLimit(),
+		BaseFee:    bigString(block.BaseFee()),
+		Difficulty: bigString(block.Difficulty()),
+		TxCount:    len(block.Transactions()),
+	}
+	if *withTxs {
+		for _, tx := range block.Transactions() {
+			out.Transactions = append(out.Transactions, tx.Hash().Hex())
+		}
+	}
+
+	if opts.json {
+		return printJSON(out)
+	}
+
+	fmt.Printf("区块号:     %d\n", out.Number)
+	fmt.Printf("区块哈希:   %s\n", out.Hash)
+	fmt.Printf("父区块:     %s\n", out.ParentHash)
+	fmt.Printf("时间:       %s (%d)\n", out.Time, out.Timestamp)
+	fmt.Printf("出块地址:   %s\n", out.Miner)
+	fmt.Printf("Gas:        %d / %d\n", out.GasUsed, out.GasLimit)
+	if block.BaseFee() != nil {
+		fmt.Printf("基础费用:   %s\n", formatGwei(block.BaseFee()))
+	}
+	fmt.Printf("交易数:     %d\n", out.TxCount)
+	for i, txHash := range out.Transactions {
+		fmt.Printf("  %4d  %s\n", i, txHash)
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/test/client/internal/abidecode"
+	"github.com/test/client/internal/hdwallet"
+	"github.com/test/client/internal/nonce"
+	"github.com/test/client/internal/signer"
+	"github.com/test/client/internal/txbuilder"
+)
+
+// chainInfo 已知链的配置
+type chainInfo struct {
+	Name     string
+	ChainID  int64
+	RPCEnv   string              // 该链默认RPC地址的环境变量
+	Explorer string              // 区块浏览器地址，为空时不输出链接
+	Config   *params.ChainConfig // 分叉配置，用来按区块选择签名器，为nil时总是使用最新的签名器
+}
+
+// knownChains 支持按名称引用的链
+var knownChains = []chainInfo{
+	{Name: "mainnet", ChainID: 1, RPCEnv: "MAINNET_RPC_URL", Explorer: "https://etherscan.io", Config: params.MainnetChainConfig},
+	{Name: "sepolia", ChainID: 11155111, RPCEnv: "SEPOLIA_RPC_URL", Explorer: "https://sepolia.etherscan.io", Config: params.SepoliaChainConfig},
+	{Name: "holesky", ChainID: 17000, RPCEnv: "HOLESKY_RPC_URL", Explorer: "https://holesky.etherscan.io", Config: params.HoleskyChainConfig},
+	{Name: "base-sepolia", ChainID: 84532, RPCEnv: "BASE_SEPOLIA_RPC_URL", Explorer: "https://sepolia.basescan.org"},
+}
+
+// findChain 按名称或链ID查找链，未知的链ID也允许使用
+func findChain(key string) (chainInfo, error) {
+	for _, chain := range knownChains {
+		if strings.EqualFold(chain.Name, key) || strconv.FormatInt(chain.ChainID, 10) == key {
+			return chain, nil
+		}
+	}
+	if id, err := strconv.ParseInt(key, 10, 64); err == nil && id > 0 {
+		return chainInfo{Name: key, ChainID: id}, nil
+	}
+	return chainInfo{}, fmt.Errorf("未知的链: %s", key)
+}
+
+// options 所有命令共用的参数
+type options struct {
+	rpcURL  string
+	chain   string
+	json    bool
+	timeout time.Duration
+}
+
+// newFlagSet 创建带通用参数的参数解析器，默认值来自环境变量
+func newFlagSet(name string) (*flag.FlagSet, *options) {
+	opts := &options{}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(&opts.rpcURL, "rpc", os.Getenv("ETH_RPC_URL"), "RPC节点地址（ETH_RPC_URL）")
+	fs.StringVar(&opts.chain, "chain", getEnv("ETH_CHAIN", "sepolia"), "链名称或链ID（ETH_CHAIN）")
+	fs.BoolVar(&opts.json, "json", false, "以JSON格式输出")
+	fs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "RPC请求超时")
+	return fs, opts
+}
+
+// getEnv 读取环境变量，未设置时返回默认值
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// session 一次命令执行期间的RPC连接
+type session struct {
+	opts    *options
+	chain   chainInfo
+	rpc     *rpc.Client
+	client  *ethclient.Client
+	chainID *big.Int
+	nonces  *nonce.Manager
+}
+
+// connect 连接RPC节点并确认链ID与 --chain 一致
+func connect(opts *options) (*session, error) {
+	chain, err := findChain(opts.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	url := opts.rpcURL
+	if url == "" && chain.RPCEnv != "" {
+		url = os.Getenv(chain.RPCEnv)
+	}
+	if url == "" {
+		return nil, fmt.Errorf("未配置RPC节点: 使用 --rpc 或设置 ETH_RPC_URL / %s", chain.RPCEnv)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("连接RPC节点失败: %w", err)
+	}
+	client := ethclient.NewClient(rpcClient)
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("获取链ID失败: %w", err)
+	}
+	if chainID.Int64() != chain.ChainID {
+		client.Close()
+		return nil, fmt.Errorf("RPC节点的链ID为 %s，与 --chain %s（%d）不一致", chainID, chain.Name, chain.ChainID)
+	}
+
+	return &session{
+		opts:    opts,
+		chain:   chain,
+		rpc:     rpcClient,
+		client:  client,
+		chainID: chainID,
+		nonces:  nonce.NewManager(client),
+	}, nil
+}
+
+// Close 关闭RPC连接
+func (s *session) Close() {
+	s.client.Close()
+}
+
+// context 带超时的请求上下文
+func (s *session) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.opts.timeout)
+}
+
+// txURL 交易在区块浏览器中的链接，未知链返回空
+func (s *session) txURL(hash common.Hash) string {
+	if s.chain.Explorer == "" {
+		return ""
+	}
+	return s.chain.Explorer + "/tx/" + hash.Hex()
+}
+
+// sendFlags 发送交易的命令共用的参数
+type sendFlags struct {
+	signer        *signer.Config
+	speed         *string
+	wait          *bool
+	waitTimeout   *time.Duration
+	confirmations *uint64
+	speedUpAfter  *time.Duration
+	maxSpeedUps   *int
+	txState       *string
+}
+
+// addSendFlags 注册签名器和等待上链的参数
+func addSendFlags(fs *flag.FlagSet) *sendFlags {
+	return &sendFlags{
+		signer:        addSignerFlags(fs),
+		speed:         fs.String("speed", getEnv("ETH_GAS_SPEED", string(txbuilder.SpeedNormal)), "手续费档位 slow|normal|fast（ETH_GAS_SPEED）"),
+		wait:          fs.Bool("wait", false, "等待交易上链"),
+		waitTimeout:   fs.Duration("wait-timeout", 5*time.Minute, "等待上链的超时时间，超时后可以用 track watch 继续等待"),
+		confirmations: fs.Uint64("confirmations", 1, "等待的确认数，打包所在区块算1个"),
+		speedUpAfter:  fs.Duration("speed-up-after", 0, "等待期间交易pending超过该时间后自动加速，0为不加速"),
+		maxSpeedUps:   fs.Int("max-speed-ups", 3, "自动加速的最多次数"),
+		txState:       fs.String("tx-state", os.Getenv("ETH_TX_STATE"), "交易跟踪状态文件（ETH_TX_STATE），默认 ~/.ethcli/txs.json"),
+	}
+}
+
+// addSignerFlags 注册签名器参数，默认值来自环境变量
+func addSignerFlags(fs *flag.FlagSet) *signer.Config {
+	cfg := &signer.Config{Mnemonic: os.Getenv("ETH_MNEMONIC")}
+	fs.StringVar(&cfg.Source, "signer", os.Getenv("ETH_SIGNER"), "签名器类型 env|keystore|mnemonic（ETH_SIGNER），默认按已配置的项选择")
+	fs.StringVar(&cfg.KeyEnv, "key-env", signer.DefaultKeyEnv, "存放十六进制私钥的环境变量")
+	fs.StringVar(&cfg.Keystore, "keystore", os.Getenv("ETH_KEYSTORE"), "加密keystore文件（ETH_KEYSTORE）")
+	fs.StringVar(&cfg.PasswordFile, "password-file", os.Getenv("ETH_PASSWORD_FILE"), "keystore密码或BIP-39密码文件（ETH_PASSWORD_FILE），未设置时在终端输入keystore密码")
+	fs.StringVar(&cfg.MnemonicFile, "mnemonic-file", os.Getenv("ETH_MNEMONIC_FILE"), "助记词文件（ETH_MNEMONIC_FILE），也可以用 ETH_MNEMONIC 直接提供")
+	fs.StringVar(&cfg.HDPath, "hd-path", getEnv("ETH_HD_PATH", hdwallet.DefaultPath), "助记词派生路径（ETH_HD_PATH）")
+	return cfg
+}
+
+// addABIFlag 注册解码调用数据和日志时额外使用的ABI文件
+func addABIFlag(fs *flag.FlagSet) *string {
+	return fs.String("abi", os.Getenv("ETH_ABI"), "额外的ABI文件（ETH_ABI），多个用逗号分隔，支持Hardhat、Foundry编译产物")
+}
+
+// loadRegistry 内置ABI加上 --abi 指定的文件，文件中的ABI优先
+func loadRegistry(files string) (*abidecode.Registry, error) {
+	registry, err := abidecode.Builtin()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range splitList(files) {
+		if err := registry.AddFile(path); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// registry 加载ABI并绑定当前链上已配置的Counter、Voting合约地址
+func (s *session) registry(files string) (*abidecode.Registry, error) {
+	registry, err := loadRegistry(files)
+	if err != nil {
+		return nil, err
+	}
+	if address, err := s.contractAddress("", "COUNTER_ADDRESS", sepoliaCounter); err == nil {
+		registry.Bind(address, abidecode.NameCounter)
+	}
+	if address, err := s.contractAddress("", "VOTING_ADDRESS", sepoliaVoting); err == nil {
+		registry.Bind(address, abidecode.NameVoting)
+	}
+	return registry, nil
+}
+
+// estimateFees 按 --speed 估算手续费，没有基础费用的链上为传统Gas价格
+func (s *session) estimateFees(ctx context.Context, flags *sendFlags) (*txbuilder.Fees, error) {
+	speed, err := txbuilder.ParseSpeed(*flags.speed)
+	if err != nil {
+		return nil, err
+	}
+	return txbuilder.EstimateFees(ctx, s.client, speed)
+}
+
+// transact 由nonce管理器分配nonce后执行合约写操作，可以在多个协程中同时调用
+func (s *session) transact(ctx context.Context, sg signer.Signer, fees *txbuilder.Fees, call func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	auth := signer.TransactOpts(ctx, sg, s.chainID)
+	fees.Apply(auth)
+
+	reservation, err := s.nonces.Reserve(ctx, auth.From)
+	if err != nil {
+		return nil, err
+	}
+	auth.Nonce = new(big.Int).SetUint64(reservation.Nonce)
+
+	tx, err := call(auth)
+	s.reservationDone(ctx, reservation, auth.From, err)
+	return tx, err
+}
+
+// reservationDone 报告nonce的发送结果，节点报告nonce已占用或过高时立即从节点重新同步
+// 同一会话中的后续交易（如并发投票）从同步后的nonce继续；同步失败时由下一次分配重试
+func (s *session) reservationDone(ctx context.Context, reservation *nonce.Reservation, from common.Address, err error) {
+	reservation.Done(err)
+	if !nonce.IsNonceUsed(err) && !nonce.IsNonceTooHigh(err) {
+		return
+	}
+	if err := s.nonces.Resync(ctx, from); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// parseHash 解析32字节的区块或交易哈希
+func parseHash(value string) (common.Hash, error) {
+	b, err := hexutil.Decode(strings.TrimSpace(value))
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("无效的哈希: %s", value)
+	}
+	return common.BytesToHash(b), nil
+}
+
+// parseAddress 解析地址
+func parseAddress(value string) (common.Address, error) {
+	if !common.IsHexAddress(value) {
+		return common.Address{}, fmt.Errorf("无效的地址: %s", value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+// signerAt 按交易所在区块选择恢复发送方的签名器
+// 已知链按分叉配置选择（例如主网早期区块只支持Homestead签名）；未知链或待打包的交易（header为nil）使用最新的签名器
+func (s *session) signerAt(header *types.Header) types.Signer {
+	if s.chain.Config != nil && header != nil {
+		return types.MakeSigner(s.chain.Config, header.Number, header.Time)
+	}
+	return types.LatestSignerForChainID(s.chainID)
+}
+
+// splitList 拆分逗号分隔的参数，忽略空项
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// contractAddress 合约地址优先取参数，其次取环境变量，在Sepolia上默认使用已部署的合约
+func (s *session) contractAddress(value, envKey, sepoliaDefault string) (common.Address, error) {
+	if value == "" {
+		value = os.Getenv(envKey)
+	}
+	if value == "" && s.chain.ChainID == 11155111 {
+		value = sepoliaDefault
+	}
+	if value == "" {
+		return common.Address{}, fmt.Errorf("未配置合约地址: 使用 --contract 或设置 %s", envKey)
+	}
+	return parseAddress(value)
+}
+
+// callOpts 合约只读调用参数
+func callOpts(ctx context.Context) *bind.CallOpts {
+	return &bind.CallOpts{Context: ctx}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/test/client/internal/signer"
+	"github.com/test/client/task2/counter"
+)
+
+// sepoliaCounter Sepolia上部署的Counter合约
+const sepoliaCounter = "0x94C6335dC38af266f8e8E8d9631adE2F59935065"
+
+// newCounterFlagSet 创建counter子命令的参数解析器
+func newCounterFlagSet(name string) (*flag.FlagSet, *options, *string) {
+	fs, opts := newFlagSet(name)
+	contract := fs.String("contract", "", "Counter合约地址（COUNTER_ADDRESS）")
+	return fs, opts, contract
+}
+
+// runCounter Counter合约命令
+func runCounter(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: counter get|increment [参数]")
+	}
+
+	switch args[0] {
+	case "get":
+		return runCounterGet(args[1:])
+	case "increment":
+		return runCounterIncrement(args[1:])
+	default:
+		return fmt.Errorf("未知的counter子命令: %s", args[0])
+	}
+}
+
+// openCounter 连接节点并创建Counter合约实例
+func openCounter(opts *options, contractAddress string) (*session, *counter.Counter, error) {
+	s, err := connect(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	address, err := s.contractAddress(contractAddress, "COUNTER_ADDRESS", sepoliaCounter)
+	if err != nil {
+		s.Close()
+		return nil, nil, err
+	}
+	contract, err := counter.NewCounter(address, s.client)
+	if err != nil {
+		s.Close()
+		return nil, nil, fmt.Errorf("创建合约实例失败: %w", err)
+	}
+	return s, contract, nil
+}
+
+// runCounterGet 查询当前计数
+func runCounterGet(args []string) error {
+	fs, opts, address := newCounterFlagSet("counter get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, contract, err := openCounter(opts, *address)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	count, err := contract.Count(callOpts(ctx))
+	if err != nil {
+		return fmt.Errorf("获取计数器值失败: %w", err)
+	}
+
+	if opts.json {
+		return printJSON(map[string]string{"count": count.String()})
+	}
+	fmt.Printf("当前计数器值: %s\n", count)
+	return nil
+}
+
+// runCounterIncrement 发送increment交易
+func runCounterIncrement(args []string) error {
+	fs, opts, address := newCounterFlagSet("counter increment")
+	flags := addSendFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	sg, err := signer.Open(*flags.signer)
+	if err != nil {
+		return err
+	}
+
+	s, contract, err := openCounter(opts, *address)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	fees, err := s.estimateFees(ctx, flags)
+	if err != nil {
+		return err
+	}
+	tx, err := s.transact(ctx, sg, fees, contract.Increment)
+	if err != nil {
+		return fmt.Errorf("调用increment失败: %w", err)
+	}
+
+	out := sentOutput{
+		Hash:     tx.Hash().Hex(),
+		From:     sg.Address().Hex(),
+		To:       tx.To().Hex(),
+		Nonce:    tx.Nonce(),
+		Fees:     newFeesOutput(fees),
+		Explorer: s.txURL(tx.Hash()),
+	}
+	return s.finishSent(opts, sg, out, tx, flags)
+}
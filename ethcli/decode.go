@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// runDecode 不连接节点，按ABI解码调用数据或回滚数据
+func runDecode(args []string) error {
+	fs := flag.NewFlagSet("decode", flag.ContinueOnError)
+	jsonOut := fs.Bool("json", false, "以JSON格式输出")
+	data := fs.String("data", "", "交易的调用数据（0x开头）")
+	revert := fs.String("revert", "", "eth_call 返回的回滚数据（0x开头）")
+	to := fs.String("to", "", "目标合约地址，用来在多个ABI都匹配时优先选择绑定到该地址的ABI")
+	abiFiles := addABIFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*data == "") == (*revert == "") {
+		return errors.New("必须指定 --data 或 --revert 中的一个")
+	}
+
+	registry, err := loadRegistry(*abiFiles)
+	if err != nil {
+		return err
+	}
+	var target *common.Address
+	if *to != "" {
+		address, err := parseAddress(*to)
+		if err != nil {
+			return err
+		}
+		target = &address
+	} else {
+		// 没有指定地址时 DecodeCall 会当作合约创建，用零地址代替
+		target = &common.Address{}
+	}
+
+	if *revert != "" {
+		b, err := hexutil.Decode(*revert)
+		if err != nil {
+			return fmt.Errorf("无效的回滚数据: %w", err)
+		}
+		result := registry.DecodeRevert(target, b)
+		if *jsonOut {
+			return printJSON(result)
+		}
+		fmt.Printf("回滚原因: %s\n", result)
+		return nil
+	}
+
+	b, err := hexutil.Decode(*data)
+	if err != nil {
+		return fmt.Errorf("无效的调用数据: %w", err)
+	}
+	call, err := registry.DecodeCall(target, b)
+	if err != nil {
+		return err
+	}
+	if call == nil {
+		return errors.New("调用数据不足4字节，没有函数选择器")
+	}
+	if *jsonOut {
+		return printJSON(call)
+	}
+	fmt.Printf("合约:     %s\n", call.Contract)
+	fmt.Printf("函数:     %s\n", call.Signature)
+	fmt.Printf("选择器:   %s\n", call.Selector)
+	for _, arg := range call.Args {
+		fmt.Printf("  %s (%s): %v\n", arg.Name, arg.Type, arg.Value)
+	}
+	return nil
+}
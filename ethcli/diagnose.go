@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/test/client/internal/abidecode"
+	"github.com/test/client/internal/txdiag"
+)
+
+// failureOutput 失败交易的诊断结果
+type failureOutput struct {
+	Reason     string            `json:"reason"`
+	OutOfGas   bool              `json:"out_of_gas"`
+	Reproduced bool              `json:"reproduced"`
+	Message    string            `json:"message,omitempty"`
+	Revert     *abidecode.Revert `json:"revert,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// diagnose 在父区块上重放失败的交易并解码回滚原因，重放出错时记录在Error中
+func (s *session) diagnose(ctx context.Context, registry *abidecode.Registry, signer types.Signer, tx *types.Transaction, receipt *types.Receipt) *failureOutput {
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return &failureOutput{Reason: "未知", Error: fmt.Sprintf("恢复交易发送方失败: %v", err)}
+	}
+	d, err := txdiag.Diagnose(ctx, s.client, registry, from, tx, receipt)
+	if err != nil {
+		return &failureOutput{Reason: "未知", Error: err.Error()}
+	}
+	return &failureOutput{
+		Reason:     d.Reason(),
+		OutOfGas:   d.OutOfGas,
+		Reproduced: d.Reproduced,
+		Message:    d.Message,
+		Revert:     d.Revert,
+	}
+}
+
+// failureError 失败交易的错误信息，附带诊断出的原因
+func (s *session) failureError(tx *types.Transaction, receipt *types.Receipt) error {
+	registry, err := s.registry("")
+	if err != nil {
+		return fmt.Errorf("交易执行失败: %s", receipt.TxHash.Hex())
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+
+	// 刚发送的交易按最新的规则签名
+	failure := s.diagnose(ctx, registry, types.LatestSignerForChainID(s.chainID), tx, receipt)
+	if failure.Error != "" {
+		return fmt.Errorf("交易执行失败: %s（诊断失败: %s）", receipt.TxHash.Hex(), failure.Error)
+	}
+	return fmt.Errorf("交易执行失败: %s，原因: %s", receipt.TxHash.Hex(), failure.Reason)
+}
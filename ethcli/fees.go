@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/test/client/internal/txbuilder"
+)
+
+// feesOutput 手续费估算结果
+type feesOutput struct {
+	Speed    string `json:"speed"`
+	Dynamic  bool   `json:"dynamic"`
+	BaseFee  string `json:"base_fee,omitempty"`
+	TipCap   string `json:"max_priority_fee_per_gas,omitempty"`
+	FeeCap   string `json:"max_fee_per_gas,omitempty"`
+	GasPrice string `json:"gas_price,omitempty"`
+}
+
+// newFeesOutput 转换手续费为输出格式
+func newFeesOutput(fees *txbuilder.Fees) *feesOutput {
+	if fees == nil {
+		return nil
+	}
+	return &feesOutput{
+		Speed:    string(fees.Speed),
+		Dynamic:  fees.Dynamic,
+		BaseFee:  bigString(fees.BaseFee),
+		TipCap:   bigString(fees.TipCap),
+		FeeCap:   bigString(fees.FeeCap),
+		GasPrice: bigString(fees.GasPrice),
+	}
+}
+
+// runFees 显示各档位的手续费估算
+func runFees(args []string) error {
+	fs, opts := newFlagSet("fees")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	result := make([]*txbuilder.Fees, 0, len(txbuilder.Speeds()))
+	for _, speed := range txbuilder.Speeds() {
+		fees, err := txbuilder.EstimateFees(ctx, s.client, speed)
+		if err != nil {
+			return err
+		}
+		result = append(result, fees)
+	}
+
+	if opts.json {
+		outputs := make([]*feesOutput, 0, len(result))
+		for _, fees := range result {
+			outputs = append(outputs, newFeesOutput(fees))
+		}
+		return printJSON(outputs)
+	}
+	if !result[0].Dynamic {
+		fmt.Println("链上没有基础费用，使用传统Gas价格")
+		for _, fees := range result {
+			fmt.Printf("  %-8s Gas价格 %s\n", fees.Speed, formatGwei(fees.GasPrice))
+		}
+		return nil
+	}
+	fmt.Printf("下一区块基础费用: %s\n", formatGwei(result[0].BaseFee))
+	for _, fees := range result {
+		fmt.Printf("  %-8s 小费 %-18s 最高费用 %s\n", fees.Speed, formatGwei(fees.TipCap), formatGwei(fees.FeeCap))
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// command 子命令定义
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+// commands 返回所有可用的子命令
+func commands() []command {
+	return []command{
+		{name: "block", summary: "查询区块: block [--number N | --hash H] [--txs]", run: runBlock},
+		{name: "tx", summary: "查询交易及发送方: tx --hash <交易哈希>", run: runTx},
+		{name: "receipt", summary: "查询收据: receipt --hash <交易哈希> | receipt --block <区块号或哈希>", run: runReceipt},
+		{name: "scan", summary: "批量扫描: scan --start N [--end M] [--from|--to|--contract|--selector ..] [--format jsonl|csv] [--out 文件] [--checkpoint 文件]", run: runScan},
+		{name: "decode", summary: "离线解码: decode --data <调用数据> | --revert <回滚数据> [--to <地址>] [--abi <文件>]", run: runDecode},
+		{name: "fees", summary: "手续费估算: fees，按slow/normal/fast档位显示小费和最高费用", run: runFees},
+		{name: "send", summary: "发送ETH: send --to <地址> --value <数量ETH> [--data 0x..] [--speed slow|normal|fast] [--wait]", run: runSend},
+		{name: "track", summary: "交易跟踪: track list | watch|speedup|cancel --hash <交易哈希> | prune", run: runTrack},
+		{name: "wallet", summary: "钱包: wallet new|mnemonic|derive|export|import|check|vanity", run: runWallet},
+		{name: "counter", summary: "Counter合约: counter get | increment [--contract <地址>] [--wait]", run: runCounter},
+		{name: "vote", summary: "Voting合约: vote list | votes --candidate <名称> | cast --candidate <名称> | reset", run: runVote},
+	}
+}
+
+// runCommand 解析并执行子命令
+func runCommand(args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage()
+		return nil
+	}
+
+	name := args[0]
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd.run(args[1:])
+		}
+	}
+
+	printUsage()
+	return fmt.Errorf("未知命令: %s", name)
+}
+
+// printUsage 打印命令帮助
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "用法: ethcli <命令> [参数]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "命令:")
+	for _, cmd := range commands() {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "通用参数:")
+	fmt.Fprintln(os.Stderr, "  --rpc      RPC节点地址，默认读取 ETH_RPC_URL，未设置时读取 <链>_RPC_URL（如 SEPOLIA_RPC_URL）")
+	fmt.Fprintln(os.Stderr, "  --chain    链名称或链ID，默认读取 ETH_CHAIN，未设置时为 sepolia")
+	fmt.Fprintln(os.Stderr, "  --json     以JSON格式输出")
+	fmt.Fprintln(os.Stderr, "  --timeout  RPC请求超时，默认30s")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "发送交易的命令支持以下签名器（--signer，默认按已配置的项选择）:")
+	fmt.Fprintln(os.Stderr, "  keystore   --keystore <文件> [--password-file <文件>]，未指定密码文件时在终端输入密码")
+	fmt.Fprintln(os.Stderr, "  mnemonic   ETH_MNEMONIC 或 --mnemonic-file <文件>，--hd-path 默认 m/44'/60'/0'/0/0")
+	fmt.Fprintln(os.Stderr, "  env        环境变量 ETH_PRIVATE_KEY（或 --key-env 指定的变量）中的十六进制私钥，用于CI")
+}
+
+func main() {
+	if err := runCommand(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "执行失败: %v\n", err)
+		os.Exit(1)
+	}
+}
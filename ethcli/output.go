@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// printJSON 以缩进格式输出JSON
+func printJSON(v any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// parseEther 把十进制的ETH数量转换为wei，最多18位小数
+func parseEther(value string) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.Trim(value, "0123456789.") != "" || strings.Count(value, ".") > 1 {
+		return nil, fmt.Errorf("无效的金额: %s", value)
+	}
+	if i := strings.IndexByte(value, '.'); i >= 0 && len(value)-i-1 > 18 {
+		return nil, fmt.Errorf("金额最多18位小数: %s", value)
+	}
+
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("无效的金额: %s", value)
+	}
+	amount.Mul(amount, new(big.Rat).SetInt(big.NewInt(params.Ether)))
+	return new(big.Int).Quo(amount.Num(), amount.Denom()), nil
+}
+
+// formatEther 把wei格式化为ETH，去掉多余的0
+func formatEther(wei *big.Int) string {
+	if wei == nil {
+		return "0"
+	}
+	value := new(big.Rat).SetFrac(wei, big.NewInt(params.Ether)).FloatString(18)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".")
+}
+
+// formatGwei 把wei格式化为Gwei
+func formatGwei(wei *big.Int) string {
+	if wei == nil {
+		return "-"
+	}
+	value := new(big.Rat).SetFrac(wei, big.NewInt(params.GWei)).FloatString(9)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".") + " Gwei"
+}
+
+// bigString 大整数转字符串，nil返回空字符串
+func bigString(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
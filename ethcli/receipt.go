@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/test/client/internal/abidecode"
+)
+
+// logOutput 事件日志
+type logOutput struct {
+	Index   uint             `json:"log_index"`
+	Address string           `json:"address"`
+	Topics  []string         `json:"topics"`
+	Data    string           `json:"data"`
+	Event   *abidecode.Event `json:"event,omitempty"`
+}
+
+// receiptOutput 交易收据
+type receiptOutput struct {
+	TxHash            string          `json:"tx_hash"`
+	Status            uint64          `json:"status"`
+	From              string          `json:"from,omitempty"`
+	To                string          `json:"to,omitempty"`
+	BlockNumber       string          `json:"block_number"`
+	BlockHash         string          `json:"block_hash"`
+	TransactionIndex  uint            `json:"transaction_index"`
+	GasUsed           uint64          `json:"gas_used"`
+	GasLimit          uint64          `json:"gas_limit,omitempty"`
+	CumulativeGasUsed uint64          `json:"cumulative_gas_used"`
+	EffectiveGasPrice string          `json:"effective_gas_price,omitempty"`
+	ContractAddress   string          `json:"contract_address,omitempty"`
+	Call              *abidecode.Call `json:"call,omitempty"`
+	Failure           *failureOutput  `json:"failure,omitempty"`
+	Logs              []logOutput     `json:"logs"`
+}
+
+// runReceipt 按交易哈希查询收据，或查询整个区块的收据
+func runReceipt(args []string) error {
+	fs, opts := newFlagSet("receipt")
+	hash := fs.String("hash", "", "交易哈希")
+	block := fs.String("block", "", "区块号或区块哈希，查询该区块所有交易的收据")
+	abiFiles := addABIFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*hash == "") == (*block == "") {
+		return errors.New("必须指定 --hash 或 --block 中的一个")
+	}
+
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	registry, err := s.registry(*abiFiles)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	var receipts []*types.Receipt
+	var txs []*types.Transaction
+	var header *types.Header
+	if *hash != "" {
+		txHash, err := parseHash(*hash)
+		if err != nil {
+			return err
+		}
+		receipt, err := s.client.TransactionReceipt(ctx, txHash)
+		if err != nil {
+			return fmt.Errorf("获取交易收据失败: %w", err)
+		}
+		tx, _, err := s.client.TransactionByHash(ctx, txHash)
+		if err != nil {
+			return fmt.Errorf("获取交易失败: %w", err)
+		}
+		if header, err = s.client.HeaderByHash(ctx, receipt.BlockHash); err != nil {
+			return fmt.Errorf("获取区块头失败: %w", err)
+		}
+		receipts = []*types.Receipt{receipt}
+		txs = []*types.Transaction{tx}
+	} else {
+		ref, err := parseBlockRef(*block)
+		if err != nil {
+			return err
+		}
+		receipts, err = s.client.BlockReceipts(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("获取区块收据失败: %w", err)
+		}
+		if len(receipts) > 0 {
+			block, err := s.client.BlockByHash(ctx, receipts[0].BlockHash)
+			if err != nil {
+				return fmt.Errorf("获取区块失败: %w", err)
+			}
+			txs = block.Transactions()
+			header = block.Header()
+		}
+	}
+	if len(txs) != len(receipts) {
+		return fmt.Errorf("区块内有 %d 笔交易，但返回了 %d 个收据", len(txs), len(receipts))
+	}
+
+	signer := s.signerAt(header)
+	outputs := make([]receiptOutput, 0, len(receipts))
+	for i, receipt := range receipts {
+		out := newReceiptOutput(receipt)
+		s.decodeReceipt(ctx, &out, registry, signer, txs[i], receipt)
+		outputs = append(outputs, out)
+	}
+
+	if opts.json {
+		if *hash != "" {
+			return printJSON(outputs[0])
+		}
+		return printJSON(outputs)
+	}
+
+	if len(outputs) == 0 {
+		fmt.Println("区块内没有交易")
+		return nil
+	}
+	for i, out := range outputs {
+		if i > 0 {
+			fmt.Println()
+		}
+		printReceipt(out)
+	}
+	return nil
+}
+
+// parseBlockRef 解析区块号、区块哈希或latest
+func parseBlockRef(value string) (rpc.BlockNumberOrHash, error) {
+	if value == "latest" {
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil
+	}
+	if strings.HasPrefix(value, "0x") && len(value) == 66 {
+		hash, err := parseHash(value)
+		if err != nil {
+			return rpc.BlockNumberOrHash{}, err
+		}
+		return rpc.BlockNumberOrHashWithHash(hash, false), nil
+	}
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 0 {
+		return rpc.BlockNumberOrHash{}, fmt.Errorf("无效的区块: %s", value)
+	}
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number)), nil
+}
+
+// newReceiptOutput 转换收据为输出格式
+func newReceiptOutput(receipt *types.Receipt) receiptOutput {
+	out := receiptOutput{
+		TxHash:            receipt.TxHash.Hex(),
+		Status:            receipt.Status,
+		BlockNumber:       bigString(receipt.BlockNumber),
+		BlockHash:         receipt.BlockHash.Hex(),
+		TransactionIndex:  receipt.TransactionIndex,
+		GasUsed:           receipt.GasUsed,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		EffectiveGasPrice: bigString(receipt.EffectiveGasPrice),
+		Logs:              make([]logOutput, 0, len(receipt.Logs)),
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		out.ContractAddress = receipt.ContractAddress.Hex()
+	}
+	for _, log := range receipt.Logs {
+		topics := make([]string, 0, len(log.Topics))
+		for _, topic := range log.Topics {
+			topics = append(topics, topic.Hex())
+		}
+		out.Logs = append(out.Logs, logOutput{
+			Index:   log.Index,
+			Address: log.Address.Hex(),
+			Topics:  topics,
+			Data:    hexutil.Encode(log.Data),
+		})
+	}
+	return out
+}
+
+// decodeReceipt 补充交易的发送方、Gas上限，按ABI解码调用数据和日志，失败的交易重放诊断原因
+func (s *session) decodeReceipt(ctx context.Context, out *receiptOutput, registry *abidecode.Registry, signer types.Signer, tx *types.Transaction, receipt *types.Receipt) {
+	if from, err := types.Sender(signer, tx); err == nil {
+		out.From = from.Hex()
+	}
+	if tx.To() != nil {
+		out.To = tx.To().Hex()
+	}
+	out.GasLimit = tx.Gas()
+	out.Call, _ = registry.DecodeCall(tx.To(), tx.Data())
+	for i, log := range receipt.Logs {
+		out.Logs[i].Event, _ = registry.DecodeLog(log)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		out.Failure = s.diagnose(ctx, registry, signer, tx, receipt)
+	}
+}
+
+// printReceipt 以文本格式输出收据
+func printReceipt(out receiptOutput) {
+	status := "成功"
+	if out.Status != types.ReceiptStatusSuccessful {
+		status = "失败"
+	}
+	fmt.Printf("交易哈希:   %s\n", out.TxHash)
+	fmt.Printf("状态:       %s (%d)\n", status, out.Status)
+	if out.Failure != nil {
+		fmt.Printf("失败原因:   %s\n", out.Failure.Reason)
+		if out.Failure.Error != "" {
+			fmt.Printf("诊断出错:   %s\n", out.Failure.Error)
+		}
+	}
+	if out.From != "" {
+		fmt.Printf("发送方:     %s\n", out.From)
+	}
+	if out.To != "" {
+		fmt.Printf("接收方:     %s\n", out.To)
+	}
+	if out.Call != nil {
+		fmt.Printf("调用:       %s [%s]\n", out.Call, out.Call.Contract)
+	}
+	fmt.Printf("区块:       %s (%s)\n", out.BlockNumber, out.BlockHash)
+	fmt.Printf("交易序号:   %d\n", out.TransactionIndex)
+	if out.GasLimit > 0 {
+		fmt.Printf("Gas消耗:    %d / %d (%.1f%%)\n", out.GasUsed, out.GasLimit, float64(out.GasUsed)*100/float64(out.GasLimit))
+	} else {
+		fmt.Printf("Gas消耗:    %d\n", out.GasUsed)
+	}
+	if out.EffectiveGasPrice != "" {
+		price, _ := new(big.Int).SetString(out.EffectiveGasPrice, 10)
+		fmt.Printf("实际Gas价格: %s\n", formatGwei(price))
+	}
+	if out.ContractAddress != "" {
+		fmt.Printf("合约地址:   %s\n", out.ContractAddress)
+	}
+	fmt.Printf("日志数:     %d\n", len(out.Logs))
+	for _, log := range out.Logs {
+		if log.Event != nil {
+			fmt.Printf("  [%d] %s %s [%s]\n", log.Index, log.Address, log.Event, log.Event.Contract)
+			continue
+		}
+		fmt.Printf("  [%d] %s\n", log.Index, log.Address)
+		for i, topic := range log.Topics {
+			fmt.Printf("      topic%d: %s\n", i, topic)
+		}
+		if log.Data != "0x" {
+			fmt.Printf("      data:   %s\n", log.Data)
+		}
+	}
+}
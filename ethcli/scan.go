@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/test/client/internal/scanner"
+)
+
+// runScan 并发扫描区块区间内的交易，按条件过滤后逐行输出，支持从进度文件继续
+func runScan(args []string) error {
+	fs, opts := newFlagSet("scan")
+	start := fs.Int64("start", -1, "起始区块（包含）")
+	end := fs.Int64("end", -1, "结束区块（包含），默认最新区块")
+	from := fs.String("from", "", "发送方地址，多个用逗号分隔")
+	to := fs.String("to", "", "接收方地址，多个用逗号分隔")
+	contract := fs.String("contract", "", "合约地址，调用、创建了该合约或产生了该合约日志的交易，多个用逗号分隔")
+	selector := fs.String("selector", "", "函数选择器（0xa9059cbb）或签名（transfer(address,uint256)），多个用逗号分隔")
+	format := fs.String("format", scanner.FormatJSONL, "输出格式 jsonl|csv")
+	out := fs.String("out", "", "输出文件，默认标准输出")
+	checkpoint := fs.String("checkpoint", "", "进度文件，存在时从上次的位置继续，并把结果追加到 --out")
+	workers := fs.Int("workers", 4, "并发数")
+	batchSize := fs.Int("batch", 10, "每个批量请求包含的区块数")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *checkpoint != "" && *out == "" {
+		return errors.New("使用 --checkpoint 时必须指定 --out")
+	}
+
+	filter := &scanner.Filter{}
+	var err error
+	if filter.From, err = parseAddressList(*from); err != nil {
+		return err
+	}
+	if filter.To, err = parseAddressList(*to); err != nil {
+		return err
+	}
+	if filter.Contracts, err = parseAddressList(*contract); err != nil {
+		return err
+	}
+	for _, item := range splitList(*selector) {
+		sel, err := scanner.ParseSelector(item)
+		if err != nil {
+			return err
+		}
+		filter.Selectors = append(filter.Selectors, sel)
+	}
+
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	cp, resumed, err := s.scanRange(*checkpoint, *start, *end)
+	if err != nil {
+		return err
+	}
+	if cp.Next > cp.End {
+		fmt.Fprintf(os.Stderr, "区块 %d 之前已全部扫描完成\n", cp.End+1)
+		return nil
+	}
+
+	var w io.Writer = os.Stdout
+	var file *os.File
+	header := true
+	if *out != "" {
+		if file, err = openScanOutput(*out, cp, resumed); err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+		header = !resumed
+	}
+	writer, err := scanner.NewWriter(*format, w, header)
+	if err != nil {
+		return err
+	}
+
+	// Ctrl+C 时停止扫描，已输出的区块保存在进度文件中
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	sc := scanner.New(s.rpc, filter)
+	sc.Workers = *workers
+	sc.BatchSize = *batchSize
+	sc.Timeout = max(opts.timeout, time.Minute)
+
+	fmt.Fprintf(os.Stderr, "扫描区块 %d-%d\n", cp.Next, cp.End)
+	var matched int
+	next := cp.Next
+	// save 输出写入文件后把区块进度和文件位置一起保存，继续时截断掉之后写了一半的结果
+	save := func() error {
+		if err := writer.Flush(); err != nil {
+			return fmt.Errorf("写入结果失败: %w", err)
+		}
+		if *checkpoint == "" {
+			return nil
+		}
+		offset, err := file.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return fmt.Errorf("获取输出文件位置失败: %w", err)
+		}
+		cp.Next, cp.Offset = next, &offset
+		return cp.Save(*checkpoint)
+	}
+	lastSaved := time.Now()
+	err = sc.Scan(ctx, cp.Next, cp.End, func(block scanner.Block) error {
+		for _, m := range block.Matches {
+			if err := writer.Write(m); err != nil {
+				return fmt.Errorf("写入结果失败: %w", err)
+			}
+		}
+		matched += len(block.Matches)
+		next = block.Number + 1
+		// 进度最多每秒保存一次，最后一个区块总是保存
+		if *checkpoint == "" || (time.Since(lastSaved) < time.Second && next <= cp.End) {
+			return nil
+		}
+		lastSaved = time.Now()
+		return save()
+	})
+	if saveErr := save(); saveErr != nil {
+		err = errors.Join(err, saveErr)
+	}
+	if err != nil {
+		if *checkpoint != "" {
+			fmt.Fprintf(os.Stderr, "扫描中断，下一个区块 %d，重新执行相同的命令继续\n", cp.Next)
+		}
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "扫描完成，匹配 %d 笔交易\n", matched)
+	return nil
+}
+
+// openScanOutput 打开输出文件，继续扫描时截断到进度文件记录的位置，丢弃上次中断时没有计入进度的结果
+func openScanOutput(path string, cp *scanner.Checkpoint, resumed bool) (*os.File, error) {
+	if !resumed {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("打开输出文件失败: %w", err)
+		}
+		return file, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("打开输出文件失败: %w", err)
+	}
+	if err := seekScanOutput(file, cp); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// seekScanOutput 把输出文件定位到继续写入的位置，旧版进度文件没有记录位置时追加到末尾
+func seekScanOutput(file *os.File, cp *scanner.Checkpoint) error {
+	if cp.Offset == nil {
+		if _, err := file.Seek(0, io.SeekEnd); err != nil {
+			return fmt.Errorf("定位输出文件失败: %w", err)
+		}
+		return nil
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("读取输出文件失败: %w", err)
+	}
+	offset := *cp.Offset
+	if info.Size() < offset {
+		return fmt.Errorf("输出文件 %s 只有 %d 字节，小于进度文件记录的 %d 字节，可能已被修改", file.Name(), info.Size(), offset)
+	}
+	if err := file.Truncate(offset); err != nil {
+		return fmt.Errorf("截断输出文件失败: %w", err)
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("定位输出文件失败: %w", err)
+	}
+	return nil
+}
+
+// scanRange 确定扫描区间，进度文件存在时从记录的位置继续，此时resumed为true
+func (s *session) scanRange(path string, start, end int64) (cp *scanner.Checkpoint, resumed bool, err error) {
+	if path != "" {
+		if cp, err = scanner.LoadCheckpoint(path); err != nil {
+			return nil, false, err
+		}
+		if cp != nil && cp.ChainID != s.chainID.Uint64() {
+			return nil, false, fmt.Errorf("进度文件属于链 %d，当前连接的是链 %s", cp.ChainID, s.chainID)
+		}
+	}
+	if cp != nil {
+		// 区间以进度文件为准，命令行指定了不同的区间时报错，避免以为扫描的是新区间
+		if end >= 0 && uint64(end) != cp.End {
+			return nil, false, fmt.Errorf("--end %d 与进度文件的结束区块 %d 不一致，扫描新区间请使用新的进度文件", end, cp.End)
+		}
+		if start >= 0 && cp.Start != nil && uint64(start) != *cp.Start {
+			return nil, false, fmt.Errorf("--start %d 与进度文件的起始区块 %d 不一致，扫描新区间请使用新的进度文件", start, *cp.Start)
+		}
+		fmt.Fprintf(os.Stderr, "从进度文件继续: 扫描到区块 %d，下一个区块 %d\n", cp.End, cp.Next)
+		return cp, true, nil
+	}
+
+	if start < 0 {
+		return nil, false, errors.New("必须指定 --start")
+	}
+	if end < 0 {
+		ctx, cancel := s.context()
+		defer cancel()
+		latest, err := s.client.BlockNumber(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("获取最新区块号失败: %w", err)
+		}
+		end = int64(latest)
+	}
+	if start > end {
+		return nil, false, fmt.Errorf("起始区块 %d 大于结束区块 %d", start, end)
+	}
+	first := uint64(start)
+	return &scanner.Checkpoint{ChainID: s.chainID.Uint64(), Start: &first, Next: first, End: uint64(end)}, false, nil
+}
+
+// parseAddressList 解析逗号分隔的地址
+func parseAddressList(value string) ([]common.Address, error) {
+	var addresses []common.Address
+	for _, item := range splitList(value) {
+		address, err := parseAddress(item)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
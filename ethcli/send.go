@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/test/client/internal/signer"
+	"github.com/test/client/internal/txbuilder"
+	"github.com/test/client/internal/txtracker"
+)
+
+// sentOutput 已发送交易的结果，等待上链时附带收据
+type sentOutput struct {
+	Hash     string         `json:"hash"`
+	From     string         `json:"from"`
+	To       string         `json:"to"`
+	Nonce    uint64         `json:"nonce"`
+	Value    string         `json:"value,omitempty"`
+	Fees     *feesOutput    `json:"fees,omitempty"`
+	Explorer string         `json:"explorer,omitempty"`
+	State    string         `json:"state,omitempty"`
+	Receipt  *receiptOutput `json:"receipt,omitempty"`
+}
+
+// runSend 签名并发送ETH转账
+func runSend(args []string) error {
+	fs, opts := newFlagSet("send")
+	to := fs.String("to", "", "接收地址")
+	value := fs.String("value", "0", "转账金额（ETH）")
+	data := fs.String("data", "", "附带的调用数据（0x开头）")
+	gasLimit := fs.Uint64("gas-limit", 0, "Gas上限，默认自动估算")
+	flags := addSendFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *to == "" {
+		return errors.New("必须指定 --to")
+	}
+	toAddress, err := parseAddress(*to)
+	if err != nil {
+		return err
+	}
+	amount, err := parseEther(*value)
+	if err != nil {
+		return err
+	}
+	var payload []byte
+	if *data != "" {
+		if payload, err = hexutil.Decode(*data); err != nil {
+			return fmt.Errorf("无效的调用数据: %w", err)
+		}
+	}
+
+	speed, err := txbuilder.ParseSpeed(*flags.speed)
+	if err != nil {
+		return err
+	}
+
+	sg, err := signer.Open(*flags.signer)
+	if err != nil {
+		return err
+	}
+	from := sg.Address()
+
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	reservation, err := s.nonces.Reserve(ctx, from)
+	if err != nil {
+		return err
+	}
+	nonce := reservation.Nonce
+	tx, fees, err := txbuilder.NewBuilder(s.client).Build(ctx, txbuilder.Request{
+		From:  from,
+		To:    &toAddress,
+		Value: amount,
+		Data:  payload,
+		Gas:   *gasLimit,
+		Nonce: &nonce,
+		Speed: speed,
+	})
+	if err != nil {
+		reservation.Done(err)
+		return err
+	}
+	signed, err := sg.SignTx(tx, s.chainID)
+	if err == nil {
+		err = s.client.SendTransaction(ctx, signed)
+		if err != nil {
+			err = fmt.Errorf("发送交易失败: %w", err)
+		}
+	}
+	s.reservationDone(ctx, reservation, from, err)
+	if err != nil {
+		return err
+	}
+
+	out := sentOutput{
+		Hash:     signed.Hash().Hex(),
+		From:     from.Hex(),
+		To:       toAddress.Hex(),
+		Nonce:    signed.Nonce(),
+		Value:    amount.String(),
+		Fees:     newFeesOutput(fees),
+		Explorer: s.txURL(signed.Hash()),
+	}
+	return s.finishSent(opts, sg, out, signed, flags)
+}
+
+// finishSent 记录交易到状态文件，按需等待交易上链并输出结果
+func (s *session) finishSent(opts *options, sg signer.Signer, out sentOutput, tx *types.Transaction, flags *sendFlags) error {
+	return s.finishSentAll(opts, sg, []sentOutput{out}, []*types.Transaction{tx}, flags)
+}
+
+// finishSentAll 记录多笔交易到状态文件，按需等待上链并输出结果，JSON格式下多笔交易输出为数组
+func (s *session) finishSentAll(opts *options, sg signer.Signer, outs []sentOutput, txs []*types.Transaction, flags *sendFlags) error {
+	tracker, err := s.newTracker(sg, flags)
+	if err != nil {
+		return err
+	}
+	records := make([]*txtracker.Record, len(txs))
+	for i, tx := range txs {
+		if records[i], err = tracker.Track(s.chainID, tx); err != nil {
+			return err
+		}
+	}
+
+	if !opts.json {
+		for _, out := range outs {
+			fmt.Printf("交易已发送: %s (nonce %d)\n", out.Hash, out.Nonce)
+			if out.Explorer != "" {
+				fmt.Printf("浏览器:     %s\n", out.Explorer)
+			}
+		}
+	}
+
+	var errs []error
+	if *flags.wait {
+		for i, rec := range records {
+			receipt, err := s.watchRecord(tracker, rec, opts, *flags.waitTimeout)
+			outs[i].State = string(rec.State)
+			if receipt != nil {
+				result := newReceiptOutput(receipt)
+				outs[i].Receipt = &result
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if opts.json {
+		var err error
+		if len(outs) == 1 {
+			err = printJSON(outs[0])
+		} else {
+			err = printJSON(outs)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return errors.Join(errs...)
+}
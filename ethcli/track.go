@@ -0,0 +1,466 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/test/client/internal/signer"
+	"github.com/test/client/internal/txbuilder"
+	"github.com/test/client/internal/txtracker"
+)
+
+// recordOutput 交易跟踪记录
+type recordOutput struct {
+	Hash          string           `json:"hash"`
+	From          string           `json:"from"`
+	Nonce         uint64           `json:"nonce"`
+	State         string           `json:"state"`
+	MinedHash     string           `json:"mined_hash,omitempty"`
+	BlockNumber   uint64           `json:"block_number,omitempty"`
+	Status        *uint64          `json:"status,omitempty"`
+	Confirmations uint64           `json:"confirmations"`
+	Versions      []versionOutput  `json:"versions"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+	Receipt       *receiptOutput   `json:"receipt,omitempty"`
+	Fees          *feesOutput      `json:"fees,omitempty"`
+	Replacement   *replacedVersion `json:"replacement,omitempty"`
+}
+
+// versionOutput 同一nonce下发送过的交易
+type versionOutput struct {
+	Kind     string    `json:"kind"`
+	Hash     string    `json:"hash"`
+	SentAt   time.Time `json:"sent_at"`
+	Explorer string    `json:"explorer,omitempty"`
+}
+
+// replacedVersion speedup、cancel刚发送的替换交易
+type replacedVersion struct {
+	Kind string `json:"kind"`
+	Hash string `json:"hash"`
+}
+
+// newTrackFlagSet 创建track子命令的参数解析器
+func newTrackFlagSet(name string) (*flag.FlagSet, *options, *string, *sendFlags) {
+	fs, opts := newFlagSet(name)
+	hash := fs.String("hash", "", "交易哈希，原交易或任意一笔替换交易均可")
+	return fs, opts, hash, addSendFlags(fs)
+}
+
+// runTrack 交易跟踪命令
+func runTrack(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: track list|watch|speedup|cancel|prune [参数]")
+	}
+
+	switch args[0] {
+	case "list":
+		return runTrackList(args[1:])
+	case "watch":
+		return runTrackWatch(args[1:])
+	case "speedup":
+		return runTrackReplace(args[1:], txtracker.KindSpeedUp)
+	case "cancel":
+		return runTrackReplace(args[1:], txtracker.KindCancel)
+	case "prune":
+		return runTrackPrune(args[1:])
+	default:
+		return fmt.Errorf("未知的track子命令: %s", args[0])
+	}
+}
+
+// openStore 打开 --tx-state 指定的状态文件
+func openStore(flags *sendFlags) (*txtracker.Store, error) {
+	path := *flags.txState
+	if path == "" {
+		var err error
+		if path, err = txtracker.DefaultStorePath(); err != nil {
+			return nil, err
+		}
+	}
+	return txtracker.NewStore(path), nil
+}
+
+// newTracker 按发送参数创建交易跟踪器，sg不为nil时支持自动加速
+func (s *session) newTracker(sg signer.Signer, flags *sendFlags) (*txtracker.Tracker, error) {
+	store, err := openStore(flags)
+	if err != nil {
+		return nil, err
+	}
+	speed, err := txbuilder.ParseSpeed(*flags.speed)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := txtracker.NewTracker(s.client, store)
+	tracker.Confirmations = *flags.confirmations
+	tracker.SpeedUpAfter = *flags.speedUpAfter
+	tracker.MaxSpeedUps = *flags.maxSpeedUps
+	// 被丢弃交易的nonce交还给nonce管理器，同一会话中的下一笔交易复用它
+	tracker.Dropped = func(rec *txtracker.Record) {
+		s.nonces.Dropped(rec.From, rec.Nonce)
+	}
+	if sg != nil {
+		tracker.SpeedUp = func(ctx context.Context, rec *txtracker.Record) (*types.Transaction, error) {
+			tx, _, err := s.sendReplacement(ctx, sg, rec, txtracker.KindSpeedUp, speed)
+			return tx, err
+		}
+	}
+	return tracker, nil
+}
+
+// sendReplacement 按档位重新估算手续费，签名并发送同nonce的加速或取消交易
+func (s *session) sendReplacement(ctx context.Context, sg signer.Signer, rec *txtracker.Record, kind txtracker.Kind, speed txbuilder.Speed) (*types.Transaction, *txbuilder.Fees, error) {
+	if sg.Address() != rec.From {
+		return nil, nil, fmt.Errorf("签名器地址 %s 与交易发送方 %s 不一致", sg.Address().Hex(), rec.From.Hex())
+	}
+	fees, err := txbuilder.EstimateFees(ctx, s.client, speed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latest := rec.Latest().Tx
+	var tx *types.Transaction
+	if kind == txtracker.KindCancel {
+		tx = txtracker.Cancel(rec.From, latest, fees)
+	} else {
+		tx = txtracker.SpeedUp(latest, fees)
+	}
+	signed, err := sg.SignTx(tx, s.chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.client.SendTransaction(ctx, signed); err != nil {
+		return nil, nil, fmt.Errorf("发送替换交易失败: %w", err)
+	}
+	return signed, fees, nil
+}
+
+// watchRecord 跟踪交易直到确认、被替换、被丢弃或超时，打包后返回收据
+func (s *session) watchRecord(tracker *txtracker.Tracker, rec *txtracker.Record, opts *options, timeout time.Duration) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var onChange func(*txtracker.Record)
+	if !opts.json {
+		onChange = func(rec *txtracker.Record) {
+			fmt.Printf("%s %s\n", rec.Hash().Hex(), describeRecord(rec, tracker.Confirmations))
+		}
+	}
+	if err := tracker.Watch(ctx, rec, onChange); err != nil {
+		return nil, err
+	}
+
+	switch rec.State {
+	case txtracker.StateDropped:
+		return nil, fmt.Errorf("交易 %s 已从交易池中消失，可以用 track speedup 重新发送或 track cancel 取消", rec.Hash().Hex())
+	case txtracker.StateReplaced:
+		return nil, fmt.Errorf("交易 %s 的nonce %d 已被其他交易使用", rec.Hash().Hex(), rec.Nonce)
+	}
+
+	receiptCtx, receiptCancel := s.context()
+	defer receiptCancel()
+	receipt, err := s.client.TransactionReceipt(receiptCtx, *rec.MinedHash)
+	if err != nil {
+		return nil, fmt.Errorf("获取交易收据失败: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, s.failureError(rec.Mined().Tx, receipt)
+	}
+	return receipt, nil
+}
+
+// describeRecord 状态的文字说明
+func describeRecord(rec *txtracker.Record, confirmations uint64) string {
+	var text string
+	switch rec.State {
+	case txtracker.StatePending:
+		text = "等待打包"
+		if latest := rec.Latest(); latest.Kind != txtracker.KindOriginal {
+			text = fmt.Sprintf("等待打包，已发送%s交易 %s", kindName(latest.Kind), latest.Tx.Hash().Hex())
+		}
+	case txtracker.StateMined:
+		text = fmt.Sprintf("已打包进区块 %d，确认数 %d/%d", rec.BlockNumber, rec.Confirmations, confirmations)
+	case txtracker.StateConfirmed:
+		text = fmt.Sprintf("已确认，区块 %d，确认数 %d", rec.BlockNumber, rec.Confirmations)
+	case txtracker.StateDropped:
+		text = "已从交易池中消失"
+	case txtracker.StateReplaced:
+		text = "nonce已被其他交易使用"
+	default:
+		text = string(rec.State)
+	}
+	if mined := rec.Mined(); mined != nil && mined.Kind != txtracker.KindOriginal {
+		text += fmt.Sprintf("（打包的是%s交易 %s）", kindName(mined.Kind), mined.Tx.Hash().Hex())
+	}
+	return text
+}
+
+// kindName 交易类型的中文名称
+func kindName(kind txtracker.Kind) string {
+	switch kind {
+	case txtracker.KindSpeedUp:
+		return "加速"
+	case txtracker.KindCancel:
+		return "取消"
+	default:
+		return "原"
+	}
+}
+
+// newRecordOutput 转换跟踪记录为输出格式
+func (s *session) newRecordOutput(rec *txtracker.Record) recordOutput {
+	out := recordOutput{
+		Hash:          rec.Hash().Hex(),
+		From:          rec.From.Hex(),
+		Nonce:         rec.Nonce,
+		State:         string(rec.State),
+		BlockNumber:   rec.BlockNumber,
+		Confirmations: rec.Confirmations,
+		Versions:      make([]versionOutput, 0, len(rec.Versions)),
+		UpdatedAt:     rec.UpdatedAt,
+	}
+	if rec.MinedHash != nil {
+		out.MinedHash = rec.MinedHash.Hex()
+		status := rec.Status
+		out.Status = &status
+	}
+	for _, v := range rec.Versions {
+		out.Versions = append(out.Versions, versionOutput{
+			Kind:     string(v.Kind),
+			Hash:     v.Tx.Hash().Hex(),
+			SentAt:   v.SentAt,
+			Explorer: s.txURL(v.Tx.Hash()),
+		})
+	}
+	return out
+}
+
+// loadRecord 连接节点后从状态文件中查找 --hash 对应的记录
+func loadRecord(opts *options, hash string, flags *sendFlags) (*session, *txtracker.Record, error) {
+	if hash == "" {
+		return nil, nil, errors.New("必须指定 --hash")
+	}
+	txHash, err := parseHash(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	store, err := openStore(flags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s, err := connect(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	rec, err := store.Find(s.chainID.Uint64(), txHash)
+	if err != nil {
+		s.Close()
+		return nil, nil, err
+	}
+	return s, rec, nil
+}
+
+// runTrackList 刷新并列出当前链上跟踪的交易
+func runTrackList(args []string) error {
+	fs, opts := newFlagSet("track list")
+	flags := addSendFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	store, err := openStore(flags)
+	if err != nil {
+		return err
+	}
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	tracker, err := s.newTracker(nil, flags)
+	if err != nil {
+		return err
+	}
+	records, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	outputs := make([]recordOutput, 0, len(records))
+	for _, rec := range records {
+		if rec.ChainID != s.chainID.Uint64() {
+			continue
+		}
+		if !rec.State.Done() {
+			if err := tracker.Check(ctx, rec); err != nil {
+				return err
+			}
+		}
+		outputs = append(outputs, s.newRecordOutput(rec))
+	}
+
+	if opts.json {
+		return printJSON(outputs)
+	}
+	if len(outputs) == 0 {
+		fmt.Println("没有跟踪中的交易")
+		return nil
+	}
+	for _, out := range outputs {
+		fmt.Printf("%s  nonce %-5d %-10s 确认数 %-4d 版本数 %d\n", out.Hash, out.Nonce, out.State, out.Confirmations, len(out.Versions))
+	}
+	return nil
+}
+
+// runTrackWatch 继续跟踪之前发送的交易，--speed-up-after 大于0时需要签名器
+func runTrackWatch(args []string) error {
+	fs, opts, hash, flags := newTrackFlagSet("track watch")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var sg signer.Signer
+	if *flags.speedUpAfter > 0 {
+		var err error
+		if sg, err = signer.Open(*flags.signer); err != nil {
+			return err
+		}
+	}
+
+	s, rec, err := loadRecord(opts, *hash, flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	tracker, err := s.newTracker(sg, flags)
+	if err != nil {
+		return err
+	}
+	receipt, err := s.watchRecord(tracker, rec, opts, *flags.waitTimeout)
+
+	if opts.json {
+		out := s.newRecordOutput(rec)
+		if receipt != nil {
+			result := newReceiptOutput(receipt)
+			out.Receipt = &result
+		}
+		if printErr := printJSON(out); printErr != nil {
+			return printErr
+		}
+	}
+	return err
+}
+
+// runTrackReplace 加速或取消还没有打包的交易，新交易使用相同的nonce
+func runTrackReplace(args []string, kind txtracker.Kind) error {
+	fs, opts, hash, flags := newTrackFlagSet("track " + string(kind))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	speed, err := txbuilder.ParseSpeed(*flags.speed)
+	if err != nil {
+		return err
+	}
+
+	sg, err := signer.Open(*flags.signer)
+	if err != nil {
+		return err
+	}
+
+	s, rec, err := loadRecord(opts, *hash, flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	tracker, err := s.newTracker(sg, flags)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	// 先刷新状态，已经打包的交易不能再替换
+	if err := tracker.Check(ctx, rec); err != nil {
+		return err
+	}
+	if rec.State != txtracker.StatePending && rec.State != txtracker.StateDropped {
+		return fmt.Errorf("交易当前状态为 %s，只能替换还没有打包的交易", rec.State)
+	}
+
+	tx, fees, err := s.sendReplacement(ctx, sg, rec, kind, speed)
+	if err != nil {
+		return err
+	}
+	if err := tracker.Replace(rec, kind, tx); err != nil {
+		return err
+	}
+
+	if !opts.json {
+		fmt.Printf("%s交易已发送: %s (nonce %d)\n", kindName(kind), tx.Hash().Hex(), tx.Nonce())
+		if url := s.txURL(tx.Hash()); url != "" {
+			fmt.Printf("浏览器:     %s\n", url)
+		}
+	}
+
+	var receipt *types.Receipt
+	if *flags.wait {
+		receipt, err = s.watchRecord(tracker, rec, opts, *flags.waitTimeout)
+	}
+
+	if opts.json {
+		out := s.newRecordOutput(rec)
+		out.Fees = newFeesOutput(fees)
+		out.Replacement = &replacedVersion{Kind: string(kind), Hash: tx.Hash().Hex()}
+		if receipt != nil {
+			result := newReceiptOutput(receipt)
+			out.Receipt = &result
+		}
+		if printErr := printJSON(out); printErr != nil {
+			return printErr
+		}
+	}
+	return err
+}
+
+// runTrackPrune 删除当前链上已确认或已被替换的记录
+func runTrackPrune(args []string) error {
+	fs, opts := newFlagSet("track prune")
+	flags := addSendFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	chain, err := findChain(opts.chain)
+	if err != nil {
+		return err
+	}
+	store, err := openStore(flags)
+	if err != nil {
+		return err
+	}
+
+	removed, err := store.Remove(func(rec *txtracker.Record) bool {
+		return rec.ChainID == uint64(chain.ChainID) && rec.State.Done()
+	})
+	if err != nil {
+		return err
+	}
+	if opts.json {
+		return printJSON(map[string]int{"removed": removed})
+	}
+	fmt.Printf("已删除 %d 条记录\n", removed)
+	return nil
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/test/client/internal/abidecode"
+)
+
+// txOutput 交易查询结果，各类型交易特有的字段只在对应类型中输出
+type txOutput struct {
+	Hash      string          `json:"hash"`
+	Type      uint8           `json:"type"`
+	TypeName  string          `json:"type_name"`
+	Pending   bool            `json:"pending"`
+	From      string          `json:"from"`
+	To        string          `json:"to,omitempty"`
+	Nonce     uint64          `json:"nonce"`
+	Value     string          `json:"value"`
+	Gas       uint64          `json:"gas"`
+	GasPrice  string          `json:"gas_price,omitempty"`
+	GasTipCap string          `json:"max_priority_fee_per_gas,omitempty"`
+	GasFeeCap string          `json:"max_fee_per_gas,omitempty"`
+	ChainID   string          `json:"chain_id,omitempty"`
+	Data      string          `json:"data"`
+	Call      *abidecode.Call `json:"call,omitempty"`
+
+	// EIP-2930及之后的类型
+	AccessList types.AccessList `json:"access_list,omitempty"`
+	// EIP-4844 blob交易
+	BlobGasFeeCap string   `json:"max_fee_per_blob_gas,omitempty"`
+	BlobHashes    []string `json:"blob_versioned_hashes,omitempty"`
+	// EIP-7702 设置代码交易
+	Authorizations []authorizationOutput `json:"authorization_list,omitempty"`
+
+	V string `json:"v"`
+	R string `json:"r"`
+	S string `json:"s"`
+
+	// 已打包的交易，来自区块和收据
+	BlockNumber       string  `json:"block_number,omitempty"`
+	BlockHash         string  `json:"block_hash,omitempty"`
+	TransactionIndex  *uint   `json:"transaction_index,omitempty"`
+	Status            *uint64 `json:"status,omitempty"`
+	GasUsed           uint64  `json:"gas_used,omitempty"`
+	EffectiveGasPrice string  `json:"effective_gas_price,omitempty"`
+	BlobGasUsed       uint64  `json:"blob_gas_used,omitempty"`
+	BlobGasPrice      string  `json:"blob_gas_price,omitempty"`
+}
+
+// authorizationOutput EIP-7702授权，Authority为从签名中恢复的授权账户
+type authorizationOutput struct {
+	ChainID   string `json:"chain_id"`
+	Address   string `json:"address"`
+	Nonce     uint64 `json:"nonce"`
+	Authority string `json:"authority,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// txTypeNames 交易类型名称
+var txTypeNames = map[uint8]string{
+	types.LegacyTxType:     "legacy",
+	types.AccessListTxType: "access-list (EIP-2930)",
+	types.DynamicFeeTxType: "dynamic-fee (EIP-1559)",
+	types.BlobTxType:       "blob (EIP-4844)",
+	types.SetCodeTxType:    "set-code (EIP-7702)",
+}
+
+// runTx 按哈希查询交易，按所在区块的分叉规则恢复发送方地址
+func runTx(args []string) error {
+	fs, opts := newFlagSet("tx")
+	hash := fs.String("hash", "", "交易哈希")
+	abiFiles := addABIFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *hash == "" {
+		return errors.New("必须指定 --hash")
+	}
+	txHash, err := parseHash(*hash)
+	if err != nil {
+		return err
+	}
+
+	s, err := connect(opts)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	registry, err := s.registry(*abiFiles)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	tx, pending, err := s.client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return fmt.Errorf("获取交易失败: %w", err)
+	}
+
+	var receipt *types.Receipt
+	var header *types.Header
+	if !pending {
+		if receipt, err = s.client.TransactionReceipt(ctx, txHash); err != nil {
+			return fmt.Errorf("获取交易收据失败: %w", err)
+		}
+		if header, err = s.client.HeaderByHash(ctx, receipt.BlockHash); err != nil {
+			return fmt.Errorf("获取区块头失败: %w", err)
+		}
+	}
+	from, err := types.Sender(s.signerAt(header), tx)
+	if err != nil {
+		return fmt.Errorf("恢复交易发送方失败: %w", err)
+	}
+
+	out := newTxOutput(tx, pending)
+	out.From = from.Hex()
+	out.Call, _ = registry.DecodeCall(tx.To(), tx.Data())
+	if receipt != nil {
+		out.BlockNumber = bigString(receipt.BlockNumber)
+		out.BlockHash = receipt.BlockHash.Hex()
+		out.TransactionIndex = &receipt.TransactionIndex
+		out.Status = &receipt.Status
+		out.GasUsed = receipt.GasUsed
+		out.EffectiveGasPrice = bigString(receipt.EffectiveGasPrice)
+		out.BlobGasUsed = receipt.BlobGasUsed
+		out.BlobGasPrice = bigString(receipt.BlobGasPrice)
+	}
+
+	if opts.json {
+		return printJSON(out)
+	}
+	printTx(out, tx, receipt)
+	if url := s.txURL(tx.Hash()); url != "" {
+		fmt.Printf("浏览器:     %s\n", url)
+	}
+	return nil
+}
+
+// newTxOutput 转换交易为输出格式，不包含发送方和收据中的字段
+func newTxOutput(tx *types.Transaction, pending bool) txOutput {
+	out := txOutput{
+		Hash:     tx.Hash().Hex(),
+		Type:     tx.Type(),
+		TypeName: txTypeNames[tx.Type()],
+		Pending:  pending,
+		Nonce:    tx.Nonce(),
+		Value:    tx.Value().String(),
+		Gas:      tx.Gas(),
+		ChainID:  bigString(tx.ChainId()),
+		Data:     hexutil.Encode(tx.Data()),
+	}
+	if out.TypeName == "" {
+		out.TypeName = "unknown"
+	}
+	if tx.To() != nil {
+		out.To = tx.To().Hex()
+	}
+
+	switch tx.Type() {
+	case types.LegacyTxType, types.AccessListTxType:
+		out.GasPrice = bigString(tx.GasPrice())
+	default:
+		out.GasTipCap = bigString(tx.GasTipCap())
+		out.GasFeeCap = bigString(tx.GasFeeCap())
+	}
+	if tx.Type() != types.LegacyTxType {
+		out.AccessList = tx.AccessList()
+	}
+	if tx.Type() == types.BlobTxType {
+		out.BlobGasFeeCap = bigString(tx.BlobGasFeeCap())
+		for _, h := range tx.BlobHashes() {
+			out.BlobHashes = append(out.BlobHashes, h.Hex())
+		}
+	}
+	for _, auth := range tx.SetCodeAuthorizations() {
+		item := authorizationOutput{
+			ChainID: auth.ChainID.Dec(),
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+		}
+		if authority, err := auth.Authority(); err != nil {
+			item.Error = err.Error()
+		} else {
+			item.Authority = authority.Hex()
+		}
+		out.Authorizations = append(out.Authorizations, item)
+	}
+
+	v, r, sig := tx.RawSignatureValues()
+	out.V, out.R, out.S = bigString(v), hexBig(r), hexBig(sig)
+	return out
+}
+
+// hexBig 以十六进制输出签名值
+func hexBig(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+	return hexutil.EncodeBig(value)
+}
+
+// printTx 以文本格式输出交易
+func printTx(out txOutput, tx *types.Transaction, receipt *types.Receipt) {
+	fmt.Printf("交易哈希:   %s\n", out.Hash)
+	fmt.Printf("类型:       %d %s\n", out.Type, out.TypeName)
+	if out.Pending {
+		fmt.Println("状态:       待打包")
+	} else {
+		fmt.Printf("状态:       已上链，区块 %s，序号 %d\n", out.BlockNumber, *out.TransactionIndex)
+	}
+	fmt.Printf("发送方:     %s\n", out.From)
+	if out.To != "" {
+		fmt.Printf("接收方:     %s\n", out.To)
+	} else {
+		fmt.Println("接收方:     （合约创建）")
+	}
+	fmt.Printf("Nonce:      %d\n", out.Nonce)
+	fmt.Printf("金额:       %s ETH\n", formatEther(tx.Value()))
+	if receipt != nil {
+		fmt.Printf("Gas:        %d / %d\n", receipt.GasUsed, out.Gas)
+	} else {
+		fmt.Printf("Gas上限:    %d\n", out.Gas)
+	}
+	if out.GasPrice != "" {
+		fmt.Printf("Gas价格:    %s\n", formatGwei(tx.GasPrice()))
+	} else {
+		fmt.Printf("最高小费:   %s\n", formatGwei(tx.GasTipCap()))
+		fmt.Printf("最高费用:   %s\n", formatGwei(tx.GasFeeCap()))
+	}
+	if receipt != nil && receipt.EffectiveGasPrice != nil {
+		fmt.Printf("实际Gas价格: %s\n", formatGwei(receipt.EffectiveGasPrice))
+	}
+	if out.BlobGasFeeCap != "" {
+		fmt.Printf("Blob最高费用: %s\n", formatGwei(tx.BlobGasFeeCap()))
+		if receipt != nil && receipt.BlobGasPrice != nil {
+			fmt.Printf("Blob Gas:   %d，价格 %s\n", receipt.BlobGasUsed, formatGwei(receipt.BlobGasPrice))
+		}
+		for i, h := range out.BlobHashes {
+			fmt.Printf("  blob[%d]   %s\n", i, h)
+		}
+	}
+	if len(out.AccessList) > 0 {
+		fmt.Printf("访问列表:   %d 个地址\n", len(out.AccessList))
+		for _, item := range out.AccessList {
+			fmt.Printf("  %s（%d 个存储槽）\n", item.Address.Hex(), len(item.StorageKeys))
+			for _, key := range item.StorageKeys {
+				fmt.Printf("    %s\n", key.Hex())
+			}
+		}
+	}
+	if len(out.Authorizations) > 0 {
+		fmt.Printf("授权列表:   %d 项\n", len(out.Authorizations))
+		for _, auth := range out.Authorizations {
+			authority := auth.Authority
+			if authority == "" {
+				authority = "无法恢复: " + auth.Error
+			}
+			fmt.Printf("  %s 委托给 %s（链 %s，nonce %d）\n", authority, auth.Address, auth.ChainID, auth.Nonce)
+		}
+	}
+	fmt.Printf("数据:       %s\n", out.Data)
+	if out.Call != nil {
+		fmt.Printf("调用:       %s [%s]\n", out.Call, out.Call.Contract)
+	}
+}
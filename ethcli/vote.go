@@ -0,0 +1,265 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/test/client/internal/signer"
+	voting "github.com/test/client/voting/vote-contract"
+)
+
+// sepoliaVoting Sepolia上部署的Voting合约
+const sepoliaVoting = "0xd4066694b589729eCEe134142EB76AF84ea34812"
+
+// candidateVotes 候选人及得票数
+type candidateVotes struct {
+	Candidate string `json:"candidate"`
+	Votes     string `json:"votes"`
+}
+
+// newVoteFlagSet 创建vote子命令的参数解析器
+func newVoteFlagSet(name string) (*flag.FlagSet, *options, *string) {
+	fs, opts := newFlagSet(name)
+	contract := fs.String("contract", "", "Voting合约地址（VOTING_ADDRESS）")
+	return fs, opts, contract
+}
+
+// runVote Voting合约命令
+func runVote(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: vote list|votes|cast|reset [参数]")
+	}
+
+	switch args[0] {
+	case "list":
+		return runVoteList(args[1:])
+	case "votes":
+		return runVoteVotes(args[1:])
+	case "cast":
+		return runVoteCast(args[1:])
+	case "reset":
+		return runVoteReset(args[1:])
+	default:
+		return fmt.Errorf("未知的vote子命令: %s", args[0])
+	}
+}
+
+// openVoting 连接节点并创建Voting合约实例
+func openVoting(opts *options, contractAddress string) (*session, *voting.Voting, error) {
+	s, err := connect(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	address, err := s.contractAddress(contractAddress, "VOTING_ADDRESS", sepoliaVoting)
+	if err != nil {
+		s.Close()
+		return nil, nil, err
+	}
+	contract, err := voting.NewVoting(address, s.client)
+	if err != nil {
+		s.Close()
+		return nil, nil, fmt.Errorf("创建合约实例失败: %w", err)
+	}
+	return s, contract, nil
+}
+
+// runVoteList 列出所有候选人及得票数
+func runVoteList(args []string) error {
+	fs, opts, address := newVoteFlagSet("vote list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, contract, err := openVoting(opts, *address)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	candidates, err := contract.GetAllCandidates(callOpts(ctx))
+	if err != nil {
+		return fmt.Errorf("获取候选人列表失败: %w", err)
+	}
+	result := make([]candidateVotes, 0, len(candidates))
+	for _, candidate := range candidates {
+		votes, err := contract.GetVotes(callOpts(ctx), candidate)
+		if err != nil {
+			return fmt.Errorf("获取候选人 %s 得票数失败: %w", candidate, err)
+		}
+		result = append(result, candidateVotes{Candidate: candidate, Votes: votes.String()})
+	}
+
+	if opts.json {
+		return printJSON(result)
+	}
+	if len(result) == 0 {
+		fmt.Println("暂无候选人")
+		return nil
+	}
+	for i, item := range result {
+		fmt.Printf("%d. %s: %s票\n", i+1, item.Candidate, item.Votes)
+	}
+	fmt.Printf("候选人总数: %d\n", len(result))
+	return nil
+}
+
+// runVoteVotes 查询单个候选人的得票数
+func runVoteVotes(args []string) error {
+	fs, opts, address := newVoteFlagSet("vote votes")
+	candidate := fs.String("candidate", "", "候选人名称")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *candidate == "" {
+		return errors.New("必须指定 --candidate")
+	}
+
+	s, contract, err := openVoting(opts, *address)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	votes, err := contract.GetVotes(callOpts(ctx), *candidate)
+	if err != nil {
+		return fmt.Errorf("获取得票数失败: %w", err)
+	}
+
+	if opts.json {
+		return printJSON(candidateVotes{Candidate: *candidate, Votes: votes.String()})
+	}
+	fmt.Printf("%s: %s票\n", *candidate, votes)
+	return nil
+}
+
+// runVoteCast 给候选人投票，多个候选人用逗号分隔，各笔交易并行发送
+func runVoteCast(args []string) error {
+	fs, opts, address := newVoteFlagSet("vote cast")
+	candidate := fs.String("candidate", "", "候选人名称，多个用逗号分隔")
+	flags := addSendFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	candidates := splitList(*candidate)
+	if len(candidates) == 0 {
+		return errors.New("必须指定 --candidate")
+	}
+
+	sg, err := signer.Open(*flags.signer)
+	if err != nil {
+		return err
+	}
+
+	s, contract, err := openVoting(opts, *address)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	fees, err := s.estimateFees(ctx, flags)
+	if err != nil {
+		return err
+	}
+
+	// 每笔交易由nonce管理器分配nonce，发送失败的nonce会被后续交易复用
+	txs := make([]*types.Transaction, len(candidates))
+	errs := make([]error, len(candidates))
+	var wg sync.WaitGroup
+	for i, name := range candidates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			txs[i], errs[i] = s.transact(ctx, sg, fees, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return contract.Vote(auth, name)
+			})
+		}()
+	}
+	wg.Wait()
+
+	var outs []sentOutput
+	var sent []*types.Transaction
+	var failed []error
+	for i, tx := range txs {
+		if errs[i] != nil {
+			failed = append(failed, fmt.Errorf("给 %s 投票失败: %w", candidates[i], errs[i]))
+			continue
+		}
+		outs = append(outs, sentOutput{
+			Hash:     tx.Hash().Hex(),
+			From:     sg.Address().Hex(),
+			To:       tx.To().Hex(),
+			Nonce:    tx.Nonce(),
+			Fees:     newFeesOutput(fees),
+			Explorer: s.txURL(tx.Hash()),
+		})
+		sent = append(sent, tx)
+	}
+	if len(sent) == 0 {
+		return errors.Join(failed...)
+	}
+	if len(failed) > 0 {
+		// 失败交易的nonce可能小于已发送的交易，空缺填补前这些交易不会被打包
+		fmt.Fprintln(os.Stderr, "部分投票发送失败，已发送的交易如果迟迟没有打包，再发送一笔交易即可填补nonce空缺")
+	}
+	if err := s.finishSentAll(opts, sg, outs, sent, flags); err != nil {
+		failed = append(failed, err)
+	}
+	return errors.Join(failed...)
+}
+
+// runVoteReset 清空所有候选人的得票
+func runVoteReset(args []string) error {
+	fs, opts, address := newVoteFlagSet("vote reset")
+	flags := addSendFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	sg, err := signer.Open(*flags.signer)
+	if err != nil {
+		return err
+	}
+
+	s, contract, err := openVoting(opts, *address)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	fees, err := s.estimateFees(ctx, flags)
+	if err != nil {
+		return err
+	}
+	tx, err := s.transact(ctx, sg, fees, contract.ResetVotes)
+	if err != nil {
+		return fmt.Errorf("重置投票失败: %w", err)
+	}
+
+	out := sentOutput{
+		Hash:     tx.Hash().Hex(),
+		From:     sg.Address().Hex(),
+		To:       tx.To().Hex(),
+		Nonce:    tx.Nonce(),
+		Fees:     newFeesOutput(fees),
+		Explorer: s.txURL(tx.Hash()),
+	}
+	return s.finishSent(opts, sg, out, tx, flags)
+}
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/test/client/internal/hdwallet"
+	"github.com/test/client/internal/signer"
+)
+
+// walletOutput 账户信息，写入keystore时不输出私钥
+type walletOutput struct {
+	Path       string `json:"path,omitempty"`
+	Address    string `json:"address"`
+	PublicKey  string `json:"public_key,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+	Keystore   string `json:"keystore,omitempty"`
+}
+
+// keystoreFlags 把私钥加密保存为keystore文件的参数
+type keystoreFlags struct {
+	dir          *string
+	passwordFile *string
+	light        *bool
+}
+
+// runWallet 钱包命令
+func runWallet(args []string) error {
+	if len(args) == 0 {
+		return errors.New("用法: wallet new|mnemonic|derive|export|import|check|vanity [参数]")
+	}
+
+	switch args[0] {
+	case "new":
+		return runWalletNew(args[1:])
+	case "mnemonic":
+		return runWalletMnemonic(args[1:])
+	case "derive":
+		return runWalletDerive(args[1:])
+	case "export":
+		return runWalletExport(args[1:])
+	case "import":
+		return runWalletImport(args[1:])
+	case "check":
+		return runWalletCheck(args[1:])
+	case "vanity":
+		return runWalletVanity(args[1:])
+	default:
+		return fmt.Errorf("未知的wallet子命令: %s", args[0])
+	}
+}
+
+// addKeystoreFlags 注册保存keystore文件的参数
+func addKeystoreFlags(fs *flag.FlagSet) keystoreFlags {
+	return keystoreFlags{
+		dir:          fs.String("keystore-dir", "", "把私钥加密保存到该目录，此时不输出私钥"),
+		passwordFile: fs.String("new-password-file", "", "加密keystore的密码文件，未设置时在终端输入"),
+		light:        fs.Bool("light", false, "使用轻量scrypt参数，加解密更快但强度较低"),
+	}
+}
+
+// newWalletOutput 账户信息，指定了keystore目录时加密保存私钥，否则在结果中包含私钥
+func newWalletOutput(key *ecdsa.PrivateKey, path string, ks keystoreFlags) (walletOutput, error) {
+	out := walletOutput{
+		Path:      path,
+		Address:   crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		PublicKey: hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)),
+	}
+	if *ks.dir == "" {
+		out.PrivateKey = hexutil.Encode(crypto.FromECDSA(key))
+		return out, nil
+	}
+
+	password, err := signer.ReadNewPassword(*ks.passwordFile)
+	if err != nil {
+		return out, err
+	}
+	if out.Keystore, err = hdwallet.WriteKeystore(key, *ks.dir, password, *ks.light); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// printWallet 以文本格式输出账户
+func printWallet(out walletOutput) {
+	if out.Path != "" {
+		fmt.Printf("路径:   %s\n", out.Path)
+	}
+	fmt.Printf("地址:   %s\n", out.Address)
+	if out.PublicKey != "" {
+		fmt.Printf("公钥:   %s\n", out.PublicKey)
+	}
+	if out.PrivateKey != "" {
+		fmt.Printf("私钥:   %s\n", out.PrivateKey)
+	}
+	if out.Keystore != "" {
+		fmt.Printf("keystore: %s\n", out.Keystore)
+	}
+}
+
+// runWalletNew 随机生成一个账户，不需要连接节点
+func runWalletNew(args []string) error {
+	fs, opts := newFlagSet("wallet new")
+	ks := addKeystoreFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return fmt.Errorf("生成私钥失败: %w", err)
+	}
+	out, err := newWalletOutput(key, "", ks)
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		return printJSON(out)
+	}
+	printWallet(out)
+	if out.PrivateKey != "" {
+		fmt.Println("请妥善保存私钥，不要提交到代码仓库")
+	}
+	return nil
+}
+
+// runWalletMnemonic 生成新的助记词并列出前几个账户
+func runWalletMnemonic(args []string) error {
+	fs, opts := newFlagSet("wallet mnemonic")
+	words := fs.Int("words", 12, "单词数: 12、15、18、21、24")
+	count := fs.Uint("count", 1, "列出的账户数")
+	basePath := fs.String("base-path", hdwallet.DefaultBasePath, "账户的基础派生路径，账户序号追加在末尾")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	mnemonic, err := hdwallet.NewMnemonic(*words)
+	if err != nil {
+		return err
+	}
+	result, err := deriveWallets(mnemonic, "", *basePath, 0, uint32(*count), false)
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		return printJSON(map[string]any{"mnemonic": mnemonic, "accounts": result})
+	}
+	fmt.Printf("助记词: %s\n", mnemonic)
+	for _, out := range result {
+		fmt.Printf("  %-22s %s\n", out.Path, out.Address)
+	}
+	fmt.Println("请离线抄写保存助记词，任何人拿到助记词都可以控制以上账户")
+	return nil
+}
+
+// runWalletDerive 从已有助记词派生多个账户
+func runWalletDerive(args []string) error {
+	fs, opts := newFlagSet("wallet derive")
+	cfg := addSignerFlags(fs)
+	from := fs.Uint("from", 0, "起始账户序号")
+	count := fs.Uint("count", 5, "派生的账户数")
+	basePath := fs.String("base-path", hdwallet.DefaultBasePath, "账户的基础派生路径，账户序号追加在末尾")
+	showKeys := fs.Bool("show-keys", false, "同时输出私钥")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	mnemonic, err := cfg.LoadMnemonic()
+	if err != nil {
+		return fmt.Errorf("%w: 使用 ETH_MNEMONIC 或 --mnemonic-file 提供", err)
+	}
+	var password string
+	if cfg.PasswordFile != "" {
+		if password, err = signer.ReadPassword(cfg.PasswordFile, ""); err != nil {
+			return err
+		}
+	}
+	result, err := deriveWallets(mnemonic, password, *basePath, uint32(*from), uint32(*count), *showKeys)
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		return printJSON(result)
+	}
+	for _, out := range result {
+		fmt.Printf("%-22s %s", out.Path, out.Address)
+		if out.PrivateKey != "" {
+			fmt.Printf("  %s", out.PrivateKey)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// deriveWallets 由助记词派生账户
+func deriveWallets(mnemonic, password, basePath string, from, count uint32, withKeys bool) ([]walletOutput, error) {
+	base, err := accounts.ParseDerivationPath(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("无效的派生路径 %s: %w", basePath, err)
+	}
+	seed, err := hdwallet.NewSeed(mnemonic, password)
+	if err != nil {
+		return nil, err
+	}
+	derived, err := hdwallet.DeriveAccounts(seed, base, from, count)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]walletOutput, 0, len(derived))
+	for _, account := range derived {
+		out := walletOutput{Path: account.Path, Address: account.Address.Hex()}
+		if withKeys {
+			out.PrivateKey = hexutil.Encode(crypto.FromECDSA(account.Key))
+		}
+		result = append(result, out)
+	}
+	return result, nil
+}
+
+// runWalletExport 把签名器的私钥（环境变量、助记词或其他keystore）加密导出为keystore文件
+func runWalletExport(args []string) error {
+	fs, opts := newFlagSet("wallet export")
+	cfg := addSignerFlags(fs)
+	ks := addKeystoreFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *ks.dir == "" {
+		return errors.New("必须指定 --keystore-dir")
+	}
+
+	key, source, err := signer.LoadKey(*cfg)
+	if err != nil {
+		return err
+	}
+	out, err := newWalletOutput(key, "", ks)
+	if err != nil {
+		return err
+	}
+	out.PublicKey = ""
+
+	if opts.json {
+		return printJSON(out)
+	}
+	fmt.Printf("已导出 %s\n", source)
+	printWallet(out)
+	return nil
+}
+
+// runWalletImport 解密keystore文件，确认密码正确并显示账户
+func runWalletImport(args []string) error {
+	fs, opts := newFlagSet("wallet import")
+	path := fs.String("keystore", "", "keystore文件")
+	passwordFile := fs.String("password-file", "", "密码文件，未设置时在终端输入")
+	showKey := fs.Bool("show-key", false, "输出解密后的私钥")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *path == "" {
+		return errors.New("必须指定 --keystore")
+	}
+
+	password, err := signer.ReadPassword(*passwordFile, "keystore密码: ")
+	if err != nil {
+		return err
+	}
+	key, err := hdwallet.ReadKeystore(*path, password)
+	if err != nil {
+		return err
+	}
+
+	out := walletOutput{
+		Address:   crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		PublicKey: hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)),
+		Keystore:  *path,
+	}
+	if *showKey {
+		out.PrivateKey = hexutil.Encode(crypto.FromECDSA(key))
+	}
+
+	if opts.json {
+		return printJSON(out)
+	}
+	printWallet(out)
+	return nil
+}
+
+// runWalletCheck 校验地址的EIP-55校验和
+func runWalletCheck(args []string) error {
+	fs, opts := newFlagSet("wallet check")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("用法: wallet check <地址>")
+	}
+
+	address, checksummed, err := hdwallet.CheckAddress(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		return printJSON(map[string]any{"address": address.Hex(), "checksummed": checksummed})
+	}
+	if checksummed {
+		fmt.Printf("校验和正确: %s\n", address.Hex())
+	} else {
+		fmt.Printf("地址没有校验和（全小写或全大写），EIP-55格式: %s\n", address.Hex())
+	}
+	return nil
+}
+
+// runWalletVanity 多协程并行搜索指定前缀或后缀的地址
+func runWalletVanity(args []string) error {
+	fs, opts := newFlagSet("wallet vanity")
+	prefix := fs.String("prefix", "", "地址前缀（不含0x）")
+	suffix := fs.String("suffix", "", "地址后缀")
+	caseSensitive := fs.Bool("case-sensitive", false, "按EIP-55校验和格式区分大小写")
+	workers := fs.Int("workers", runtime.NumCPU(), "并行协程数")
+	ks := addKeystoreFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	pattern := hdwallet.VanityPattern{
+		Prefix:        strings.TrimPrefix(*prefix, "0x"),
+		Suffix:        *suffix,
+		CaseSensitive: *caseSensitive,
+	}
+	if err := pattern.Validate(); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "搜索中，预计平均尝试 %.0f 次，%d 个协程，Ctrl+C 停止\n", pattern.Difficulty(), *workers)
+	var attempts atomic.Uint64
+	started := time.Now()
+	done := make(chan struct{})
+	go reportVanityProgress(&attempts, started, done)
+
+	key, err := hdwallet.SearchVanity(ctx, pattern, *workers, &attempts)
+	close(done)
+	if err != nil {
+		return err
+	}
+	elapsed := time.Since(started)
+	fmt.Fprintf(os.Stderr, "找到地址，共尝试 %d 次，用时 %s\n", attempts.Load(), elapsed.Round(time.Millisecond))
+
+	out, err := newWalletOutput(key, "", ks)
+	if err != nil {
+		return err
+	}
+	if opts.json {
+		return printJSON(out)
+	}
+	printWallet(out)
+	return nil
+}
+
+// reportVanityProgress 每5秒输出一次搜索进度
+func reportVanityProgress(attempts *atomic.Uint64, started time.Time, done <-chan struct{}) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			n := attempts.Load()
+			rate := float64(n) / time.Since(started).Seconds()
+			fmt.Fprintf(os.Stderr, "已尝试 %d 次，%.0f 次/秒\n", n, rate)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package abidecode
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrUnknown 没有能解码该数据的ABI
+var ErrUnknown = errors.New("没有匹配的ABI")
+
+// Arg 解码出的参数，Value已转换为便于输出的类型：大整数、地址、字节为字符串，数组为切片，结构体为map
+type Arg struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+	Value   any    `json:"value"`
+}
+
+// Call 解码出的函数调用
+type Call struct {
+	Contract  string `json:"contract"`
+	Method    string `json:"method"`
+	Signature string `json:"signature"`
+	Selector  string `json:"selector"`
+	Args      []Arg  `json:"args"`
+}
+
+// Event 解码出的事件日志
+type Event struct {
+	Contract  string `json:"contract"`
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+	Args      []Arg  `json:"args"`
+}
+
+// String 函数调用的可读形式，例如 vote(candidate="Alice")
+func (c *Call) String() string {
+	return c.Method + "(" + formatArgs(c.Args) + ")"
+}
+
+// String 事件的可读形式，例如 Transfer(from=0x..., to=0x..., value=1)
+func (e *Event) String() string {
+	return e.Name + "(" + formatArgs(e.Args) + ")"
+}
+
+// DecodeCall 解码交易的调用数据，to为nil（合约创建）或数据不足4字节时返回nil
+func (r *Registry) DecodeCall(to *common.Address, data []byte) (*Call, error) {
+	if to == nil || len(data) < 4 {
+		return nil, nil
+	}
+	for _, c := range r.candidates(to) {
+		method, err := c.abi.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		values, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+		return &Call{
+			Contract:  c.name,
+			Method:    method.Name,
+			Signature: method.Sig,
+			Selector:  hexutil.Encode(data[:4]),
+			Args:      newArgs(method.Inputs, values),
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: 函数选择器 %s", ErrUnknown, hexutil.Encode(data[:4]))
+}
+
+// DecodeLog 解码事件日志，indexed参数数量必须与topic一致，用来区分ERC20和ERC721的同名事件
+func (r *Registry) DecodeLog(log *types.Log) (*Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("%w: 匿名事件", ErrUnknown)
+	}
+	for _, c := range r.candidates(&log.Address) {
+		event, err := c.abi.EventByID(log.Topics[0])
+		if err != nil {
+			continue
+		}
+		indexed := indexedArgs(event.Inputs)
+		if len(indexed)+1 != len(log.Topics) {
+			continue
+		}
+
+		values := make(map[string]any)
+		if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+			continue
+		}
+		if err := event.Inputs.NonIndexed().UnpackIntoMap(values, log.Data); err != nil {
+			continue
+		}
+
+		args := make([]Arg, 0, len(event.Inputs))
+		for _, input := range event.Inputs {
+			args = append(args, Arg{
+				Name:    input.Name,
+				Type:    input.Type.String(),
+				Indexed: input.Indexed,
+				Value:   formatValue(values[input.Name]),
+			})
+		}
+		return &Event{Contract: c.name, Name: event.Name, Signature: event.Sig, Args: args}, nil
+	}
+	return nil, fmt.Errorf("%w: 事件 %s", ErrUnknown, log.Topics[0].Hex())
+}
+
+// indexedArgs 事件的indexed参数
+func indexedArgs(inputs abi.Arguments) abi.Arguments {
+	var indexed abi.Arguments
+	for _, input := range inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	return indexed
+}
+
+// newArgs 按ABI参数的顺序组合解码结果
+func newArgs(inputs abi.Arguments, values []any) []Arg {
+	args := make([]Arg, 0, len(inputs))
+	for i, input := range inputs {
+		var value any
+		if i < len(values) {
+			value = formatValue(values[i])
+		}
+		args = append(args, Arg{Name: input.Name, Type: input.Type.String(), Value: value})
+	}
+	return args
+}
+
+// formatArgs 参数的可读形式，没有名称的参数只输出值
+func formatArgs(args []Arg) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		value := fmt.Sprint(arg.Value)
+		if s, ok := arg.Value.(string); ok && arg.Type == "string" {
+			value = fmt.Sprintf("%q", s)
+		}
+		if arg.Name == "" {
+			parts = append(parts, value)
+		} else {
+			parts = append(parts, arg.Name+"="+value)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatValue 把abi解码出的Go值转换为便于输出和JSON序列化的值
+func formatValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case string, bool:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprint(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(rv.Uint())
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// bytes1到bytes32
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]any, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, formatValue(rv.Index(i).Interface()))
+		}
+		return items
+	case reflect.Struct:
+		// abi解码的tuple是带json标签的匿名结构体
+		fields := make(map[string]any, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			fields[name] = formatValue(rv.Field(i).Interface())
+		}
+		return fields
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return formatValue(rv.Elem().Interface())
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,125 @@
+package abidecode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/test/client/task2/counter"
+	voting "github.com/test/client/voting/vote-contract"
+)
+
+// 内置ABI的名称
+const (
+	NameCounter = "Counter"
+	NameVoting  = "Voting"
+	NameERC20   = "ERC20"
+	NameERC721  = "ERC721"
+)
+
+// contractABI 一个已注册的合约ABI
+type contractABI struct {
+	name string
+	abi  abi.ABI
+}
+
+// Registry 解码时使用的ABI集合
+// 同一个选择器或事件签名在多个ABI中出现时（例如ERC20和ERC721的transferFrom），
+// 优先使用绑定到目标地址的ABI，其次是后添加的ABI
+type Registry struct {
+	contracts []*contractABI
+	bound     map[common.Address]string
+}
+
+// NewRegistry 创建空的ABI集合
+func NewRegistry() *Registry {
+	return &Registry{bound: make(map[common.Address]string)}
+}
+
+// Builtin 包含ERC20、ERC721以及Counter、Voting合约的ABI集合
+func Builtin() (*Registry, error) {
+	r := NewRegistry()
+	for _, item := range []struct {
+		name string
+		json string
+	}{
+		{NameERC721, erc721ABI},
+		{NameERC20, erc20ABI},
+		{NameVoting, voting.VotingMetaData.ABI},
+		{NameCounter, counter.CounterMetaData.ABI},
+	} {
+		if err := r.AddJSON(item.name, []byte(item.json)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Add 添加已解析的ABI
+func (r *Registry) Add(name string, parsed abi.ABI) {
+	r.contracts = append(r.contracts, &contractABI{name: name, abi: parsed})
+}
+
+// AddJSON 添加JSON格式的ABI，支持ABI数组以及Hardhat、Foundry编译产物中的 "abi" 字段
+func (r *Registry) AddJSON(name string, data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil || len(artifact.ABI) == 0 {
+			return fmt.Errorf("%s 不是ABI数组，也没有 abi 字段", name)
+		}
+		data = artifact.ABI
+	}
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("解析 %s 的ABI失败: %w", name, err)
+	}
+	r.Add(name, parsed)
+	return nil
+}
+
+// AddFile 添加ABI文件，名称取文件名去掉扩展名
+func (r *Registry) AddFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取ABI文件失败: %w", err)
+	}
+	name := filepath.Base(path)
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	return r.AddJSON(name, data)
+}
+
+// Bind 指定地址上部署的合约，解码该地址的调用和日志时优先使用对应的ABI
+func (r *Registry) Bind(address common.Address, name string) {
+	r.bound[address] = name
+}
+
+// candidates 解码address相关数据时依次尝试的ABI
+func (r *Registry) candidates(address *common.Address) []*contractABI {
+	result := make([]*contractABI, 0, len(r.contracts))
+	var preferred string
+	if address != nil {
+		preferred = r.bound[*address]
+	}
+	if preferred != "" {
+		for _, c := range r.contracts {
+			if c.name == preferred {
+				result = append(result, c)
+			}
+		}
+	}
+	for i := len(r.contracts) - 1; i >= 0; i-- {
+		if c := r.contracts[i]; c.name != preferred {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package abidecode
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// 回滚数据的类型
+const (
+	RevertEmpty   = "empty"   // 没有回滚数据，例如 revert() 或 require 不带消息
+	RevertError   = "error"   // Error(string)
+	RevertPanic   = "panic"   // Panic(uint256)
+	RevertCustom  = "custom"  // 合约ABI中声明的自定义错误
+	RevertUnknown = "unknown" // 无法解码
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	errorArgs = abi.Arguments{{Type: mustType("string")}}
+	panicArgs = abi.Arguments{{Type: mustType("uint256")}}
+)
+
+// panicReasons Solidity的Panic错误码
+var panicReasons = map[uint64]string{
+	0x00: "通用的编译器插入的panic",
+	0x01: "assert失败",
+	0x11: "算术运算溢出",
+	0x12: "除以0或对0取模",
+	0x21: "转换为枚举时值越界",
+	0x22: "存储中的字节数组编码错误",
+	0x31: "对空数组执行pop",
+	0x32: "数组下标越界",
+	0x41: "内存分配过大",
+	0x51: "调用未初始化的函数指针",
+}
+
+// Revert 解码出的回滚原因
+type Revert struct {
+	Kind      string `json:"kind"`
+	Reason    string `json:"reason"`
+	Code      string `json:"code,omitempty"`
+	Contract  string `json:"contract,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Args      []Arg  `json:"args,omitempty"`
+	Data      string `json:"data"`
+}
+
+// String 回滚原因的可读形式
+func (r *Revert) String() string {
+	return r.Reason
+}
+
+// DecodeRevert 解码回滚数据，address不为nil时优先使用绑定到该地址的ABI解码自定义错误
+func (r *Registry) DecodeRevert(address *common.Address, data []byte) *Revert {
+	result := &Revert{Data: hexutil.Encode(data)}
+	if len(data) == 0 {
+		result.Kind = RevertEmpty
+		result.Reason = "没有回滚原因"
+		return result
+	}
+	if len(data) >= 4 {
+		switch {
+		case bytes.Equal(data[:4], errorSelector):
+			if values, err := errorArgs.Unpack(data[4:]); err == nil {
+				result.Kind = RevertError
+				result.Reason = values[0].(string)
+				return result
+			}
+		case bytes.Equal(data[:4], panicSelector):
+			if values, err := panicArgs.Unpack(data[4:]); err == nil {
+				code := values[0].(*big.Int)
+				result.Kind = RevertPanic
+				result.Code = fmt.Sprintf("0x%02x", code)
+				reason, ok := panicReasons[code.Uint64()]
+				if !code.IsUint64() || !ok {
+					reason = "未知的panic"
+				}
+				result.Reason = fmt.Sprintf("panic %s: %s", result.Code, reason)
+				return result
+			}
+		default:
+			if decoded := r.decodeCustomError(address, data); decoded != nil {
+				return decoded
+			}
+		}
+	}
+	result.Kind = RevertUnknown
+	result.Reason = "无法解码的回滚数据 " + result.Data
+	return result
+}
+
+// decodeCustomError 按已注册的ABI解码自定义错误
+func (r *Registry) decodeCustomError(address *common.Address, data []byte) *Revert {
+	var id [4]byte
+	copy(id[:], data[:4])
+	for _, c := range r.candidates(address) {
+		e, err := c.abi.ErrorByID(id)
+		if err != nil {
+			continue
+		}
+		values, err := e.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+		args := newArgs(e.Inputs, values)
+		return &Revert{
+			Kind:      RevertCustom,
+			Reason:    e.Name + "(" + formatArgs(args) + ")",
+			Contract:  c.name,
+			Name:      e.Name,
+			Signature: e.Sig,
+			Args:      args,
+			Data:      hexutil.Encode(data),
+		}
+	}
+	return nil
+}
+
+// mustType 解析内置的ABI类型
+func mustType(name string) abi.Type {
+	t, err := abi.NewType(name, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
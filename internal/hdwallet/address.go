@@ -0,0 +1,34 @@
+package hdwallet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrBadChecksum 大小写混合的地址与EIP-55校验和不符
+var ErrBadChecksum = errors.New("地址的EIP-55校验和不正确")
+
+// CheckAddress 校验地址格式和EIP-55校验和
+// 全小写或全大写的地址不带校验和，返回checksummed=false；大小写混合时必须与校验和一致
+func CheckAddress(value string) (address common.Address, checksummed bool, err error) {
+	value = strings.TrimSpace(value)
+	if !common.IsHexAddress(value) {
+		return common.Address{}, false, fmt.Errorf("无效的地址: %s", value)
+	}
+
+	address = common.HexToAddress(value)
+	digits := value
+	if len(digits) == 2*common.AddressLength+2 {
+		digits = digits[2:]
+	}
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return address, false, nil
+	}
+	if digits != address.Hex()[2:] {
+		return address, false, fmt.Errorf("%w: %s，应为 %s", ErrBadChecksum, value, address.Hex())
+	}
+	return address, true, nil
+}
@@ -0,0 +1,174 @@
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// 以太坊账户的派生路径
+const (
+	DefaultPath     = "m/44'/60'/0'/0/0" // 第一个账户
+	DefaultBasePath = "m/44'/60'/0'/0"   // 按账户序号派生时的基础路径
+)
+
+// ErrInvalidMnemonic 助记词的单词或校验和不正确
+var ErrInvalidMnemonic = errors.New("无效的助记词")
+
+// masterSecret BIP-32主密钥的HMAC密钥
+var masterSecret = []byte("Bitcoin seed")
+
+// extendedKey BIP-32扩展私钥
+type extendedKey struct {
+	key       *big.Int
+	chainCode []byte
+}
+
+// NormalizeMnemonic 去掉多余的空白，单词统一为小写并以单个空格分隔
+func NormalizeMnemonic(mnemonic string) string {
+	return strings.ToLower(strings.Join(strings.Fields(mnemonic), " "))
+}
+
+// NewSeed 由助记词和可选的BIP-39密码生成种子，校验助记词
+func NewSeed(mnemonic, password string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(NormalizeMnemonic(mnemonic), password)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+	return seed, nil
+}
+
+// Account 由助记词派生出的账户
+type Account struct {
+	Path    string
+	Address common.Address
+	Key     *ecdsa.PrivateKey
+}
+
+// DeriveKey 按BIP-32从种子派生路径上的私钥
+func DeriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	ext, err := derive(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	return ext.privateKey()
+}
+
+// DeriveAccounts 在基础路径下派生序号从start开始的count个账户，如 m/44'/60'/0'/0/i
+func DeriveAccounts(seed []byte, base accounts.DerivationPath, start, count uint32) ([]Account, error) {
+	if uint64(start)+uint64(count) > 0x80000000 {
+		return nil, fmt.Errorf("账户序号超出非强化派生范围: %d+%d", start, count)
+	}
+	parent, err := derive(seed, base)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Account, 0, count)
+	for i := start; i < start+count; i++ {
+		path := append(append(accounts.DerivationPath{}, base...), i)
+		ext, err := parent.child(i)
+		if err != nil {
+			return nil, fmt.Errorf("派生 %s 失败: %w", path, err)
+		}
+		key, err := ext.privateKey()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, Account{Path: path.String(), Address: crypto.PubkeyToAddress(key.PublicKey), Key: key})
+	}
+	return result, nil
+}
+
+// KeyFromMnemonic 由助记词派生路径上的私钥，路径为空时使用默认路径
+func KeyFromMnemonic(mnemonic, password, path string) (*ecdsa.PrivateKey, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("无效的派生路径 %s: %w", path, err)
+	}
+	seed, err := NewSeed(mnemonic, password)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveKey(seed, derivationPath)
+}
+
+// derive 从种子派生路径上的扩展私钥
+func derive(seed []byte, path accounts.DerivationPath) (*extendedKey, error) {
+	ext, err := newMaster(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range path {
+		if ext, err = ext.child(index); err != nil {
+			return nil, fmt.Errorf("派生 %s 失败: %w", path, err)
+		}
+	}
+	return ext, nil
+}
+
+// newMaster 由种子生成主扩展私钥
+func newMaster(seed []byte) (*extendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("种子长度必须在16到64字节之间: %d", len(seed))
+	}
+	sum := hmacSHA512(masterSecret, seed)
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, errors.New("种子生成的主密钥无效")
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+// child 派生子私钥，index不小于2^31时为强化派生
+func (k *extendedKey) child(index uint32) (*extendedKey, error) {
+	var data []byte
+	if index >= 0x80000000 {
+		data = append([]byte{0}, k.key.FillBytes(make([]byte, 32))...)
+	} else {
+		priv, err := k.privateKey()
+		if err != nil {
+			return nil, err
+		}
+		data = crypto.CompressPubkey(&priv.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	sum := hmacSHA512(k.chainCode, data)
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(n) >= 0 {
+		return nil, fmt.Errorf("索引 %d 派生出无效密钥", index)
+	}
+	key := tweak.Add(tweak, k.key)
+	key.Mod(key, n)
+	if key.Sign() == 0 {
+		return nil, fmt.Errorf("索引 %d 派生出无效密钥", index)
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+// privateKey 扩展私钥对应的ECDSA私钥
+func (k *extendedKey) privateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(k.key.FillBytes(make([]byte, 32)))
+}
+
+// hmacSHA512 计算HMAC-SHA512
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,234 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const abandonMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestMnemonicFromEntropy(t *testing.T) {
+	mnemonic, err := MnemonicFromEntropy(make([]byte, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mnemonic != abandonMnemonic {
+		t.Errorf("全零熵的助记词 = %q", mnemonic)
+	}
+
+	// BIP-39测试向量使用密码TREZOR
+	seed, err := NewSeed(abandonMnemonic, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"
+	if got := hex.EncodeToString(seed); got != want {
+		t.Errorf("种子 = %s，期望 %s", got, want)
+	}
+}
+
+func TestKeyFromMnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		path     string
+		address  string
+		err      error
+	}{
+		{name: "默认路径", mnemonic: abandonMnemonic, address: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+		{name: "第二个账户", mnemonic: abandonMnemonic, path: "m/44'/60'/0'/0/1", address: "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"},
+		{name: "大小写和空白", mnemonic: "  ABANDON abandon abandon abandon abandon abandon\tabandon abandon abandon abandon abandon About ", address: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+		{name: "校验和错误", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", err: ErrInvalidMnemonic},
+		{name: "未知单词", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abou", err: ErrInvalidMnemonic},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := KeyFromMnemonic(tt.mnemonic, "", tt.path)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("错误 = %v，期望 %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := crypto.PubkeyToAddress(key.PublicKey).Hex(); got != tt.address {
+				t.Errorf("地址 = %s，期望 %s", got, tt.address)
+			}
+		})
+	}
+
+	if _, err := KeyFromMnemonic(abandonMnemonic, "", "m/44'/60'/x"); err == nil {
+		t.Error("无效的派生路径没有返回错误")
+	}
+}
+
+func TestDeriveAccounts(t *testing.T) {
+	seed, err := NewSeed(abandonMnemonic, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := accounts.ParseDerivationPath(DefaultBasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	derived, err := DeriveAccounts(seed, base, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ path, address string }{
+		{"m/44'/60'/0'/0/0", "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+		{"m/44'/60'/0'/0/1", "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"},
+	}
+	if len(derived) != len(want) {
+		t.Fatalf("派生了 %d 个账户", len(derived))
+	}
+	for i, w := range want {
+		if derived[i].Path != w.path || derived[i].Address.Hex() != w.address {
+			t.Errorf("账户 %d = %s %s，期望 %s %s", i, derived[i].Path, derived[i].Address.Hex(), w.path, w.address)
+		}
+		if crypto.PubkeyToAddress(derived[i].Key.PublicKey) != derived[i].Address {
+			t.Errorf("账户 %d 的私钥与地址不对应", i)
+		}
+	}
+
+	// 从中间序号开始与单独派生的结果一致
+	second, err := DeriveAccounts(seed, base, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second[0].Address != derived[1].Address {
+		t.Errorf("从序号1开始派生的地址 = %s", second[0].Address.Hex())
+	}
+
+	if _, err := DeriveAccounts(seed, base, 0x7fffffff, 2); err == nil {
+		t.Error("超出非强化派生范围没有返回错误")
+	}
+}
+
+// BIP-32 测试向量1
+func TestDeriveBIP32Vector1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const hardened = 0x80000000
+	tests := []struct {
+		name      string
+		path      accounts.DerivationPath
+		key       string
+		chainCode string
+	}{
+		{
+			name:      "m",
+			path:      accounts.DerivationPath{},
+			key:       "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+			chainCode: "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508",
+		},
+		{
+			name:      "m/0H",
+			path:      accounts.DerivationPath{hardened},
+			key:       "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+			chainCode: "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141",
+		},
+		{
+			name:      "m/0H/1",
+			path:      accounts.DerivationPath{hardened, 1},
+			key:       "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+			chainCode: "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19",
+		},
+		{
+			name:      "m/0H/1/2H",
+			path:      accounts.DerivationPath{hardened, 1, hardened + 2},
+			key:       "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+			chainCode: "04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f",
+		},
+		{
+			name:      "m/0H/1/2H/2",
+			path:      accounts.DerivationPath{hardened, 1, hardened + 2, 2},
+			key:       "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4",
+			chainCode: "cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd",
+		},
+		{
+			name:      "m/0H/1/2H/2/1000000000",
+			path:      accounts.DerivationPath{hardened, 1, hardened + 2, 2, 1000000000},
+			key:       "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+			chainCode: "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext, err := derive(seed, tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(ext.key.FillBytes(make([]byte, 32))); got != tt.key {
+				t.Errorf("私钥 = %s，期望 %s", got, tt.key)
+			}
+			if got := hex.EncodeToString(ext.chainCode); got != tt.chainCode {
+				t.Errorf("链码 = %s，期望 %s", got, tt.chainCode)
+			}
+
+			key, err := DeriveKey(seed, tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(crypto.FromECDSA(key)); got != tt.key {
+				t.Errorf("DeriveKey = %s，期望 %s", got, tt.key)
+			}
+		})
+	}
+}
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		address     string
+		checksummed bool
+		err         error
+		invalid     bool
+	}{
+		// EIP-55 中的示例地址
+		{name: "校验和1", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", checksummed: true},
+		{name: "校验和2", value: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", checksummed: true},
+		{name: "校验和3", value: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", address: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", checksummed: true},
+		{name: "校验和4", value: "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", address: "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", checksummed: true},
+		{name: "没有0x前缀", value: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", checksummed: true},
+		{name: "首尾空白", value: " 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", checksummed: true},
+		{name: "全小写", value: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{name: "全大写", value: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{name: "大小写错一位", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", err: ErrBadChecksum},
+		{name: "长度不对", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", invalid: true},
+		{name: "非十六进制", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", invalid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, checksummed, err := CheckAddress(tt.value)
+			switch {
+			case tt.invalid:
+				if err == nil || errors.Is(err, ErrBadChecksum) {
+					t.Fatalf("错误 = %v，期望无效地址", err)
+				}
+				return
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("错误 = %v，期望 %v", err, tt.err)
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+			if address != common.HexToAddress(tt.address) || checksummed != tt.checksummed {
+				t.Errorf("CheckAddress = %s, %v，期望 %s, %v", address.Hex(), checksummed, tt.address, tt.checksummed)
+			}
+		})
+	}
+}
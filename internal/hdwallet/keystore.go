@@ -0,0 +1,41 @@
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// WriteKeystore 用密码加密私钥，以go-ethereum的命名方式写入目录，返回文件路径
+// light为true时使用轻量scrypt参数，加解密更快但强度较低
+func WriteKeystore(key *ecdsa.PrivateKey, dir, passphrase string, light bool) (string, error) {
+	scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+	if light {
+		scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	}
+
+	account, err := keystore.NewKeyStore(dir, scryptN, scryptP).ImportECDSA(key, passphrase)
+	if errors.Is(err, keystore.ErrAccountAlreadyExists) {
+		return "", fmt.Errorf("目录 %s 中已有该账户的keystore文件", dir)
+	}
+	if err != nil {
+		return "", fmt.Errorf("写入keystore文件失败: %w", err)
+	}
+	return account.URL.Path, nil
+}
+
+// ReadKeystore 读取并用密码解密keystore文件
+func ReadKeystore(path, passphrase string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取keystore文件失败: %w", err)
+	}
+	key, err := keystore.DecryptKey(data, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("解密keystore文件失败: %w", err)
+	}
+	return key.PrivateKey, nil
+}
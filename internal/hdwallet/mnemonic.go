@@ -0,0 +1,41 @@
+package hdwallet
+
+import (
+	"fmt"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// NewMnemonic 随机生成BIP-39英文助记词，单词数为12、15、18、21或24
+func NewMnemonic(words int) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", fmt.Errorf("助记词单词数必须是12、15、18、21或24: %d", words)
+	}
+	// 每3个单词对应32位熵
+	entropy, err := bip39.NewEntropy(words / 3 * 32)
+	if err != nil {
+		return "", fmt.Errorf("生成随机熵失败: %w", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("生成助记词失败: %w", err)
+	}
+	return mnemonic, nil
+}
+
+// MnemonicFromEntropy 由熵生成助记词，用于核对BIP-39测试向量
+func MnemonicFromEntropy(entropy []byte) (string, error) {
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("生成助记词失败: %w", err)
+	}
+	return mnemonic, nil
+}
+
+// ValidateMnemonic 校验助记词的单词和校验和
+func ValidateMnemonic(mnemonic string) error {
+	if _, err := bip39.EntropyFromMnemonic(NormalizeMnemonic(mnemonic)); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+	return nil
+}
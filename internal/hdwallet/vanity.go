@@ -0,0 +1,110 @@
+package hdwallet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// VanityPattern 靓号地址的匹配条件
+type VanityPattern struct {
+	Prefix        string // 地址开头（不含0x）
+	Suffix        string // 地址结尾
+	CaseSensitive bool   // 按EIP-55校验和格式区分大小写
+}
+
+// Validate 检查匹配条件只包含十六进制字符且总长度不超过地址长度
+func (p VanityPattern) Validate() error {
+	if p.Prefix == "" && p.Suffix == "" {
+		return fmt.Errorf("前缀和后缀至少指定一个")
+	}
+	if len(p.Prefix)+len(p.Suffix) > 2*common.AddressLength {
+		return fmt.Errorf("前缀和后缀总长度不能超过%d", 2*common.AddressLength)
+	}
+	for _, part := range []string{p.Prefix, p.Suffix} {
+		if strings.Trim(strings.ToLower(part), "0123456789abcdef") != "" {
+			return fmt.Errorf("只能包含十六进制字符: %s", part)
+		}
+	}
+	return nil
+}
+
+// Match 地址是否符合条件
+func (p VanityPattern) Match(address common.Address) bool {
+	if p.CaseSensitive {
+		digits := address.Hex()[2:]
+		return strings.HasPrefix(digits, p.Prefix) && strings.HasSuffix(digits, p.Suffix)
+	}
+	digits := hex.EncodeToString(address[:])
+	return strings.HasPrefix(digits, strings.ToLower(p.Prefix)) && strings.HasSuffix(digits, strings.ToLower(p.Suffix))
+}
+
+// Difficulty 平均需要尝试的次数，区分大小写时每个字母的概率再减半
+func (p VanityPattern) Difficulty() float64 {
+	pattern := p.Prefix + p.Suffix
+	difficulty := math.Pow(16, float64(len(pattern)))
+	if p.CaseSensitive {
+		letters := 0
+		for _, c := range strings.ToLower(pattern) {
+			if c >= 'a' && c <= 'f' {
+				letters++
+			}
+		}
+		difficulty *= math.Pow(2, float64(letters))
+	}
+	return difficulty
+}
+
+// SearchVanity 用workers个协程并行随机生成私钥，直到地址符合条件或ctx结束
+// attempts累计所有协程的尝试次数，可以在搜索过程中读取以显示进度
+func SearchVanity(ctx context.Context, pattern VanityPattern, workers int, attempts *atomic.Uint64) (*ecdsa.PrivateKey, error) {
+	if err := pattern.Validate(); err != nil {
+		return nil, err
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		found *ecdsa.PrivateKey
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				key, err := crypto.GenerateKey()
+				if err != nil {
+					continue
+				}
+				attempts.Add(1)
+				if pattern.Match(crypto.PubkeyToAddress(key.PublicKey)) {
+					once.Do(func() {
+						found = key
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if found == nil {
+		return nil, fmt.Errorf("搜索靓号地址已停止: %w", context.Cause(ctx))
+	}
+	return found, nil
+}
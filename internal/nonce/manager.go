@@ -0,0 +1,196 @@
+package nonce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Source 查询账户待处理nonce的节点接口，ethclient.Client 满足该接口
+type Source interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// Manager 按账户分配nonce，可以被多个协程同时使用
+// 分配出去但没有发送成功的nonce会被回收，优先分配给下一笔交易，避免出现空洞
+type Manager struct {
+	source   Source
+	mu       sync.Mutex
+	accounts map[common.Address]*account
+}
+
+// account 单个账户的nonce状态
+type account struct {
+	mu       sync.Mutex
+	synced   bool
+	gap      bool                // 节点报告nonce过高，下次同步时回收节点待处理nonce之后没有在用的nonce
+	next     uint64              // 下一个从未分配过的nonce
+	released []uint64            // 已回收、等待重新分配的nonce，升序
+	reserved map[uint64]struct{} // 已分配、还没有确认发送结果的nonce
+}
+
+// NewManager 创建nonce管理器
+func NewManager(source Source) *Manager {
+	return &Manager{source: source, accounts: make(map[common.Address]*account)}
+}
+
+// Reservation 一次nonce分配，发送完成后必须调用Done
+type Reservation struct {
+	manager *Manager
+	account common.Address
+	Nonce   uint64
+	once    sync.Once
+}
+
+// Reserve 为账户分配一个nonce，第一次使用或标记为需要同步时先从节点同步
+func (m *Manager) Reserve(ctx context.Context, address common.Address) (*Reservation, error) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	if !acc.synced {
+		if err := m.sync(ctx, address, acc); err != nil {
+			return nil, err
+		}
+	}
+
+	var nonce uint64
+	if len(acc.released) > 0 {
+		nonce = acc.released[0]
+		acc.released = acc.released[1:]
+	} else {
+		nonce = acc.next
+		acc.next++
+	}
+	acc.reserved[nonce] = struct{}{}
+	return &Reservation{manager: m, account: address, Nonce: nonce}, nil
+}
+
+// Done 报告发送结果，重复调用无效
+// err为nil表示节点已接受交易；nonce已被占用（nonce too low、already known、replacement transaction underpriced）时下次分配前重新同步；
+// nonce过高（nonce too high）表示之前发送的交易已不在节点中，回收该nonce，下次同步时回收空缺；
+// 其他错误表示交易没有发出，nonce被回收
+func (r *Reservation) Done(err error) {
+	r.once.Do(func() {
+		acc := r.manager.account(r.account)
+		acc.mu.Lock()
+		defer acc.mu.Unlock()
+
+		delete(acc.reserved, r.Nonce)
+		switch {
+		case err == nil:
+		case IsNonceUsed(err):
+			acc.synced = false
+		case IsNonceTooHigh(err):
+			acc.release(r.Nonce)
+			acc.synced, acc.gap = false, true
+		default:
+			acc.release(r.Nonce)
+		}
+	})
+}
+
+// Resync 从节点重新同步账户的nonce
+// 节点的待处理nonce小于本地记录时不做回收：交易可能只是还没有传播到该节点，
+// 确认被丢弃的交易需要通过Dropped回收
+func (m *Manager) Resync(ctx context.Context, address common.Address) error {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	return m.sync(ctx, address, acc)
+}
+
+// Dropped 标记已发送的交易被丢弃（例如长时间没有打包后从交易池消失），其nonce被回收
+func (m *Manager) Dropped(address common.Address, nonce uint64) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	if nonce < acc.next {
+		acc.release(nonce)
+	}
+}
+
+// Pending 账户已分配但还没有报告发送结果的nonce数量
+func (m *Manager) Pending(address common.Address) int {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	return len(acc.reserved)
+}
+
+// account 获取账户状态，不存在时创建
+func (m *Manager) account(address common.Address) *account {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	acc, ok := m.accounts[address]
+	if !ok {
+		acc = &account{reserved: make(map[uint64]struct{})}
+		m.accounts[address] = acc
+	}
+	return acc
+}
+
+// sync 按节点的待处理nonce调整本地状态，调用方持有acc.mu
+func (m *Manager) sync(ctx context.Context, address common.Address, acc *account) error {
+	pending, err := m.source.PendingNonceAt(ctx, address)
+	if err != nil {
+		return fmt.Errorf("同步 %s 的nonce失败: %w", address.Hex(), err)
+	}
+
+	// 节点已经包含的nonce不能再用
+	kept := acc.released[:0]
+	for _, nonce := range acc.released {
+		if nonce >= pending {
+			kept = append(kept, nonce)
+		}
+	}
+	acc.released = kept
+
+	// 首次同步，或者有其他程序用同一账户发送了交易
+	if pending > acc.next {
+		acc.next = pending
+	}
+	// 节点拒绝过高的nonce，说明之前发送的交易已丢失，没有在用的nonce都要重新分配
+	if acc.gap {
+		for nonce := pending; nonce < acc.next; nonce++ {
+			if _, ok := acc.reserved[nonce]; !ok {
+				acc.release(nonce)
+			}
+		}
+	}
+	acc.synced, acc.gap = true, false
+	return nil
+}
+
+// release 回收nonce，调用方持有acc.mu
+func (acc *account) release(nonce uint64) {
+	i := sort.Search(len(acc.released), func(i int) bool { return acc.released[i] >= nonce })
+	if i < len(acc.released) && acc.released[i] == nonce {
+		return
+	}
+	acc.released = append(acc.released, 0)
+	copy(acc.released[i+1:], acc.released[i:])
+	acc.released[i] = nonce
+}
+
+// IsNonceUsed 发送错误是否表示该nonce已被占用
+// 节点通过RPC返回的是错误文本，这里按go-ethereum交易池的错误信息判断；
+// replacement transaction underpriced 表示交易池里已有同nonce的交易
+func IsNonceUsed(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "already known") ||
+		strings.Contains(msg, "replacement transaction underpriced")
+}
+
+// IsNonceTooHigh 发送错误是否表示nonce超过了节点的待处理nonce
+// go-ethereum的交易池会把这类交易放入队列，部分节点（如一些L2排序器）直接拒绝
+func IsNonceTooHigh(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "nonce too high")
+}
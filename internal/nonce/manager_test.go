@@ -0,0 +1,343 @@
+package nonce
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/test/client/internal/txtracker"
+)
+
+// testChain 模拟链和一个有余额的账户
+type testChain struct {
+	backend *simulated.Backend
+	client  simulated.Client
+	key     *ecdsa.PrivateKey
+	from    common.Address
+	signer  types.Signer
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	client := backend.Client()
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testChain{backend: backend, client: client, key: key, from: from, signer: types.LatestSignerForChainID(chainID)}
+}
+
+// send 用指定nonce发送一笔转账，tip决定能否替换交易池中的同nonce交易
+func (c *testChain) send(t *testing.T, nonce uint64, tip int64) error {
+	t.Helper()
+	return c.client.SendTransaction(context.Background(), c.transfer(t, nonce, tip))
+}
+
+// transfer 签名一笔转账
+func (c *testChain) transfer(t *testing.T, nonce uint64, tip int64) *types.Transaction {
+	t.Helper()
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	tx, err := types.SignNewTx(c.key, c.signer, &types.DynamicFeeTx{
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(100 * params.GWei),
+		Gas:       params.TxGas,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// waitIndexed 等待模拟链的交易索引初始化完成，之前查询收据会返回错误而不是NotFound
+// 索引在有新区块后才开始，先打包一个空区块
+func (c *testChain) waitIndexed(t *testing.T) {
+	t.Helper()
+	c.backend.Commit()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := c.client.TransactionReceipt(context.Background(), common.Hash{})
+		if errors.Is(err, ethereum.NotFound) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("等待交易索引超时: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (c *testChain) reserve(t *testing.T, m *Manager) *Reservation {
+	t.Helper()
+	r, err := m.Reserve(context.Background(), c.from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestReserveParallel(t *testing.T) {
+	chain := newTestChain(t)
+	m := NewManager(chain.client)
+
+	const n = 32
+	nonces := make([]uint64, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := m.Reserve(context.Background(), chain.from)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			nonces[i] = r.Nonce
+			r.Done(chain.send(t, r.Nonce, params.GWei))
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, nonce := range nonces {
+		if nonce != uint64(i) {
+			t.Fatalf("并行分配的nonce = %v，期望 0..%d 各一次", nonces, n-1)
+		}
+	}
+	if pending := m.Pending(chain.from); pending != 0 {
+		t.Errorf("全部报告结果后仍有 %d 个未完成的分配", pending)
+	}
+
+	chain.backend.Commit()
+	got, err := chain.client.PendingNonceAt(context.Background(), chain.from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != n {
+		t.Errorf("打包后账户nonce = %d，期望 %d", got, n)
+	}
+}
+
+func TestFailedSendReleasesNonce(t *testing.T) {
+	chain := newTestChain(t)
+	m := NewManager(chain.client)
+
+	first := chain.reserve(t, m)
+	second := chain.reserve(t, m)
+	if first.Nonce != 0 || second.Nonce != 1 {
+		t.Fatalf("分配的nonce = %d, %d", first.Nonce, second.Nonce)
+	}
+
+	// 第一笔没有发出去，第二笔正常发送
+	first.Done(errors.New("连接被重置"))
+	second.Done(chain.send(t, second.Nonce, params.GWei))
+	first.Done(nil) // 重复调用无效
+
+	retry := chain.reserve(t, m)
+	if retry.Nonce != 0 {
+		t.Fatalf("发送失败后重新分配的nonce = %d，期望 0", retry.Nonce)
+	}
+	retry.Done(chain.send(t, retry.Nonce, params.GWei))
+
+	if next := chain.reserve(t, m); next.Nonce != 2 {
+		t.Errorf("回收的nonce用完后分配 %d，期望 2", next.Nonce)
+	}
+}
+
+func TestNonceUsedResyncs(t *testing.T) {
+	chain := newTestChain(t)
+	m := NewManager(chain.client)
+
+	// 其他程序用同一账户发送了nonce 0
+	if err := chain.send(t, 0, params.GWei); err != nil {
+		t.Fatal(err)
+	}
+
+	// 首次同步时从节点的待处理nonce开始
+	r := chain.reserve(t, m)
+	if r.Nonce != 1 {
+		t.Fatalf("同步后分配的nonce = %d，期望 1", r.Nonce)
+	}
+	r.Done(chain.send(t, r.Nonce, params.GWei))
+
+	// 同价格替换交易池中的交易
+	r = chain.reserve(t, m)
+	if r.Nonce != 2 {
+		t.Fatalf("分配的nonce = %d，期望 2", r.Nonce)
+	}
+	// 其他程序抢先用了nonce 2
+	if err := chain.send(t, 2, params.GWei); err != nil {
+		t.Fatal(err)
+	}
+	err := chain.send(t, r.Nonce, params.GWei)
+	if !IsNonceUsed(err) {
+		t.Fatalf("同nonce同价格的交易返回 %v，期望判定为nonce已占用", err)
+	}
+	r.Done(err)
+
+	// nonce 2 没有被回收，重新同步后从3开始
+	if next := chain.reserve(t, m); next.Nonce != 3 {
+		t.Errorf("nonce已占用后分配 %d，期望 3", next.Nonce)
+	}
+
+	chain.backend.Commit()
+	err = chain.send(t, 0, 2*params.GWei)
+	if !IsNonceUsed(err) {
+		t.Errorf("已打包的nonce返回 %v，期望判定为nonce已占用", err)
+	}
+}
+
+func TestDroppedRecovery(t *testing.T) {
+	chain := newTestChain(t)
+	m := NewManager(chain.client)
+
+	for range 2 {
+		r := chain.reserve(t, m)
+		r.Done(chain.send(t, r.Nonce, params.GWei))
+	}
+
+	// 交易从交易池消失，节点的待处理nonce回到0
+	chain.backend.Rollback()
+	if err := m.Resync(context.Background(), chain.from); err != nil {
+		t.Fatal(err)
+	}
+
+	// 没有报告丢弃时不回收，避免与仍在传播的交易冲突
+	r := chain.reserve(t, m)
+	if r.Nonce != 2 {
+		t.Fatalf("没有报告丢弃时分配 %d，期望 2", r.Nonce)
+	}
+	r.Done(errors.New("取消发送"))
+
+	m.Dropped(chain.from, 1)
+	m.Dropped(chain.from, 0)
+	m.Dropped(chain.from, 0)  // 重复报告无效
+	m.Dropped(chain.from, 10) // 从未分配过的nonce不回收
+
+	var resent []uint64
+	for range 3 {
+		r := chain.reserve(t, m)
+		if err := chain.send(t, r.Nonce, params.GWei); err != nil {
+			t.Fatal(err)
+		}
+		r.Done(nil)
+		resent = append(resent, r.Nonce)
+	}
+	if resent[0] != 0 || resent[1] != 1 || resent[2] != 2 {
+		t.Fatalf("丢弃后重新分配的nonce = %v，期望 [0 1 2]", resent)
+	}
+	if next := chain.reserve(t, m); next.Nonce != 3 {
+		t.Errorf("回收的nonce用完后分配 %d，期望 3", next.Nonce)
+	}
+
+	chain.backend.Commit()
+	got, err := chain.client.NonceAt(context.Background(), chain.from, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 3 {
+		t.Errorf("打包后账户nonce = %d，期望 3", got)
+	}
+}
+
+func TestTrackerDroppedReleasesNonce(t *testing.T) {
+	chain := newTestChain(t)
+	chain.waitIndexed(t)
+	m := NewManager(chain.client)
+
+	r := chain.reserve(t, m)
+	tx := chain.transfer(t, r.Nonce, params.GWei)
+	r.Done(chain.client.SendTransaction(context.Background(), tx))
+
+	tracker := txtracker.NewTracker(chain.client, nil)
+	tracker.PollInterval = time.Millisecond
+	tracker.DropAfter = 0
+	tracker.Dropped = func(rec *txtracker.Record) {
+		m.Dropped(rec.From, rec.Nonce)
+	}
+	rec, err := tracker.Track(chain.signer.ChainID(), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 交易从交易池消失，跟踪器判定为丢弃后nonce交还给管理器
+	chain.backend.Rollback()
+	if err := tracker.Watch(context.Background(), rec, nil); err != nil {
+		t.Fatal(err)
+	}
+	if rec.State != txtracker.StateDropped {
+		t.Fatalf("交易状态 = %s，期望 %s", rec.State, txtracker.StateDropped)
+	}
+
+	next := chain.reserve(t, m)
+	if next.Nonce != r.Nonce {
+		t.Fatalf("丢弃后分配的nonce = %d，期望复用 %d", next.Nonce, r.Nonce)
+	}
+	if err := chain.send(t, next.Nonce, params.GWei); err != nil {
+		t.Fatal(err)
+	}
+	next.Done(nil)
+}
+
+func TestNonceTooHighReclaimsGap(t *testing.T) {
+	chain := newTestChain(t)
+	m := NewManager(chain.client)
+
+	for range 2 {
+		r := chain.reserve(t, m)
+		r.Done(chain.send(t, r.Nonce, params.GWei))
+	}
+
+	// 交易丢失后节点拒绝nonce 2（go-ethereum的交易池会排队，这里模拟拒绝的节点）
+	chain.backend.Rollback()
+	r := chain.reserve(t, m)
+	if r.Nonce != 2 {
+		t.Fatalf("分配的nonce = %d，期望 2", r.Nonce)
+	}
+	tooHigh := errors.New("nonce too high: address " + chain.from.Hex() + ", tx: 2 state: 0")
+	if !IsNonceTooHigh(tooHigh) {
+		t.Fatal("没有识别nonce too high")
+	}
+	r.Done(tooHigh)
+
+	if err := m.Resync(context.Background(), chain.from); err != nil {
+		t.Fatal(err)
+	}
+	for want := range uint64(3) {
+		r := chain.reserve(t, m)
+		if r.Nonce != want {
+			t.Fatalf("重新同步后分配的nonce = %d，期望 %d", r.Nonce, want)
+		}
+		r.Done(chain.send(t, r.Nonce, params.GWei))
+	}
+}
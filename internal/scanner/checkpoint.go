@@ -0,0 +1,54 @@
+package scanner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Checkpoint 扫描进度，Next之前的区块都已输出
+type Checkpoint struct {
+	ChainID uint64 `json:"chain_id"`
+	// Start 开始扫描时的起始区块，用于检查继续时的 --start；旧版进度文件没有该字段
+	Start *uint64 `json:"start,omitempty"`
+	Next  uint64  `json:"next"`
+	End   uint64  `json:"end"`
+	// Offset Next之前的区块在输出文件中结束的字节位置，继续扫描时截断到这里；旧版进度文件没有该字段
+	Offset    *int64    `json:"offset,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LoadCheckpoint 读取进度文件，文件不存在时返回nil
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取进度文件失败: %w", err)
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("解析进度文件 %s 失败: %w", path, err)
+	}
+	return &cp, nil
+}
+
+// Save 先写临时文件再改名，避免中途退出时进度文件损坏
+func (cp *Checkpoint) Save(path string) error {
+	cp.UpdatedAt = time.Now()
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化进度失败: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("写入进度文件失败: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("写入进度文件失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package scanner
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Filter 交易过滤条件，不同条件之间为“且”，同一条件的多个值之间为“或”，条件为空时不过滤
+type Filter struct {
+	From      []common.Address
+	To        []common.Address
+	Contracts []common.Address // 调用、创建了该合约，或者收据中有该合约的日志
+	Selectors [][4]byte        // 调用数据的前4字节
+}
+
+// Match 交易是否满足过滤条件
+func (f *Filter) Match(from common.Address, tx *types.Transaction, receipt *types.Receipt) bool {
+	if len(f.From) > 0 && !containsAddress(f.From, from) {
+		return false
+	}
+	if len(f.To) > 0 && (tx.To() == nil || !containsAddress(f.To, *tx.To())) {
+		return false
+	}
+	if len(f.Selectors) > 0 && !f.matchSelector(tx.Data()) {
+		return false
+	}
+	if len(f.Contracts) > 0 && !f.matchContract(tx, receipt) {
+		return false
+	}
+	return true
+}
+
+// matchSelector 调用数据是否以任一选择器开头
+func (f *Filter) matchSelector(data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	for _, selector := range f.Selectors {
+		if bytes.Equal(data[:4], selector[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchContract 交易是否与任一合约有关
+func (f *Filter) matchContract(tx *types.Transaction, receipt *types.Receipt) bool {
+	if tx.To() != nil && containsAddress(f.Contracts, *tx.To()) {
+		return true
+	}
+	if receipt.ContractAddress != (common.Address{}) && containsAddress(f.Contracts, receipt.ContractAddress) {
+		return true
+	}
+	for _, log := range receipt.Logs {
+		if containsAddress(f.Contracts, log.Address) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAddress 地址是否在列表中
+func containsAddress(list []common.Address, address common.Address) bool {
+	for _, item := range list {
+		if item == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseSelector 解析函数选择器，支持4字节十六进制（0xa9059cbb）或函数签名（transfer(address,uint256)）
+func ParseSelector(value string) ([4]byte, error) {
+	var selector [4]byte
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "(") {
+		copy(selector[:], crypto.Keccak256([]byte(strings.ReplaceAll(value, " ", "")))[:4])
+		return selector, nil
+	}
+	b, err := hexutil.Decode(value)
+	if err != nil || len(b) != 4 {
+		return selector, fmt.Errorf("无效的函数选择器: %s", value)
+	}
+	copy(selector[:], b)
+	return selector, nil
+}
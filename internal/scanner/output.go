@@ -0,0 +1,89 @@
+package scanner
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Writer 逐条输出匹配的交易
+type Writer interface {
+	Write(m Match) error
+	// Flush 把缓冲的数据写入底层输出，保存进度前调用
+	Flush() error
+}
+
+// 输出格式
+const (
+	FormatJSONL = "jsonl"
+	FormatCSV   = "csv"
+)
+
+// NewWriter 按格式创建输出，header为false时CSV不输出表头（续扫追加到已有文件时）
+func NewWriter(format string, w io.Writer, header bool) (Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return &jsonlWriter{encoder: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &csvWriter{writer: csv.NewWriter(w), header: header}, nil
+	default:
+		return nil, fmt.Errorf("未知的输出格式: %s（可选 jsonl、csv）", format)
+	}
+}
+
+// jsonlWriter 每行一个JSON对象
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlWriter) Write(m Match) error {
+	return w.encoder.Encode(m)
+}
+
+func (w *jsonlWriter) Flush() error {
+	return nil
+}
+
+// csvColumns CSV的列
+var csvColumns = []string{
+	"block_number", "block_hash", "timestamp", "tx_index", "tx_hash", "type",
+	"from", "to", "value", "selector", "status", "gas_used", "contract_address", "log_count",
+}
+
+// csvWriter 逗号分隔，合约创建交易的to为空
+type csvWriter struct {
+	writer *csv.Writer
+	header bool
+}
+
+func (w *csvWriter) Write(m Match) error {
+	if w.header {
+		if err := w.writer.Write(csvColumns); err != nil {
+			return err
+		}
+		w.header = false
+	}
+	return w.writer.Write([]string{
+		strconv.FormatUint(m.BlockNumber, 10),
+		m.BlockHash,
+		strconv.FormatUint(m.Timestamp, 10),
+		strconv.FormatUint(uint64(m.TxIndex), 10),
+		m.TxHash,
+		strconv.FormatUint(uint64(m.Type), 10),
+		m.From,
+		m.To,
+		m.Value,
+		m.Selector,
+		strconv.FormatUint(m.Status, 10),
+		strconv.FormatUint(m.GasUsed, 10),
+		m.ContractAddress,
+		strconv.Itoa(m.LogCount),
+	})
+}
+
+func (w *csvWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
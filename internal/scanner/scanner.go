@@ -0,0 +1,247 @@
+package scanner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Backend 批量调用JSON-RPC的接口，rpc.Client 满足该接口
+type Backend interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Match 满足过滤条件的交易
+type Match struct {
+	BlockNumber     uint64 `json:"block_number"`
+	BlockHash       string `json:"block_hash"`
+	Timestamp       uint64 `json:"timestamp"`
+	TxIndex         uint   `json:"tx_index"`
+	TxHash          string `json:"tx_hash"`
+	Type            uint8  `json:"type"`
+	From            string `json:"from"`
+	To              string `json:"to,omitempty"` // 合约创建时为空
+	Value           string `json:"value"`        // wei，十进制
+	Selector        string `json:"selector,omitempty"`
+	Status          uint64 `json:"status"`
+	GasUsed         uint64 `json:"gas_used"`
+	ContractAddress string `json:"contract_address,omitempty"`
+	LogCount        int    `json:"log_count"`
+}
+
+// Block 一个区块的扫描结果
+type Block struct {
+	Number  uint64
+	Matches []Match
+}
+
+// Scanner 用多个协程按批次并发获取区块和收据，按区块号顺序交给调用方处理
+type Scanner struct {
+	backend Backend
+	filter  *Filter
+
+	Workers   int           // 并发的协程数
+	BatchSize int           // 每个JSON-RPC批量请求包含的区块数
+	Timeout   time.Duration // 每个批量请求的超时
+}
+
+// New 创建扫描器，默认4个协程、每批10个区块、每批超时60秒
+func New(backend Backend, filter *Filter) *Scanner {
+	if filter == nil {
+		filter = &Filter{}
+	}
+	return &Scanner{backend: backend, filter: filter, Workers: 4, BatchSize: 10, Timeout: time.Minute}
+}
+
+// batch 一批连续的区块
+type batch struct {
+	seq    int
+	start  uint64
+	end    uint64 // 包含
+	blocks []Block
+	err    error
+}
+
+// Scan 扫描 [start, end] 区间的区块，按区块号从小到大对每个区块调用handle，handle返回错误时停止
+// 即使某个区块没有匹配的交易也会调用handle，调用方可以据此保存进度
+func (s *Scanner) Scan(ctx context.Context, start, end uint64, handle func(Block) error) error {
+	if start > end {
+		return fmt.Errorf("起始区块 %d 大于结束区块 %d", start, end)
+	}
+	workers, size := max(s.Workers, 1), uint64(max(s.BatchSize, 1))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan batch)
+	results := make(chan batch)
+
+	go func() {
+		defer close(jobs)
+		seq := 0
+		for from := start; from <= end; from += size {
+			to := min(from+size-1, end)
+			select {
+			case jobs <- batch{seq: seq, start: from, end: to}:
+			case <-ctx.Done():
+				return
+			}
+			seq++
+			if to == end {
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job.blocks, job.err = s.fetch(ctx, job.start, job.end)
+				select {
+				case results <- job:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// 批次完成的顺序不固定，先缓存，按顺序交给handle
+	pending := make(map[int]batch)
+	next := 0
+	for result := range results {
+		if result.err != nil {
+			return result.err
+		}
+		pending[result.seq] = result
+		for {
+			b, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			for _, block := range b.blocks {
+				if err := handle(block); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// rpcBlock eth_getBlockByNumber 返回结果中用到的字段
+type rpcBlock struct {
+	Number       hexutil.Uint64    `json:"number"`
+	Hash         common.Hash       `json:"hash"`
+	Timestamp    hexutil.Uint64    `json:"timestamp"`
+	Transactions []json.RawMessage `json:"transactions"`
+}
+
+// rpcSender 交易对象中节点给出的发送方
+type rpcSender struct {
+	From common.Address `json:"from"`
+}
+
+// fetch 用一个批量请求获取 [start, end] 区间每个区块的完整交易和收据
+func (s *Scanner) fetch(ctx context.Context, start, end uint64) ([]Block, error) {
+	count := int(end - start + 1)
+	blocks := make([]*rpcBlock, count)
+	receipts := make([][]*types.Receipt, count)
+	elems := make([]rpc.BatchElem, 0, 2*count)
+	for i := range count {
+		number := hexutil.EncodeUint64(start + uint64(i))
+		elems = append(elems,
+			rpc.BatchElem{Method: "eth_getBlockByNumber", Args: []any{number, true}, Result: &blocks[i]},
+			rpc.BatchElem{Method: "eth_getBlockReceipts", Args: []any{number}, Result: &receipts[i]},
+		)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	if err := s.backend.BatchCallContext(callCtx, elems); err != nil {
+		return nil, fmt.Errorf("批量获取区块 %d-%d 失败: %w", start, end, err)
+	}
+	for _, elem := range elems {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("%s %v 失败: %w", elem.Method, elem.Args[0], elem.Error)
+		}
+	}
+
+	result := make([]Block, 0, count)
+	for i := range count {
+		number := start + uint64(i)
+		if blocks[i] == nil {
+			return nil, fmt.Errorf("区块 %d 不存在", number)
+		}
+		block, err := s.match(blocks[i], receipts[i])
+		if err != nil {
+			return nil, fmt.Errorf("处理区块 %d 失败: %w", number, err)
+		}
+		result = append(result, block)
+	}
+	return result, nil
+}
+
+// match 按过滤条件筛选区块内的交易
+func (s *Scanner) match(block *rpcBlock, receipts []*types.Receipt) (Block, error) {
+	if len(block.Transactions) != len(receipts) {
+		return Block{}, fmt.Errorf("区块内有 %d 笔交易，但返回了 %d 个收据", len(block.Transactions), len(receipts))
+	}
+
+	result := Block{Number: uint64(block.Number)}
+	for i, raw := range block.Transactions {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalJSON(raw); err != nil {
+			return Block{}, fmt.Errorf("解析第 %d 笔交易失败: %w", i, err)
+		}
+		var sender rpcSender
+		if err := json.Unmarshal(raw, &sender); err != nil {
+			return Block{}, fmt.Errorf("解析第 %d 笔交易的发送方失败: %w", i, err)
+		}
+		receipt := receipts[i]
+		if !s.filter.Match(sender.From, tx, receipt) {
+			continue
+		}
+
+		m := Match{
+			BlockNumber: uint64(block.Number),
+			BlockHash:   block.Hash.Hex(),
+			Timestamp:   uint64(block.Timestamp),
+			TxIndex:     receipt.TransactionIndex,
+			TxHash:      tx.Hash().Hex(),
+			Type:        tx.Type(),
+			From:        sender.From.Hex(),
+			Value:       tx.Value().String(),
+			Status:      receipt.Status,
+			GasUsed:     receipt.GasUsed,
+			LogCount:    len(receipt.Logs),
+		}
+		if tx.To() != nil {
+			m.To = tx.To().Hex()
+		}
+		if len(tx.Data()) >= 4 {
+			m.Selector = hexutil.Encode(tx.Data()[:4])
+		}
+		if receipt.ContractAddress != (common.Address{}) {
+			m.ContractAddress = receipt.ContractAddress.Hex()
+		}
+		result.Matches = append(result.Matches, m)
+	}
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/test/client/internal/hdwallet"
+)
+
+// 签名器类型
+const (
+	SourceEnv      = "env"      // 环境变量中的十六进制私钥
+	SourceKeystore = "keystore" // 加密keystore文件
+	SourceMnemonic = "mnemonic" // BIP-39助记词
+)
+
+// DefaultKeyEnv 默认存放私钥的环境变量
+const DefaultKeyEnv = "ETH_PRIVATE_KEY"
+
+// Config 签名器配置
+type Config struct {
+	Source       string // env、keystore、mnemonic，为空时按已配置的项自动选择
+	KeyEnv       string // 私钥所在的环境变量
+	Keystore     string // keystore文件路径
+	PasswordFile string // keystore密码或BIP-39密码文件，未设置时从终端读取
+	Mnemonic     string // 助记词
+	MnemonicFile string // 助记词文件，Mnemonic为空时读取
+	HDPath       string // 助记词派生路径
+}
+
+// source 实际使用的签名器类型
+func (c *Config) source() string {
+	if c.Source != "" {
+		return c.Source
+	}
+	switch {
+	case c.Keystore != "":
+		return SourceKeystore
+	case c.Mnemonic != "" || c.MnemonicFile != "":
+		return SourceMnemonic
+	default:
+		return SourceEnv
+	}
+}
+
+// Open 按配置创建签名器
+func Open(cfg Config) (Signer, error) {
+	key, source, err := LoadKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySigner(key, source), nil
+}
+
+// LoadKey 按配置读取私钥，同时返回私钥来源的描述，用于导出keystore等需要私钥本身的场景
+func LoadKey(cfg Config) (*ecdsa.PrivateKey, string, error) {
+	switch cfg.source() {
+	case SourceEnv:
+		name := cfg.KeyEnv
+		if name == "" {
+			name = DefaultKeyEnv
+		}
+		key, err := keyFromEnv(name)
+		return key, "env:" + name, err
+
+	case SourceKeystore:
+		if cfg.Keystore == "" {
+			return nil, "", errors.New("未指定keystore文件")
+		}
+		passphrase, err := ReadPassword(cfg.PasswordFile, "keystore密码: ")
+		if err != nil {
+			return nil, "", err
+		}
+		key, err := hdwallet.ReadKeystore(cfg.Keystore, passphrase)
+		return key, "keystore:" + cfg.Keystore, err
+
+	case SourceMnemonic:
+		mnemonic, err := cfg.LoadMnemonic()
+		if err != nil {
+			return nil, "", err
+		}
+		// 助记词的BIP-39密码是可选的，只从文件读取，不在终端询问
+		var password string
+		if cfg.PasswordFile != "" {
+			if password, err = ReadPassword(cfg.PasswordFile, ""); err != nil {
+				return nil, "", err
+			}
+		}
+		path := cfg.HDPath
+		if path == "" {
+			path = hdwallet.DefaultPath
+		}
+		key, err := hdwallet.KeyFromMnemonic(mnemonic, password, path)
+		return key, "mnemonic:" + path, err
+
+	default:
+		return nil, "", fmt.Errorf("未知的签名器类型: %s", cfg.Source)
+	}
+}
+
+// LoadMnemonic 配置的助记词，Mnemonic为空时读取MnemonicFile
+func (c *Config) LoadMnemonic() (string, error) {
+	mnemonic := c.Mnemonic
+	if mnemonic == "" && c.MnemonicFile != "" {
+		data, err := os.ReadFile(c.MnemonicFile)
+		if err != nil {
+			return "", fmt.Errorf("读取助记词文件失败: %w", err)
+		}
+		mnemonic = string(data)
+	}
+	if strings.TrimSpace(mnemonic) == "" {
+		return "", errors.New("未配置助记词")
+	}
+	return mnemonic, nil
+}
@@ -0,0 +1,56 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ReadPassword 从文件读取密码（去掉末尾换行），未指定文件时在终端不回显地输入
+func ReadPassword(file, prompt string) (string, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("读取密码文件失败: %w", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("标准输入不是终端，请用密码文件提供密码")
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("读取密码失败: %w", err)
+	}
+	return string(password), nil
+}
+
+// ReadNewPassword 读取用于加密的新密码，在终端输入时需要输入两次确认
+func ReadNewPassword(file string) (string, error) {
+	if file != "" {
+		return ReadPassword(file, "")
+	}
+
+	password, err := ReadPassword("", "设置密码: ")
+	if err != nil {
+		return "", err
+	}
+	if password == "" {
+		return "", errors.New("密码不能为空")
+	}
+	confirm, err := ReadPassword("", "再次输入密码: ")
+	if err != nil {
+		return "", err
+	}
+	if password != confirm {
+		return "", errors.New("两次输入的密码不一致")
+	}
+	return password, nil
+}
@@ -0,0 +1,83 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer 交易签名器，私钥的来源由具体实现决定
+type Signer interface {
+	// Address 签名账户地址
+	Address() common.Address
+	// SignTx 按链ID签名交易
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// String 私钥来源的描述，不包含私钥本身
+	String() string
+}
+
+// keySigner 持有解密后私钥的签名器
+type keySigner struct {
+	key    *ecdsa.PrivateKey
+	source string
+}
+
+// NewKeySigner 用内存中的私钥创建签名器
+func NewKeySigner(key *ecdsa.PrivateKey, source string) Signer {
+	return &keySigner{key: key, source: source}
+}
+
+// Address 签名账户地址
+func (s *keySigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+// SignTx 按链ID签名交易，支持所有交易类型
+func (s *keySigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+	if err != nil {
+		return nil, fmt.Errorf("签名交易失败: %w", err)
+	}
+	return signed, nil
+}
+
+// String 私钥来源的描述
+func (s *keySigner) String() string {
+	return s.source
+}
+
+// keyFromEnv 解析环境变量中的十六进制私钥
+func keyFromEnv(name string) (*ecdsa.PrivateKey, error) {
+	hexKey := strings.TrimPrefix(strings.TrimSpace(os.Getenv(name)), "0x")
+	if hexKey == "" {
+		return nil, fmt.Errorf("环境变量 %s 未设置", name)
+	}
+	key, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("解析环境变量 %s 中的私钥失败: %w", name, err)
+	}
+	return key, nil
+}
+
+// TransactOpts 用签名器创建合约绑定的交易参数，nonce和手续费由bind或调用方填充
+func TransactOpts(ctx context.Context, s Signer, chainID *big.Int) *bind.TransactOpts {
+	from := s.Address()
+	return &bind.TransactOpts{
+		From:    from,
+		Context: ctx,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.SignTx(tx, chainID)
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package signer
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/test/client/internal/hdwallet"
+)
+
+const (
+	abandonMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testKey         = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadKey(t *testing.T) {
+	key, err := crypto.HexToECDSA(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyAddress := crypto.PubkeyToAddress(key.PublicKey)
+
+	t.Setenv("SIGNER_TEST_KEY", "0x"+testKey)
+	t.Setenv(DefaultKeyEnv, testKey)
+	keystorePath, err := hdwallet.WriteKeystore(key, t.TempDir(), "secret", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	passwordFile := writeFile(t, "password", "secret\n")
+	mnemonicFile := writeFile(t, "mnemonic", abandonMnemonic+"\n")
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		address common.Address
+		source  string
+	}{
+		{name: "默认环境变量", cfg: Config{}, address: keyAddress, source: "env:" + DefaultKeyEnv},
+		{name: "指定环境变量", cfg: Config{KeyEnv: "SIGNER_TEST_KEY"}, address: keyAddress, source: "env:SIGNER_TEST_KEY"},
+		{name: "keystore", cfg: Config{Keystore: keystorePath, PasswordFile: passwordFile}, address: keyAddress, source: "keystore:" + keystorePath},
+		{name: "助记词", cfg: Config{Mnemonic: abandonMnemonic}, address: common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"), source: "mnemonic:" + hdwallet.DefaultPath},
+		{name: "助记词文件和路径", cfg: Config{MnemonicFile: mnemonicFile, HDPath: "m/44'/60'/0'/0/1"}, address: common.HexToAddress("0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"), source: "mnemonic:m/44'/60'/0'/0/1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, source, err := LoadKey(tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := crypto.PubkeyToAddress(key.PublicKey); got != tt.address {
+				t.Errorf("地址 = %s，期望 %s", got.Hex(), tt.address.Hex())
+			}
+			if source != tt.source {
+				t.Errorf("来源 = %s，期望 %s", source, tt.source)
+			}
+		})
+	}
+}
+
+func TestLoadKeyErrors(t *testing.T) {
+	t.Setenv("SIGNER_TEST_EMPTY", "")
+	t.Setenv("SIGNER_TEST_INVALID", "0x1234")
+	key, err := crypto.HexToECDSA(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keystorePath, err := hdwallet.WriteKeystore(key, t.TempDir(), "secret", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{name: "环境变量未设置", cfg: Config{KeyEnv: "SIGNER_TEST_EMPTY"}},
+		{name: "私钥格式错误", cfg: Config{KeyEnv: "SIGNER_TEST_INVALID"}},
+		{name: "未指定keystore", cfg: Config{Source: SourceKeystore}},
+		{name: "keystore密码错误", cfg: Config{Keystore: keystorePath, PasswordFile: writeFile(t, "password", "wrong")}},
+		{name: "未配置助记词", cfg: Config{Source: SourceMnemonic}},
+		{name: "未知类型", cfg: Config{Source: "ledger"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := LoadKey(tt.cfg); err == nil {
+				t.Error("没有返回错误")
+			}
+		})
+	}
+}
+
+func TestOpenTransactOpts(t *testing.T) {
+	t.Setenv("SIGNER_TEST_KEY", testKey)
+	s, err := Open(Config{KeyEnv: "SIGNER_TEST_KEY"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.String() != "env:SIGNER_TEST_KEY" {
+		t.Errorf("来源 = %s", s.String())
+	}
+
+	chainID := big.NewInt(11155111)
+	opts := TransactOpts(t.Context(), s, chainID)
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 1, Gas: 21000})
+	signed, err := opts.Signer(s.Address(), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != s.Address() {
+		t.Errorf("签名地址 = %s，期望 %s", from.Hex(), s.Address().Hex())
+	}
+
+	if _, err := opts.Signer(common.HexToAddress("0x1"), tx); err != bind.ErrNotAuthorized {
+		t.Errorf("其他地址签名返回 %v，期望 %v", err, bind.ErrNotAuthorized)
+	}
+}
@@ -0,0 +1,108 @@
+package txbuilder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend 构造交易需要的节点接口，ethclient.Client 满足该接口
+type Backend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// Request 待构造的交易
+type Request struct {
+	From  common.Address
+	To    *common.Address // 为nil时创建合约
+	Value *big.Int
+	Data  []byte
+	Gas   uint64  // 为0时估算
+	Nonce *uint64 // 为nil时取待处理nonce
+	Speed Speed
+	Fees  *Fees // 不为nil时直接使用，不再估算
+}
+
+// Builder 构造未签名的交易，有基础费用的链上生成EIP-1559交易，否则生成传统交易
+type Builder struct {
+	backend Backend
+}
+
+// NewBuilder 创建交易构造器
+func NewBuilder(backend Backend) *Builder {
+	return &Builder{backend: backend}
+}
+
+// Build 估算手续费、gas和nonce并构造交易，签名由调用方用 types.LatestSignerForChainID 完成
+func (b *Builder) Build(ctx context.Context, req Request) (*types.Transaction, *Fees, error) {
+	fees := req.Fees
+	if fees == nil {
+		var err error
+		if fees, err = EstimateFees(ctx, b.backend, req.Speed); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	value := req.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	gas := req.Gas
+	if gas == 0 {
+		msg := ethereum.CallMsg{From: req.From, To: req.To, Value: value, Data: req.Data}
+		if fees.Dynamic {
+			msg.GasTipCap, msg.GasFeeCap = fees.TipCap, fees.FeeCap
+		} else {
+			msg.GasPrice = fees.GasPrice
+		}
+		var err error
+		if gas, err = b.backend.EstimateGas(ctx, msg); err != nil {
+			return nil, nil, fmt.Errorf("估算Gas失败: %w", err)
+		}
+	}
+
+	var nonce uint64
+	if req.Nonce != nil {
+		nonce = *req.Nonce
+	} else {
+		var err error
+		if nonce, err = b.backend.PendingNonceAt(ctx, req.From); err != nil {
+			return nil, nil, fmt.Errorf("获取nonce失败: %w", err)
+		}
+	}
+
+	return NewTx(nonce, req.To, value, gas, req.Data, fees), fees, nil
+}
+
+// NewTx 按手续费类型构造交易
+func NewTx(nonce uint64, to *common.Address, value *big.Int, gas uint64, data []byte, fees *Fees) *types.Transaction {
+	if fees.Dynamic {
+		return types.NewTx(&types.DynamicFeeTx{
+			Nonce:     nonce,
+			To:        to,
+			Value:     value,
+			Gas:       gas,
+			GasTipCap: fees.TipCap,
+			GasFeeCap: fees.FeeCap,
+			Data:      data,
+		})
+	}
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    value,
+		Gas:      gas,
+		GasPrice: fees.GasPrice,
+		Data:     data,
+	})
+}
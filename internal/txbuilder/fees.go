@@ -0,0 +1,144 @@
+package txbuilder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Speed 手续费档位
+type Speed string
+
+// 手续费档位
+const (
+	SpeedSlow   Speed = "slow"
+	SpeedNormal Speed = "normal"
+	SpeedFast   Speed = "fast"
+)
+
+// feeHistoryBlocks 估算小费时参考的最近区块数
+const feeHistoryBlocks = 20
+
+// preset 档位参数
+type preset struct {
+	percentile     float64 // 取最近区块中该百分位的小费
+	baseMultiplier int64   // 最高费用按下一区块基础费用的倍数预留（百分比）
+	legacyPercent  int64   // 无基础费用的链上，相对节点建议Gas价格的比例（百分比）
+}
+
+// presets 各档位的参数，基础费用每个区块最多上涨12.5%，2倍大约可以容忍连续6个满区块
+var presets = map[Speed]preset{
+	SpeedSlow:   {percentile: 10, baseMultiplier: 125, legacyPercent: 90},
+	SpeedNormal: {percentile: 50, baseMultiplier: 200, legacyPercent: 100},
+	SpeedFast:   {percentile: 90, baseMultiplier: 300, legacyPercent: 125},
+}
+
+// ParseSpeed 解析手续费档位，为空时使用normal
+func ParseSpeed(value string) (Speed, error) {
+	if value == "" {
+		return SpeedNormal, nil
+	}
+	speed := Speed(strings.ToLower(value))
+	if _, ok := presets[speed]; !ok {
+		return "", fmt.Errorf("未知的手续费档位: %s（可选 slow、normal、fast）", value)
+	}
+	return speed, nil
+}
+
+// Speeds 所有档位，从慢到快
+func Speeds() []Speed {
+	return []Speed{SpeedSlow, SpeedNormal, SpeedFast}
+}
+
+// Fees 估算出的手续费，Dynamic为false时只有GasPrice有效
+type Fees struct {
+	Speed    Speed
+	Dynamic  bool
+	BaseFee  *big.Int // 下一个区块的基础费用
+	TipCap   *big.Int
+	FeeCap   *big.Int
+	GasPrice *big.Int
+}
+
+// Apply 把手续费写入合约绑定的交易参数，使bind按相同的方式构造交易
+func (f *Fees) Apply(opts *bind.TransactOpts) {
+	if f.Dynamic {
+		opts.GasTipCap = new(big.Int).Set(f.TipCap)
+		opts.GasFeeCap = new(big.Int).Set(f.FeeCap)
+		opts.GasPrice = nil
+		return
+	}
+	opts.GasPrice = new(big.Int).Set(f.GasPrice)
+	opts.GasTipCap = nil
+	opts.GasFeeCap = nil
+}
+
+// EstimateFees 按档位估算手续费
+// 链上最新区块有基础费用时，小费取最近区块 eth_feeHistory 中对应百分位的中位数，
+// 最高费用为下一区块基础费用按档位倍数预留再加小费；没有基础费用时按节点建议的Gas价格估算
+func EstimateFees(ctx context.Context, backend Backend, speed Speed) (*Fees, error) {
+	p, ok := presets[speed]
+	if !ok {
+		return nil, fmt.Errorf("未知的手续费档位: %s", speed)
+	}
+
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("获取最新区块失败: %w", err)
+	}
+	if head.BaseFee == nil {
+		price, err := backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取Gas价格失败: %w", err)
+		}
+		return &Fees{Speed: speed, GasPrice: percentOf(price, p.legacyPercent)}, nil
+	}
+
+	history, err := backend.FeeHistory(ctx, feeHistoryBlocks, head.Number, []float64{p.percentile})
+	if err != nil {
+		return nil, fmt.Errorf("获取手续费历史失败: %w", err)
+	}
+
+	baseFee := head.BaseFee
+	if n := len(history.BaseFee); n > 0 && history.BaseFee[n-1] != nil {
+		// 返回结果的最后一项是下一个区块的基础费用
+		baseFee = history.BaseFee[n-1]
+	}
+
+	tip := medianReward(history.Reward)
+	if tip == nil {
+		// 最近区块没有交易，参考节点的建议
+		if tip, err = backend.SuggestGasTipCap(ctx); err != nil {
+			return nil, fmt.Errorf("获取建议小费失败: %w", err)
+		}
+	}
+
+	feeCap := percentOf(baseFee, p.baseMultiplier)
+	feeCap.Add(feeCap, tip)
+	return &Fees{Speed: speed, Dynamic: true, BaseFee: baseFee, TipCap: tip, FeeCap: feeCap}, nil
+}
+
+// medianReward 各区块小费的中位数，忽略没有交易的区块，全部没有时返回nil
+func medianReward(rewards [][]*big.Int) *big.Int {
+	var values []*big.Int
+	for _, block := range rewards {
+		if len(block) > 0 && block[0] != nil && block[0].Sign() > 0 {
+			values = append(values, block[0])
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return new(big.Int).Set(values[len(values)/2])
+}
+
+// percentOf value的percent%
+func percentOf(value *big.Int, percent int64) *big.Int {
+	result := new(big.Int).Mul(value, big.NewInt(percent))
+	return result.Quo(result, big.NewInt(100))
+}
@@ -0,0 +1,128 @@
+package txdiag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/test/client/internal/abidecode"
+)
+
+// Backend 诊断需要的节点接口，ethclient.Client 满足该接口
+type Backend interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Diagnosis 失败交易的诊断结果
+type Diagnosis struct {
+	TxHash      common.Hash
+	BlockNumber uint64
+	From        common.Address
+	To          *common.Address
+	GasUsed     uint64
+	GasLimit    uint64
+
+	// OutOfGas Gas全部用完，多半是Gas上限设置过低
+	OutOfGas bool
+	// Reproduced 在父区块状态下用 eth_call 重放同样失败
+	Reproduced bool
+	// Revert 解码出的回滚原因，节点没有返回回滚数据时为nil
+	Revert *abidecode.Revert
+	// Message 重放时节点返回的错误信息
+	Message string
+}
+
+// Reason 失败原因的简短说明
+func (d *Diagnosis) Reason() string {
+	switch {
+	case d.Revert != nil && d.Revert.Kind != abidecode.RevertEmpty:
+		return d.Revert.Reason
+	case d.OutOfGas:
+		return fmt.Sprintf("Gas耗尽（%d/%d），需要提高Gas上限", d.GasUsed, d.GasLimit)
+	case d.Message != "":
+		return d.Message
+	case !d.Reproduced:
+		return "在父区块状态下重放成功，失败可能依赖同一区块内排在前面的交易"
+	default:
+		return "没有回滚原因"
+	}
+}
+
+// Diagnose 在交易所在区块的父区块状态下用 eth_call 重放失败的交易，取得并解码回滚原因
+// 重放时不包含同一区块内排在前面的交易，结果可能与实际执行不同；老区块需要归档节点
+func Diagnose(ctx context.Context, backend Backend, registry *abidecode.Registry, from common.Address, tx *types.Transaction, receipt *types.Receipt) (*Diagnosis, error) {
+	d := &Diagnosis{
+		TxHash:      tx.Hash(),
+		BlockNumber: receipt.BlockNumber.Uint64(),
+		From:        from,
+		To:          tx.To(),
+		GasUsed:     receipt.GasUsed,
+		GasLimit:    tx.Gas(),
+		OutOfGas:    receipt.GasUsed >= tx.Gas(),
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("交易 %s 执行成功，不需要诊断", tx.Hash().Hex())
+	}
+	if receipt.BlockNumber.Sign() == 0 {
+		return nil, errors.New("创世区块中的交易无法重放")
+	}
+
+	// 不带Gas价格重放，避免父区块的基础费用高于交易的最高费用时节点直接拒绝
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err := backend.CallContract(ctx, msg, parent)
+	if err == nil {
+		return d, nil
+	}
+
+	// 只有执行本身失败才算重放出了失败，缺少状态、网络错误、超时等交给调用方处理
+	data, ok := revertData(err)
+	if !ok && !isExecutionError(err, d.OutOfGas) {
+		return nil, fmt.Errorf("重放交易失败: %w", err)
+	}
+	d.Reproduced = true
+	d.Message = err.Error()
+	if ok {
+		d.Revert = registry.DecodeRevert(tx.To(), data)
+	}
+	return d, nil
+}
+
+// isExecutionError 错误是否来自EVM执行：execution reverted，或者交易本来就Gas耗尽时重放同样耗尽
+func isExecutionError(err error, outOfGas bool) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "execution reverted") || outOfGas && strings.Contains(msg, "out of gas")
+}
+
+// revertData 从 eth_call 的错误中取出回滚数据
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	s, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,134 @@
+package txtracker
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// State 交易状态
+type State string
+
+// 交易状态
+const (
+	StatePending   State = "pending"   // 在交易池中等待打包
+	StateMined     State = "mined"     // 已打包，确认数还不够
+	StateConfirmed State = "confirmed" // 已达到要求的确认数
+	StateDropped   State = "dropped"   // 交易池中找不到，nonce也没有被使用
+	StateReplaced  State = "replaced"  // nonce被不在记录中的其他交易使用
+)
+
+// Done 是否不会再变化，dropped的交易还可以加速或取消后重新发送，不算结束
+func (s State) Done() bool {
+	return s == StateConfirmed || s == StateReplaced
+}
+
+// Kind 同一nonce下发送的交易类型
+type Kind string
+
+// 交易类型
+const (
+	KindOriginal Kind = "original" // 最初发送的交易
+	KindSpeedUp  Kind = "speedup"  // 提高手续费的替换交易
+	KindCancel   Kind = "cancel"   // 发给自己的0金额替换交易
+)
+
+// Version 同一nonce下发送过的一笔交易
+type Version struct {
+	Kind   Kind               `json:"kind"`
+	Tx     *types.Transaction `json:"tx"`
+	SentAt time.Time          `json:"sent_at"`
+}
+
+// Record 一个账户nonce上的交易及其替换交易的跟踪记录
+type Record struct {
+	ChainID       uint64         `json:"chain_id"`
+	From          common.Address `json:"from"`
+	Nonce         uint64         `json:"nonce"`
+	Versions      []Version      `json:"versions"`
+	State         State          `json:"state"`
+	MinedHash     *common.Hash   `json:"mined_hash,omitempty"`
+	BlockNumber   uint64         `json:"block_number,omitempty"`
+	Status        uint64         `json:"status,omitempty"`
+	Confirmations uint64         `json:"confirmations"`
+	LastSeen      time.Time      `json:"last_seen"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// NewRecord 为已签名的交易创建跟踪记录，发送方从签名中恢复
+func NewRecord(chainID *big.Int, tx *types.Transaction) (*Record, error) {
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("恢复交易发送方失败: %w", err)
+	}
+	now := time.Now()
+	return &Record{
+		ChainID:   chainID.Uint64(),
+		From:      from,
+		Nonce:     tx.Nonce(),
+		Versions:  []Version{{Kind: KindOriginal, Tx: tx, SentAt: now}},
+		State:     StatePending,
+		LastSeen:  now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Hash 最初发送的交易哈希，作为记录的标识
+func (r *Record) Hash() common.Hash {
+	return r.Versions[0].Tx.Hash()
+}
+
+// Latest 最近发送的一笔交易，加速和取消都以它为基础
+func (r *Record) Latest() *Version {
+	return &r.Versions[len(r.Versions)-1]
+}
+
+// Has 记录中是否包含该哈希的交易
+func (r *Record) Has(hash common.Hash) bool {
+	for _, v := range r.Versions {
+		if v.Tx.Hash() == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// Mined 被打包的交易，还没有打包时返回nil
+func (r *Record) Mined() *Version {
+	if r.MinedHash == nil {
+		return nil
+	}
+	for i := range r.Versions {
+		if r.Versions[i].Tx.Hash() == *r.MinedHash {
+			return &r.Versions[i]
+		}
+	}
+	return nil
+}
+
+// Count 某种类型的交易发送过的次数
+func (r *Record) Count(kind Kind) int {
+	n := 0
+	for _, v := range r.Versions {
+		if v.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// Add 记录一笔已发送的替换交易
+func (r *Record) Add(kind Kind, tx *types.Transaction) error {
+	if tx.Nonce() != r.Nonce {
+		return fmt.Errorf("替换交易的nonce %d 与原交易 %d 不一致", tx.Nonce(), r.Nonce)
+	}
+	now := time.Now()
+	r.Versions = append(r.Versions, Version{Kind: kind, Tx: tx, SentAt: now})
+	r.State = StatePending
+	r.LastSeen = now
+	r.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,64 @@
+package txtracker
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/test/client/internal/txbuilder"
+)
+
+// MinBumpPercent 节点接受同nonce替换交易要求的最低手续费涨幅（go-ethereum交易池默认10%）
+const MinBumpPercent = 10
+
+// SpeedUp 构造内容不变、手续费提高的替换交易
+func SpeedUp(tx *types.Transaction, fees *txbuilder.Fees) *types.Transaction {
+	return replacement(tx, tx.To(), tx.Value(), tx.Gas(), tx.Data(), fees)
+}
+
+// Cancel 构造发给自己的0金额替换交易，打包后原交易作废
+func Cancel(from common.Address, tx *types.Transaction, fees *txbuilder.Fees) *types.Transaction {
+	return replacement(tx, &from, new(big.Int), params.TxGas, nil, fees)
+}
+
+// replacement 用原交易的nonce构造替换交易，每项手续费取原交易上涨MinBumpPercent与当前估算中较高的一个
+// 原交易是EIP-1559交易时小费和最高费用都要上涨，否则上涨Gas价格
+func replacement(tx *types.Transaction, to *common.Address, value *big.Int, gas uint64, data []byte, fees *txbuilder.Fees) *types.Transaction {
+	bumped := &txbuilder.Fees{Speed: fees.Speed, BaseFee: fees.BaseFee}
+	if tx.Type() == types.DynamicFeeTxType {
+		tip, feeCap := fees.TipCap, fees.FeeCap
+		if !fees.Dynamic {
+			tip, feeCap = fees.GasPrice, fees.GasPrice
+		}
+		bumped.Dynamic = true
+		bumped.TipCap = maxBig(bump(tx.GasTipCap()), tip)
+		bumped.FeeCap = maxBig(bump(tx.GasFeeCap()), feeCap)
+		if bumped.FeeCap.Cmp(bumped.TipCap) < 0 {
+			bumped.FeeCap = new(big.Int).Set(bumped.TipCap)
+		}
+	} else {
+		price := fees.GasPrice
+		if fees.Dynamic {
+			price = fees.FeeCap
+		}
+		bumped.GasPrice = maxBig(bump(tx.GasPrice()), price)
+	}
+	return txbuilder.NewTx(tx.Nonce(), to, value, gas, data, bumped)
+}
+
+// bump value上涨MinBumpPercent，向上取整
+func bump(value *big.Int) *big.Int {
+	result := new(big.Int).Mul(value, big.NewInt(100+MinBumpPercent))
+	result.Add(result, big.NewInt(99))
+	return result.Quo(result, big.NewInt(100))
+}
+
+// maxBig 两者中较大的一个，b为nil时返回a
+func maxBig(a, b *big.Int) *big.Int {
+	if b != nil && b.Cmp(a) > 0 {
+		return new(big.Int).Set(b)
+	}
+	return a
+}
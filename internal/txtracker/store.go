@@ -0,0 +1,142 @@
+package txtracker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrNotFound 状态文件中没有该交易
+var ErrNotFound = errors.New("没有找到交易的跟踪记录")
+
+// Store 把跟踪记录保存在JSON文件中，CLI重新启动后可以继续跟踪
+// 同一进程内可以并发使用，多个进程同时写入同一文件时以最后写入的为准
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewStore 使用指定的状态文件，文件不存在时在第一次保存时创建
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultStorePath 默认的状态文件 ~/.ethcli/txs.json
+func DefaultStorePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("获取用户目录失败: %w", err)
+	}
+	return filepath.Join(home, ".ethcli", "txs.json"), nil
+}
+
+// Path 状态文件路径
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Load 读取所有记录
+func (s *Store) Load() ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+// Find 按链ID和任意一笔版本的哈希查找记录
+func (s *Store) Find(chainID uint64, hash common.Hash) (*Record, error) {
+	records, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range records {
+		if rec.ChainID == chainID && rec.Has(hash) {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, hash.Hex())
+}
+
+// Save 新增或更新记录
+func (s *Store) Save(rec *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i, existing := range records {
+		if existing.ChainID == rec.ChainID && existing.Hash() == rec.Hash() {
+			records[i] = rec
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		records = append(records, rec)
+	}
+	return s.write(records)
+}
+
+// Remove 删除满足条件的记录，返回删除的数量
+func (s *Store) Remove(match func(*Record) bool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return 0, err
+	}
+	kept := records[:0]
+	for _, rec := range records {
+		if !match(rec) {
+			kept = append(kept, rec)
+		}
+	}
+	removed := len(records) - len(kept)
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, s.write(kept)
+}
+
+// load 读取状态文件，调用方持有s.mu
+func (s *Store) load() ([]*Record, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取状态文件失败: %w", err)
+	}
+	var records []*Record
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("解析状态文件 %s 失败: %w", s.path, err)
+	}
+	return records, nil
+}
+
+// write 先写临时文件再改名，避免中途退出时状态文件损坏，调用方持有s.mu
+func (s *Store) write(records []*Record) error {
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化跟踪记录失败: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("创建状态目录失败: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("写入状态文件失败: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("写入状态文件失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package txtracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrTimeout 等待超时时交易仍未达到最终状态
+var ErrTimeout = errors.New("等待交易超时")
+
+// Backend 跟踪交易需要的节点接口，ethclient.Client 满足该接口
+type Backend interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// SpeedUpFunc 构造、签名并发送加速交易，返回已发送的交易
+type SpeedUpFunc func(ctx context.Context, rec *Record) (*types.Transaction, error)
+
+// Tracker 轮询节点，更新交易的状态并保存到状态文件
+type Tracker struct {
+	backend Backend
+	store   *Store // 为nil时不保存
+
+	Confirmations uint64        // 达到confirmed需要的确认数，打包所在区块算1个
+	PollInterval  time.Duration // 轮询间隔
+	DropAfter     time.Duration // 交易池中连续找不到多久后判定为dropped
+
+	// 交易pending超过SpeedUpAfter后调用SpeedUp加速，最多MaxSpeedUps次，SpeedUp为nil时不自动加速
+	SpeedUp      SpeedUpFunc
+	SpeedUpAfter time.Duration
+	MaxSpeedUps  int
+
+	// Dropped 在Watch判定交易被丢弃时调用，例如把nonce交还给nonce管理器，为nil时忽略
+	Dropped func(rec *Record)
+}
+
+// NewTracker 创建交易跟踪器，默认1个确认、每3秒轮询一次、2分钟找不到判定为dropped
+func NewTracker(backend Backend, store *Store) *Tracker {
+	return &Tracker{
+		backend:       backend,
+		store:         store,
+		Confirmations: 1,
+		PollInterval:  3 * time.Second,
+		DropAfter:     2 * time.Minute,
+		MaxSpeedUps:   3,
+	}
+}
+
+// Track 开始跟踪一笔刚发送的交易并保存
+func (t *Tracker) Track(chainID *big.Int, tx *types.Transaction) (*Record, error) {
+	rec, err := NewRecord(chainID, tx)
+	if err != nil {
+		return nil, err
+	}
+	return rec, t.save(rec)
+}
+
+// Replace 记录一笔已发送的替换交易并保存
+func (t *Tracker) Replace(rec *Record, kind Kind, tx *types.Transaction) error {
+	if err := rec.Add(kind, tx); err != nil {
+		return err
+	}
+	return t.save(rec)
+}
+
+// Check 查询一次节点并更新记录的状态，状态或确认数变化时保存
+func (t *Tracker) Check(ctx context.Context, rec *Record) error {
+	prevState, prevConfirmations := rec.State, rec.Confirmations
+	if err := t.check(ctx, rec); err != nil {
+		return err
+	}
+	if rec.State == prevState && rec.Confirmations == prevConfirmations {
+		return nil
+	}
+	rec.UpdatedAt = time.Now()
+	return t.save(rec)
+}
+
+// Watch 轮询直到交易确认、被替换、被丢弃或ctx结束，状态变化时调用onChange
+// ctx超时返回ErrTimeout，此时记录已保存，可以之后继续跟踪
+func (t *Tracker) Watch(ctx context.Context, rec *Record, onChange func(*Record)) error {
+	prevState, prevConfirmations := State(""), uint64(0)
+	for {
+		if err := t.Check(ctx, rec); err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("%w: 当前状态 %s", ErrTimeout, rec.State)
+			}
+			return err
+		}
+		if onChange != nil && (rec.State != prevState || rec.Confirmations != prevConfirmations) {
+			onChange(rec)
+		}
+		prevState, prevConfirmations = rec.State, rec.Confirmations
+		if rec.State == StateDropped && t.Dropped != nil {
+			t.Dropped(rec)
+		}
+		if rec.State.Done() || rec.State == StateDropped {
+			return nil
+		}
+
+		if t.shouldSpeedUp(rec) {
+			tx, err := t.SpeedUp(ctx, rec)
+			if err != nil {
+				return fmt.Errorf("自动加速失败: %w", err)
+			}
+			if err := t.Replace(rec, KindSpeedUp, tx); err != nil {
+				return err
+			}
+			if onChange != nil {
+				onChange(rec)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: 当前状态 %s", ErrTimeout, rec.State)
+		case <-time.After(t.PollInterval):
+		}
+	}
+}
+
+// shouldSpeedUp 最近一笔交易pending是否已超过加速等待时间
+func (t *Tracker) shouldSpeedUp(rec *Record) bool {
+	if t.SpeedUp == nil || t.SpeedUpAfter <= 0 || rec.State != StatePending {
+		return false
+	}
+	if rec.Latest().Kind == KindCancel || rec.Count(KindSpeedUp) >= t.MaxSpeedUps {
+		return false
+	}
+	return time.Since(rec.Latest().SentAt) >= t.SpeedUpAfter
+}
+
+// check 按收据、账户nonce和交易池依次判断状态
+func (t *Tracker) check(ctx context.Context, rec *Record) error {
+	receipt, err := t.findReceipt(ctx, rec)
+	if err != nil {
+		return err
+	}
+	if receipt == nil {
+		// 查询收据和nonce之间交易可能刚好被打包，nonce已使用时再查一次收据
+		nonce, err := t.backend.NonceAt(ctx, rec.From, nil)
+		if err != nil {
+			return fmt.Errorf("获取账户nonce失败: %w", err)
+		}
+		if nonce > rec.Nonce {
+			if receipt, err = t.findReceipt(ctx, rec); err != nil {
+				return err
+			}
+			if receipt == nil {
+				rec.State = StateReplaced
+				rec.MinedHash, rec.BlockNumber, rec.Status, rec.Confirmations = nil, 0, 0, 0
+				return nil
+			}
+		}
+	}
+
+	if receipt != nil {
+		head, err := t.backend.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("获取最新区块号失败: %w", err)
+		}
+		hash := receipt.TxHash
+		rec.MinedHash = &hash
+		rec.BlockNumber = receipt.BlockNumber.Uint64()
+		rec.Status = receipt.Status
+		rec.Confirmations = 0
+		if head >= rec.BlockNumber {
+			rec.Confirmations = head - rec.BlockNumber + 1
+		}
+		rec.State = StateMined
+		if rec.Confirmations >= t.Confirmations {
+			rec.State = StateConfirmed
+		}
+		return nil
+	}
+
+	// 没有打包，可能因为重组回到交易池
+	rec.MinedHash, rec.BlockNumber, rec.Status, rec.Confirmations = nil, 0, 0, 0
+	seen, err := t.inPool(ctx, rec)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if seen {
+		rec.LastSeen = now
+		rec.State = StatePending
+	} else if now.Sub(rec.LastSeen) >= t.DropAfter {
+		rec.State = StateDropped
+	} else {
+		rec.State = StatePending
+	}
+	return nil
+}
+
+// findReceipt 从最新的版本开始查找收据，都没有打包时返回nil
+func (t *Tracker) findReceipt(ctx context.Context, rec *Record) (*types.Receipt, error) {
+	for i := len(rec.Versions) - 1; i >= 0; i-- {
+		hash := rec.Versions[i].Tx.Hash()
+		receipt, err := t.backend.TransactionReceipt(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("获取交易 %s 的收据失败: %w", hash.Hex(), err)
+		}
+		return receipt, nil
+	}
+	return nil, nil
+}
+
+// inPool 是否有任意一个版本还在节点的交易池中
+func (t *Tracker) inPool(ctx context.Context, rec *Record) (bool, error) {
+	for _, v := range rec.Versions {
+		_, pending, err := t.backend.TransactionByHash(ctx, v.Tx.Hash())
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("查询交易 %s 失败: %w", v.Tx.Hash().Hex(), err)
+		}
+		if pending {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// save 保存记录，没有状态文件时跳过
+func (t *Tracker) save(rec *Record) error {
+	if t.store == nil {
+		return nil
+	}
+	return t.store.Save(rec)
+}
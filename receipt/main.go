@@ -1,53 +0,0 @@
-package main
-
-import (
-    "context"
-    "fmt"
-    "log"
-    "math/big"
-
-    "github.com/ethereum/go-ethereum/common"
-    "github.com/ethereum/go-ethereum/ethclient"
-    "github.com/ethereum/go-ethereum/rpc"
-)
-
-func main() {
-    client, err := ethclient.Dial("https://eth.w3node.com/3929aae67922ce11638cee1a386e301c91b558b7692bf880372b54fcb191870f/api")
-    if err != nil {
-        log.Fatal(err)
-    }
-
-    blockNumber := big.NewInt(5671744)
-    blockHash := common.HexToHash("0xae713dea1419ac72b928ebe6ba9915cd4fc1ef125a606f90f5e783c47cb1a4b5")
-
-    receiptByHash, err := client.BlockReceipts(context.Background(), rpc.BlockNumberOrHashWithHash(blockHash, false))
-    if err != nil {
-        log.Fatal(err)
-    }
-
-    receiptsByNum, err := client.BlockReceipts(context.Background(), rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNumber.Int64())))
-    if err != nil {
-        log.Fatal(err)
-    }
-    fmt.Println(receiptByHash[0] == receiptsByNum[0]) // true
-
-    for _, receipt := range receiptByHash {
-        fmt.Println(receipt.Status)                // 1
-        fmt.Println(receipt.Logs)                  // []
-        fmt.Println(receipt.TxHash.Hex())          // 0x20294a03e8766e9aeab58327fc4112756017c6c28f6f99c7722f4a29075601c5
-        fmt.Println(receipt.TransactionIndex)      // 0
-        fmt.Println(receipt.ContractAddress.Hex()) // 0x0000000000000000000000000000000000000000
-        break
-    }
-
-    txHash := common.HexToHash("0x20294a03e8766e9aeab58327fc4112756017c6c28f6f99c7722f4a29075601c5")
-    receipt, err := client.TransactionReceipt(context.Background(), txHash)
-    if err != nil {
-        log.Fatal(err)
-    }
-    fmt.Println(receipt.Status)                // 1
-    fmt.Println(receipt.Logs)                  // []
-    fmt.Println(receipt.TxHash.Hex())          // 0x20294a03e8766e9aeab58327fc4112756017c6c28f6f99c7722f4a29075601c5
-    fmt.Println(receipt.TransactionIndex)      // 0
-    fmt.Println(receipt.ContractAddress.Hex()) // 0x0000000000000000000000000000000000000000
-}
\ No newline at end of file
@@ -1,35 +0,0 @@
-package main
-
-import (
-    "crypto/ecdsa"
-    "fmt"
-    "log"
-
-    "github.com/ethereum/go-ethereum/common/hexutil"
-    "github.com/ethereum/go-ethereum/crypto"
-    "golang.org/x/crypto/sha3"
-)
-
-func main() {
-    privateKey, err := crypto.GenerateKey()
-    if err != nil {
-        log.Fatal(err)
-    }
-
-    privateKeyBytes := crypto.FromECDSA(privateKey)
-    fmt.Println(hexutil.Encode(privateKeyBytes)[2:]) // 去掉'0x'
-    publicKey := privateKey.Public()
-    publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-    if !ok {
-        log.Fatal("cannot assert type: publicKey is not of type *ecdsa.PublicKey")
-    }
-
-    publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
-    fmt.Println("from pubKey:", hexutil.Encode(publicKeyBytes)[4:]) // 去掉'0x04'
-    address := crypto.PubkeyToAddress(*publicKeyECDSA).Hex()
-    fmt.Println(address)
-    hash := sha3.NewLegacyKeccak256()
-    hash.Write(publicKeyBytes[1:])
-    fmt.Println("full:", hexutil.Encode(hash.Sum(nil)[:]))
-    fmt.Println(hexutil.Encode(hash.Sum(nil)[12:])) // 原长32位，截去12位，保留后20位
-}
\ No newline at end of file